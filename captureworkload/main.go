@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"os"
 	"os/signal"
 	"syscall"
@@ -11,6 +12,7 @@ import (
 	"github.com/microsoft/retina/internal/buildinfo"
 	"github.com/microsoft/retina/pkg/capture"
 	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	captureProvider "github.com/microsoft/retina/pkg/capture/provider"
 
 	"github.com/microsoft/retina/pkg/log"
 	"github.com/microsoft/retina/pkg/telemetry"
@@ -51,18 +53,42 @@ func main() {
 
 	cm := capture.NewCaptureManager(l, tel)
 
+	if capture.PruneMode() {
+		if err := cm.PruneOutput(); err != nil {
+			l.Error("Failed to prune network capture", zap.Error(err))
+			os.Exit(1)
+		}
+		l.Info("Done for pruning network capture")
+		return
+	}
+
 	defer func() {
 		if err := cm.Cleanup(); err != nil {
 			l.Error("Failed to cleanup network capture", zap.Error(err))
 		}
 	}()
+	// The result is reported to the Capture controller and the CLI through the termination message.
+	writeResult := func(captureErr error) {
+		if err := cm.WriteResult(captureErr); err != nil {
+			l.Error("Failed to write network capture result", zap.Error(err))
+		}
+	}
 	srcDir, err := cm.CaptureNetwork(ctx)
+	if errors.Is(err, captureProvider.ErrNotTriggered) {
+		writeResult(nil)
+		l.Info("Done for capturing network traffic, no trigger fired to output the ring buffer")
+		return
+	}
 	if err != nil {
+		writeResult(err)
 		l.Error("Failed to capture network traffic", zap.Error(err))
 		os.Exit(1)
 	}
 	if err := cm.OutputCapture(ctx, srcDir); err != nil {
+		writeResult(err)
 		l.Error("Failed to output network traffic", zap.Error(err))
+		os.Exit(1)
 	}
+	writeResult(nil)
 	l.Info("Done for capturing network traffic")
 }
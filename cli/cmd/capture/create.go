@@ -49,6 +49,7 @@ var (
 	nowait             bool
 	packetSize         int
 	podSelectors       string
+	provider           string
 	pvc                string
 	s3AccessKeyID      string
 	s3Bucket           string
@@ -315,6 +316,10 @@ func createCaptureF(ctx context.Context, kubeClient kubernetes.Interface) (*reti
 		capture.Spec.CaptureConfiguration.CaptureOption.PacketSize = &packetSize
 	}
 
+	if len(provider) != 0 {
+		capture.Spec.CaptureConfiguration.CaptureOption.Provider = retinav1alpha1.CaptureProvider(provider)
+	}
+
 	if len(hostPath) != 0 {
 		capture.Spec.OutputConfiguration.HostPath = &hostPath
 	}
@@ -466,6 +471,8 @@ func init() {
 	createCapture.Flags().DurationVar(&duration, "duration", DefaultDuration, "Duration of capturing packets")
 	createCapture.Flags().IntVar(&maxSize, "max-size", DefaultMaxSize, "Limit the capture file to MB in size which works only for Linux") //nolint:gomnd // default
 	createCapture.Flags().IntVar(&packetSize, "packet-size", DefaultPacketSize, "Limits the each packet to bytes in size which works only for Linux")
+	createCapture.Flags().StringVar(&provider, "provider", string(retinav1alpha1.CaptureProviderDefault),
+		"Capture provider to use, either Default (tcpdump/netsh) or eBPF which works only for Linux")
 	createCapture.Flags().StringVar(&nodeNames, "node-names", "", "A comma-separated list of node names to select nodes on which the network capture will be performed")
 	createCapture.Flags().StringVar(&nodeSelectors, "node-selectors", DefaultNodeSelectors, "A comma-separated list of node labels to select nodes on which the network capture will be performed")
 	createCapture.Flags().StringVar(&podSelectors, "pod-selectors", "",
@@ -4,82 +4,459 @@
 package capture
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/url"
 	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"slices"
 	"strings"
+	"syscall"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	retinacmd "github.com/microsoft/retina/cli/cmd"
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	"github.com/microsoft/retina/pkg/capture/file"
+	"github.com/microsoft/retina/pkg/capture/outputlocation"
+	"github.com/microsoft/retina/pkg/label"
 )
 
 const BlobURL = "BLOB_URL"
 
-var ErrEmptyBlobURL = errors.Errorf("%s environment variable is empty. It must be set/exported", BlobURL)
+var (
+	ErrEmptyBlobURL = errors.Errorf("%s environment variable is empty. It must be set/exported", BlobURL)
+	// ErrBlobSASPermissions is returned for SAS URLs, such as the ones only used to upload, that cannot read the blobs.
+	ErrBlobSASPermissions = errors.New("the blob SAS URL must grant read and list permissions to download the captures")
+)
+
+var (
+	downloadOutputDir   string
+	downloadHelperImage string
+)
+
+const (
+	DefaultDownloadOutputDir string = "."
+	// DefaultDownloadHelperImage is the image of the pod reading the artifacts from PersistentVolumeClaims and
+	// host paths, it needs sh and tar.
+	DefaultDownloadHelperImage string = "mcr.microsoft.com/cbl-mariner/base/core:2.0"
+)
+
+var downloadExample = templates.Examples(i18n.T(`
+		# Download the artifacts of the Retina Capture "retina-capture-8v6wd" in namespace "capture" to the current directory
+		kubectl retina capture download --name retina-capture-8v6wd --namespace capture
+
+		# Download the artifacts to the directory "captures"
+		kubectl retina capture download --name retina-capture-8v6wd --output captures
+
+		# Download the artifacts uploaded to blob storage with a SAS URL with read and list permissions
+		BLOB_URL="https://testaccount.blob.core.windows.net/<token>" kubectl retina capture download --name retina-capture-8v6wd
+		`))
 
 var downloadCapture = &cobra.Command{
-	Use:   "download",
-	Short: "Download Retina Captures",
+	Use:     "download",
+	Short:   "Download Retina Captures",
+	Example: downloadExample,
 	RunE: func(*cobra.Command, []string) error {
 		viper.AutomaticEnv()
-		blobURL := viper.GetString(BlobURL)
-		if blobURL == "" {
-			return ErrEmptyBlobURL
+		if blobURL := viper.GetString(BlobURL); blobURL != "" {
+			// The nodes of the Capture are unknown without the cluster.
+			return downloadFromBlob(blobURL, *opts.Name, nil, downloadOutputDir)
+		}
+
+		kubeConfig, err := opts.ToRESTConfig()
+		if err != nil {
+			return errors.Wrap(err, "failed to compose k8s rest config")
 		}
 
-		u, err := url.Parse(blobURL)
+		kubeClient, err := kubernetes.NewForConfig(kubeConfig)
 		if err != nil {
-			return errors.Wrapf(err, "failed to parse SAS URL %s", blobURL)
+			return errors.Wrap(err, "failed to initialize kubernetes client")
 		}
 
-		// blobService, err := storage.NewAccountSASClientFromEndpointToken(u.String(), u.Query().Encode()).GetBlobService()
-		b, err := storage.NewAccountSASClientFromEndpointToken(u.String(), u.Query().Encode())
+		scheme := runtime.NewScheme()
+		if err = retinav1alpha1.AddToScheme(scheme); err != nil {
+			return errors.Wrap(err, "failed to add Retina CRDs to scheme")
+		}
+		crClient, err := client.New(kubeConfig, client.Options{Scheme: scheme})
 		if err != nil {
-			return errors.Wrap(err, "failed to create storage account client")
+			return errors.Wrap(err, "failed to initialize Retina CRD client")
 		}
 
-		blobService := b.GetBlobService()
-		containerPath := strings.TrimLeft(u.Path, "/")
-		splitPath := strings.SplitN(containerPath, "/", 2) //nolint:gomnd // TODO string splitting probably isn't the right way to parse this URL?
-		containerName := splitPath[0]
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+		defer cancel()
 
-		params := storage.ListBlobsParameters{Prefix: *opts.Name}
-		blobList, err := blobService.GetContainerReference(containerName).ListBlobs(params)
+		artifacts, err := getCaptureArtifacts(ctx, kubeClient, crClient, *opts.Name, *opts.Namespace)
 		if err != nil {
-			return errors.Wrap(err, "failed to list blobstore ")
+			return err
 		}
 
-		if len(blobList.Blobs) == 0 {
-			return errors.Errorf("no blobs found with prefix: %s", *opts.Name)
+		d := &downloader{
+			kubeClient:  kubeClient,
+			kubeConfig:  kubeConfig,
+			name:        *opts.Name,
+			namespace:   *opts.Namespace,
+			outputDir:   downloadOutputDir,
+			helperImage: downloadHelperImage,
+			exec:        execInPod,
 		}
+		return d.download(ctx, artifacts)
+	},
+}
 
-		for _, v := range blobList.Blobs {
-			blob := blobService.GetContainerReference(containerName).GetBlobReference(v.Name)
-			readCloser, err := blob.Get(&storage.GetBlobOptions{})
-			if err != nil {
-				return errors.Wrap(err, "failed to read from blobstore")
+// captureArtifacts describes where the artifacts of a Capture were stored.
+type captureArtifacts struct {
+	output retinav1alpha1.OutputConfiguration
+	// nodes are the nodes the Capture ran on, storing the artifacts in their host path.
+	nodes []string
+}
+
+// getCaptureArtifacts reads the output configuration from the Capture, or from its jobs when the Capture was
+// created by the CLI without a Capture resource.
+func getCaptureArtifacts(ctx context.Context, kubeClient kubernetes.Interface, crClient client.Client, name, namespace string) (*captureArtifacts, error) {
+	captureJobSelector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			label.CaptureNameLabel: name,
+			label.AppLabel:         captureConstants.CaptureAppname,
+		},
+	}
+	labelSelector, _ := labels.Parse(metav1.FormatLabelSelector(captureJobSelector))
+	jobList, err := kubeClient.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list capture jobs")
+	}
+
+	artifacts := &captureArtifacts{}
+	var container *corev1.Container
+	for i := range jobList.Items {
+		containers := jobList.Items[i].Spec.Template.Spec.Containers
+		if len(containers) == 0 {
+			continue
+		}
+		if container == nil {
+			container = &containers[0]
+		}
+		if node := envValue(containers[0].Env, captureConstants.NodeHostNameEnvKey); node != "" {
+			artifacts.nodes = append(artifacts.nodes, node)
+		}
+	}
+
+	capture := &retinav1alpha1.Capture{}
+	err = crClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, capture)
+	switch {
+	case err == nil:
+		artifacts.output = capture.Spec.OutputConfiguration
+		return artifacts, nil
+	case !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err):
+		return nil, errors.Wrap(err, "failed to get capture")
+	}
+
+	if container == nil {
+		return nil, errors.Errorf("capture %s in namespace %s was not found", name, namespace)
+	}
+	artifacts.output = outputConfigurationFromContainer(container)
+	return artifacts, nil
+}
+
+// outputConfigurationFromContainer reads the output configuration from the environment and the volumes
+// of the capture container.
+func outputConfigurationFromContainer(c *corev1.Container) retinav1alpha1.OutputConfiguration {
+	output := retinav1alpha1.OutputConfiguration{}
+	if hostPath := envValue(c.Env, string(captureConstants.CaptureOutputLocationEnvKeyHostPath)); hostPath != "" {
+		output.HostPath = &hostPath
+	}
+	if pvc := envValue(c.Env, string(captureConstants.CaptureOutputLocationEnvKeyPersistentVolumeClaim)); pvc != "" {
+		output.PersistentVolumeClaim = &pvc
+	}
+	if bucket := envValue(c.Env, string(captureConstants.CaptureOutputLocationEnvKeyS3Bucket)); bucket != "" {
+		output.S3Upload = &retinav1alpha1.S3Upload{
+			Endpoint: envValue(c.Env, string(captureConstants.CaptureOutputLocationEnvKeyS3Endpoint)),
+			Bucket:   bucket,
+			Region:   envValue(c.Env, string(captureConstants.CaptureOutputLocationEnvKeyS3Region)),
+			Path:     envValue(c.Env, string(captureConstants.CaptureOutputLocationEnvKeyS3Path)),
+		}
+	}
+	// The secrets are mounted in volumes named after them.
+	for _, m := range c.VolumeMounts {
+		switch m.MountPath {
+		case captureConstants.CaptureOutputLocationS3UploadSecretPath:
+			if output.S3Upload != nil {
+				output.S3Upload.SecretName = m.Name
 			}
+		case captureConstants.CaptureOutputLocationBlobUploadSecretPath:
+			secretName := m.Name
+			output.BlobUpload = &secretName
+		}
+	}
+	return output
+}
+
+func envValue(env []corev1.EnvVar, name string) string {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+// isCaptureArtifact returns true for the tarballs of the Capture, named <capture name>-<node>-<timestamp>.tar.gz.
+// Node names may contain dashes, so the tarballs of a Capture whose name extends this one are only told apart
+// when the nodes of the Capture are known.
+func isCaptureArtifact(name string, nodes []string, fileName string) bool {
+	if !strings.HasSuffix(fileName, ".tar.gz") {
+		return false
+	}
+	cf, err := file.ParseCaptureFilename(name, fileName)
+	if err != nil {
+		return false
+	}
+	return len(nodes) == 0 || slices.Contains(nodes, cf.NodeHostname)
+}
+
+type downloader struct {
+	kubeClient kubernetes.Interface
+	kubeConfig *rest.Config
+	name       string
+	namespace  string
+	// nodes are the nodes the Capture ran on, unknown when empty.
+	nodes       []string
+	outputDir   string
+	helperImage string
+	// exec runs the command in the container of the pod, writing its output to stdout.
+	exec func(ctx context.Context, kubeClient kubernetes.Interface, kubeConfig *rest.Config, pod *corev1.Pod, command []string, stdout io.Writer) error
+}
 
-			defer readCloser.Close()
+// download fetches the artifacts from every output location of the Capture.
+func (d *downloader) download(ctx context.Context, artifacts *captureArtifacts) error {
+	if err := os.MkdirAll(d.outputDir, 0o755); err != nil { //nolint:gomnd // permissive bitmask for the output directory
+		return errors.Wrapf(err, "failed to create output directory %s", d.outputDir)
+	}
 
-			blobData, err := io.ReadAll(readCloser)
+	d.nodes = artifacts.nodes
+	output := artifacts.output
+	downloaded := 0
+	if output.S3Upload != nil && output.S3Upload.Bucket != "" {
+		files, err := d.downloadFromS3(ctx, output.S3Upload)
+		if err != nil {
+			return err
+		}
+		downloaded += len(files)
+	}
+	if output.PersistentVolumeClaim != nil && *output.PersistentVolumeClaim != "" {
+		files, err := d.downloadFromPVC(ctx, *output.PersistentVolumeClaim)
+		if err != nil {
+			return err
+		}
+		downloaded += len(files)
+	}
+	if output.HostPath != nil && *output.HostPath != "" {
+		if len(artifacts.nodes) == 0 {
+			retinacmd.Logger.Warn("Skipping host path, the capture jobs are required to find the nodes of the Capture", zap.String("host path", *output.HostPath))
+		}
+		for _, node := range artifacts.nodes {
+			files, err := d.downloadFromHostPath(ctx, *output.HostPath, node)
 			if err != nil {
-				return errors.Wrap(err, "failed to obtain blob from blobstore")
+				return err
 			}
+			downloaded += len(files)
+		}
+	}
+	if output.BlobUpload != nil && *output.BlobUpload != "" {
+		blobURL, err := d.blobURLFromSecret(ctx, *output.BlobUpload)
+		if err != nil {
+			return err
+		}
+		if err := downloadFromBlob(blobURL, d.name, d.nodes, d.outputDir); err != nil {
+			return err
+		}
+		downloaded++
+	}
+
+	if downloaded == 0 {
+		return errors.Errorf("no artifacts found for capture %s in namespace %s", d.name, d.namespace)
+	}
+	return nil
+}
+
+// blobURLFromSecret returns the SAS URL of the blob upload secret, it needs read and list permissions.
+func (d *downloader) blobURLFromSecret(ctx context.Context, secretName string) (string, error) {
+	secret, err := d.kubeClient.CoreV1().Secrets(d.namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(ErrEmptyBlobURL, "failed to get blob upload secret %s: %s", secretName, err)
+	}
+	return string(secret.Data[captureConstants.CaptureOutputLocationBlobUploadSecretKey]), nil
+}
+
+// downloadFromS3 downloads the artifacts from the bucket with the credentials of the S3 upload secret,
+// or with the default AWS credentials when the secret is gone.
+func (d *downloader) downloadFromS3(ctx context.Context, s3Upload *retinav1alpha1.S3Upload) ([]string, error) {
+	var accessKeyID, secretAccessKey string
+	if s3Upload.SecretName != "" {
+		secret, err := d.kubeClient.CoreV1().Secrets(d.namespace).Get(ctx, s3Upload.SecretName, metav1.GetOptions{})
+		switch {
+		case err == nil:
+			accessKeyID = string(secret.Data[captureConstants.CaptureOutputLocationS3UploadAccessKeyID])
+			secretAccessKey = string(secret.Data[captureConstants.CaptureOutputLocationS3UploadSecretAccessKey])
+		case apierrors.IsNotFound(err):
+			retinacmd.Logger.Info("S3 upload secret not found, using the default AWS credentials", zap.String("secret name", s3Upload.SecretName))
+		default:
+			return nil, errors.Wrap(err, "failed to get S3 upload secret")
+		}
+	}
+
+	s3Client, err := outputlocation.NewS3Client(ctx, s3Upload.Endpoint, s3Upload.Region, accessKeyID, secretAccessKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create S3 client")
+	}
 
-			err = os.WriteFile(v.Name, blobData, 0o644) //nolint:gosec,gomnd // intentionally permissive bitmask
+	// The objects are keyed by the path of the tarball in the capture pod under the S3 path.
+	files := []string{}
+	paginator := s3.NewListObjectsV2Paginator(s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s3Upload.Bucket),
+		Prefix: aws.String(s3Upload.Path),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list objects in bucket %s", s3Upload.Bucket)
+		}
+		for _, obj := range page.Contents {
+			key := aws.ToString(obj.Key)
+			fileName := path.Base(key)
+			if !isCaptureArtifact(d.name, d.nodes, fileName) {
+				continue
+			}
+			out, err := s3Client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(s3Upload.Bucket), Key: aws.String(key)})
 			if err != nil {
-				return errors.Wrap(err, "failed to write file")
+				return nil, errors.Wrapf(err, "failed to get object %s", key)
 			}
-			fmt.Println("Downloaded blob: ", v.Name)
+			err = d.writeFile(fileName, out.Body)
+			out.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, fileName)
+			fmt.Println("Downloaded S3 object: ", key)
 		}
+	}
+	return files, nil
+}
+
+func (d *downloader) writeFile(fileName string, r io.Reader) error {
+	dst := filepath.Join(d.outputDir, filepath.Base(fileName))
+	f, err := os.Create(dst)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return errors.Wrap(err, "failed to write file")
+	}
+	return nil
+}
+
+// checkBlobSASPermissions returns an error when the signed permissions of the SAS do not include read and list.
+// The permissions of a SAS referring to a stored access policy are not in the URL, the download reports them.
+func checkBlobSASPermissions(u *url.URL) error {
+	query := u.Query()
+	permissions := query.Get("sp")
+	if permissions == "" && query.Get("si") != "" {
 		return nil
-	},
+	}
+	if !strings.Contains(permissions, "r") || !strings.Contains(permissions, "l") {
+		return errors.Wrapf(ErrBlobSASPermissions, "got permissions %q", permissions)
+	}
+	return nil
+}
+
+func downloadFromBlob(blobURL, name string, nodes []string, outputDir string) error {
+	u, err := url.Parse(blobURL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse SAS URL %s", blobURL)
+	}
+	if err = checkBlobSASPermissions(u); err != nil {
+		return err
+	}
+
+	// blobService, err := storage.NewAccountSASClientFromEndpointToken(u.String(), u.Query().Encode()).GetBlobService()
+	b, err := storage.NewAccountSASClientFromEndpointToken(u.String(), u.Query().Encode())
+	if err != nil {
+		return errors.Wrap(err, "failed to create storage account client")
+	}
+
+	blobService := b.GetBlobService()
+	containerPath := strings.TrimLeft(u.Path, "/")
+	splitPath := strings.SplitN(containerPath, "/", 2) //nolint:gomnd // TODO string splitting probably isn't the right way to parse this URL?
+	containerName := splitPath[0]
+
+	params := storage.ListBlobsParameters{Prefix: name}
+	blobList, err := blobService.GetContainerReference(containerName).ListBlobs(params)
+	if err != nil {
+		return errors.Wrap(err, "failed to list blobstore ")
+	}
+
+	blobs := []storage.Blob{}
+	for _, v := range blobList.Blobs {
+		if isCaptureArtifact(name, nodes, path.Base(v.Name)) {
+			blobs = append(blobs, v)
+		}
+	}
+	if len(blobs) == 0 {
+		return errors.Errorf("no blobs found for capture %s", name)
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil { //nolint:gomnd // permissive bitmask for the output directory
+		return errors.Wrapf(err, "failed to create output directory %s", outputDir)
+	}
+
+	for _, v := range blobs {
+		blob := blobService.GetContainerReference(containerName).GetBlobReference(v.Name)
+		readCloser, err := blob.Get(&storage.GetBlobOptions{})
+		if err != nil {
+			return errors.Wrap(err, "failed to read from blobstore")
+		}
+
+		defer readCloser.Close()
+
+		blobData, err := io.ReadAll(readCloser)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain blob from blobstore")
+		}
+
+		err = os.WriteFile(filepath.Join(outputDir, v.Name), blobData, 0o644) //nolint:gosec,gomnd // intentionally permissive bitmask
+		if err != nil {
+			return errors.Wrap(err, "failed to write file")
+		}
+		fmt.Println("Downloaded blob: ", v.Name)
+	}
+	return nil
 }
 
 func init() {
 	capture.AddCommand(downloadCapture)
+	downloadCapture.Flags().StringVarP(&downloadOutputDir, "output", "o", DefaultDownloadOutputDir, "Directory to write the downloaded capture files to")
+	downloadCapture.Flags().StringVar(&downloadHelperImage, "helper-image", DefaultDownloadHelperImage,
+		"Image of the pod reading the capture files from the PersistentVolumeClaim or the host path. It must contain sh and tar")
 }
@@ -0,0 +1,234 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+
+	retinacmd "github.com/microsoft/retina/cli/cmd"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
+)
+
+const (
+	downloadPodContainerName = "download"
+	downloadPodVolumeName    = "capture"
+	// downloadPodMountPath is where the PersistentVolumeClaim or the host path is mounted in the download pod.
+	downloadPodMountPath = "/capture"
+
+	downloadPodPollInterval = 2 * time.Second
+	downloadPodStartTimeout = 2 * time.Minute
+	// downloadPodLifetime bounds the life of the download pod if the CLI does not delete it.
+	downloadPodLifetime = "3600"
+)
+
+// downloadFromPVC downloads the artifacts from the PersistentVolumeClaim through a download pod mounting it.
+func (d *downloader) downloadFromPVC(ctx context.Context, pvc string) ([]string, error) {
+	volume := corev1.Volume{
+		Name: downloadPodVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc, ReadOnly: true},
+		},
+	}
+	return d.downloadWithPod(ctx, d.downloadPod(volume, ""))
+}
+
+// downloadFromHostPath downloads the artifacts from the host path of the node through a download pod on the node.
+func (d *downloader) downloadFromHostPath(ctx context.Context, hostPath, node string) ([]string, error) {
+	hostPathType := corev1.HostPathDirectory
+	volume := corev1.Volume{
+		Name: downloadPodVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: hostPath, Type: &hostPathType},
+		},
+	}
+	return d.downloadWithPod(ctx, d.downloadPod(volume, node))
+}
+
+// downloadPod returns a short-lived pod mounting the volume read-only, on the node if it is not empty.
+func (d *downloader) downloadPod(volume corev1.Volume, node string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-download-", d.name),
+			Namespace:    d.namespace,
+			Labels:       captureUtils.GetContainerLabelsFromCaptureName(d.name),
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      node,
+			RestartPolicy: corev1.RestartPolicyNever,
+			NodeSelector:  map[string]string{corev1.LabelOSStable: "linux"},
+			Containers: []corev1.Container{
+				{
+					Name:    downloadPodContainerName,
+					Image:   d.helperImage,
+					Command: []string{"sleep", downloadPodLifetime},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: volume.Name, MountPath: downloadPodMountPath, ReadOnly: true},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{volume},
+			Tolerations: []corev1.Toleration{
+				{
+					Key:      "CriticalAddonsOnly",
+					Operator: "Exists",
+				},
+				{
+					Effect:   "NoExecute",
+					Operator: "Exists",
+				},
+				{
+					Effect:   "NoSchedule",
+					Operator: "Exists",
+				},
+			},
+		},
+	}
+}
+
+// downloadWithPod creates the download pod, streams the artifacts of the Capture as a tar archive
+// out of the pod and extracts them into the output directory, then deletes the pod.
+func (d *downloader) downloadWithPod(ctx context.Context, pod *corev1.Pod) ([]string, error) {
+	pod, err := d.kubeClient.CoreV1().Pods(d.namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create download pod")
+	}
+	defer func() {
+		// The context may be canceled, delete the pod anyway.
+		if err := d.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil {
+			retinacmd.Logger.Error("Failed to delete download pod, please manually delete it",
+				zap.String("namespace", pod.Namespace), zap.String("pod name", pod.Name), zap.Error(err))
+		}
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, downloadPodPollInterval, downloadPodStartTimeout, true, func(ctx context.Context) (bool, error) {
+		p, err := d.kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get download pod")
+		}
+		switch p.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, errors.Errorf("download pod %s exited with phase %s", p.Name, p.Status.Phase)
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "download pod %s is not running", pod.Name)
+	}
+
+	pr, pw := io.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		err := d.exec(ctx, d.kubeClient, d.kubeConfig, pod, tarArtifactsCommand(d.name), pw)
+		pw.CloseWithError(err)
+		errCh <- err
+	}()
+
+	files, err := d.extract(pr)
+	// Unblock the command if the extraction stopped early.
+	pr.Close()
+	if execErr := <-errCh; execErr != nil {
+		return nil, errors.Wrapf(execErr, "failed to stream capture files from pod %s", pod.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		fmt.Printf("Downloaded %s from pod %s\n", f, pod.Name)
+	}
+	return files, nil
+}
+
+// tarArtifactsCommand writes a tar archive of the artifacts of the Capture to stdout, nothing if there are none.
+func tarArtifactsCommand(name string) []string {
+	// Capture names are DNS subdomains, safe to use in the glob.
+	script := fmt.Sprintf(`cd %s && set -- && for f in %s-*.tar.gz; do [ -f "$f" ] && set -- "$@" "$f"; done; [ $# -eq 0 ] || tar cf - "$@"`,
+		downloadPodMountPath, name)
+	return []string{"sh", "-c", script}
+}
+
+// extract writes the artifacts of the tar stream into the output directory.
+func (d *downloader) extract(r io.Reader) ([]string, error) {
+	files := []string{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read capture files archive")
+		}
+		if hdr.Typeflag != tar.TypeReg || !isCaptureArtifact(d.name, d.nodes, hdr.FileInfo().Name()) {
+			continue
+		}
+		// writeFile keeps only the base name of the entry, which cannot escape the output directory.
+		if err := d.writeFile(hdr.Name, tr); err != nil {
+			return nil, err
+		}
+		files = append(files, hdr.FileInfo().Name())
+	}
+}
+
+// execInPod runs the command in the download container of the pod.
+func execInPod(ctx context.Context, kubeClient kubernetes.Interface, kubeConfig *rest.Config, pod *corev1.Pod, command []string, stdout io.Writer) error {
+	req := kubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(pod.Namespace).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: downloadPodContainerName,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(kubeConfig, "POST", req.URL())
+	if err != nil {
+		return errors.Wrap(err, "failed to create executor")
+	}
+	stderr := &limitedBuffer{}
+	if err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: stdout, Stderr: stderr}); err != nil {
+		return errors.Wrapf(err, "command failed: %s", stderr.String())
+	}
+	return nil
+}
+
+// limitedBuffer keeps the beginning of the stderr of the command for the error message.
+type limitedBuffer struct {
+	buf []byte
+}
+
+const limitedBufferSize = 4096
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if n := limitedBufferSize - len(b.buf); n > 0 {
+		if len(p) < n {
+			n = len(p)
+		}
+		b.buf = append(b.buf, p[:n]...)
+	}
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	return string(b.buf)
+}
@@ -0,0 +1,336 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
+)
+
+func captureJob(name, node string, env []corev1.EnvVar, mounts []corev1.VolumeMount) *batchv1.Job {
+	env = append(env, corev1.EnvVar{Name: captureConstants.NodeHostNameEnvKey, Value: node})
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-" + node,
+			Namespace: "capture",
+			Labels:    captureUtils.GetJobLabelsFromCaptureName(name),
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: captureConstants.CaptureContainername, Env: env, VolumeMounts: mounts}},
+				},
+			},
+		},
+	}
+}
+
+func newCRClient(t *testing.T, objs ...*retinav1alpha1.Capture) *crfake.ClientBuilder {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, retinav1alpha1.AddToScheme(scheme))
+	b := crfake.NewClientBuilder().WithScheme(scheme)
+	for _, o := range objs {
+		b = b.WithObjects(o)
+	}
+	return b
+}
+
+func TestGetCaptureArtifacts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("from the Capture", func(t *testing.T) {
+		capture := &retinav1alpha1.Capture{
+			ObjectMeta: metav1.ObjectMeta{Name: "cap", Namespace: "capture"},
+			Spec: retinav1alpha1.CaptureSpec{
+				OutputConfiguration: retinav1alpha1.OutputConfiguration{
+					HostPath: ptr.To("/mnt/retina/captures"),
+					S3Upload: &retinav1alpha1.S3Upload{Bucket: "bucket", Endpoint: "http://minio:9000", SecretName: "s3-secret"},
+				},
+			},
+		}
+		kubeClient := fake.NewSimpleClientset(
+			captureJob("cap", "node1", nil, nil),
+			captureJob("cap", "node2", nil, nil),
+			captureJob("other", "node3", nil, nil),
+		)
+
+		artifacts, err := getCaptureArtifacts(ctx, kubeClient, newCRClient(t, capture).Build(), "cap", "capture")
+		require.NoError(t, err)
+		assert.Equal(t, capture.Spec.OutputConfiguration, artifacts.output)
+		assert.ElementsMatch(t, []string{"node1", "node2"}, artifacts.nodes)
+	})
+
+	t.Run("from the jobs of the CLI", func(t *testing.T) {
+		env := []corev1.EnvVar{
+			{Name: string(captureConstants.CaptureOutputLocationEnvKeyPersistentVolumeClaim), Value: "captures"},
+			{Name: string(captureConstants.CaptureOutputLocationEnvKeyS3Bucket), Value: "bucket"},
+			{Name: string(captureConstants.CaptureOutputLocationEnvKeyS3Endpoint), Value: "http://minio:9000"},
+			{Name: string(captureConstants.CaptureOutputLocationEnvKeyS3Path), Value: "retina/captures"},
+		}
+		mounts := []corev1.VolumeMount{
+			{Name: "capture-s3-upload-secretx2k4", MountPath: captureConstants.CaptureOutputLocationS3UploadSecretPath},
+			{Name: "capture-blob-upload-secretq8z1", MountPath: captureConstants.CaptureOutputLocationBlobUploadSecretPath},
+		}
+		kubeClient := fake.NewSimpleClientset(captureJob("cap", "node1", env, mounts))
+
+		artifacts, err := getCaptureArtifacts(ctx, kubeClient, newCRClient(t).Build(), "cap", "capture")
+		require.NoError(t, err)
+		assert.Equal(t, retinav1alpha1.OutputConfiguration{
+			PersistentVolumeClaim: ptr.To("captures"),
+			BlobUpload:            ptr.To("capture-blob-upload-secretq8z1"),
+			S3Upload: &retinav1alpha1.S3Upload{
+				Endpoint:   "http://minio:9000",
+				Bucket:     "bucket",
+				SecretName: "capture-s3-upload-secretx2k4",
+				Path:       "retina/captures",
+			},
+		}, artifacts.output)
+		assert.Equal(t, []string{"node1"}, artifacts.nodes)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := getCaptureArtifacts(ctx, fake.NewSimpleClientset(), newCRClient(t).Build(), "cap", "capture")
+		require.Error(t, err)
+	})
+}
+
+// s3Stub serves ListObjectsV2 and GetObject of a single bucket with path-style requests.
+func s3Stub(t *testing.T, bucket string, objects map[string]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, "/"+bucket) {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=akid/") {
+			http.Error(w, "unexpected credentials", http.StatusForbidden)
+			return
+		}
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+bucket), "/")
+		if key == "" {
+			prefix := r.URL.Query().Get("prefix")
+			var b strings.Builder
+			fmt.Fprintf(&b, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult><Name>%s</Name><IsTruncated>false</IsTruncated>`, bucket)
+			for k, v := range objects {
+				if strings.HasPrefix(k, prefix) {
+					fmt.Fprintf(&b, "<Contents><Key>%s</Key><Size>%d</Size></Contents>", k, len(v))
+				}
+			}
+			b.WriteString("</ListBucketResult>")
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = io.WriteString(w, b.String())
+			return
+		}
+		v, ok := objects[key]
+		if !ok {
+			http.Error(w, "no such key", http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, v)
+	}))
+}
+
+func TestDownloadFromS3(t *testing.T) {
+	srv := s3Stub(t, "bucket", map[string]string{
+		"retina/captures/tmp/cap-node1-20240101000000UTC.tar.gz":   "node1",
+		"retina/captures/tmp/cap-node2-20240101000000UTC.tar.gz":   "node2",
+		"retina/captures/tmp/other-node1-20240101000000UTC.tar.gz": "other",
+		"retina/captures/tmp/cap-2-node1-20240101000000UTC.tar.gz": "capture whose name extends cap",
+		"retina/captures/tmp/cap-node1.txt":                        "not an artifact",
+		"elsewhere/cap-node3-20240101000000UTC.tar.gz":             "outside of the path",
+	})
+	defer srv.Close()
+
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3-secret", Namespace: "capture"},
+		Data: map[string][]byte{
+			captureConstants.CaptureOutputLocationS3UploadAccessKeyID:     []byte("akid"),
+			captureConstants.CaptureOutputLocationS3UploadSecretAccessKey: []byte("secret"),
+		},
+	})
+	outputDir := t.TempDir()
+	d := &downloader{kubeClient: kubeClient, name: "cap", namespace: "capture", outputDir: outputDir}
+
+	err := d.download(context.Background(), &captureArtifacts{
+		output: retinav1alpha1.OutputConfiguration{
+			S3Upload: &retinav1alpha1.S3Upload{Endpoint: srv.URL, Bucket: "bucket", SecretName: "s3-secret", Path: "retina/captures"},
+		},
+		nodes: []string{"node1", "node2"},
+	})
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	data, err := os.ReadFile(filepath.Join(outputDir, "cap-node2-20240101000000UTC.tar.gz"))
+	require.NoError(t, err)
+	assert.Equal(t, "node2", string(data))
+}
+
+// runningPods makes the fake clientset name the created pods and report them as running.
+func runningPods(kubeClient *fake.Clientset) {
+	n := 0
+	kubeClient.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		n++
+		pod.Name = fmt.Sprintf("%s%d", pod.GenerateName, n)
+		pod.Status.Phase = corev1.PodRunning
+		return false, nil, nil
+	})
+}
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var b strings.Builder
+	tw := tar.NewWriter(&b)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return []byte(b.String())
+}
+
+func TestDownloadFromHostPathAndPVC(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	runningPods(kubeClient)
+
+	outputDir := t.TempDir()
+	execPods := []*corev1.Pod{}
+	d := &downloader{
+		kubeClient:  kubeClient,
+		name:        "cap",
+		namespace:   "capture",
+		outputDir:   outputDir,
+		helperImage: "helper:latest",
+		exec: func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, pod *corev1.Pod, command []string, stdout io.Writer) error {
+			assert.Equal(t, tarArtifactsCommand("cap"), command)
+			execPods = append(execPods, pod)
+			// The PVC stores the tarballs of every node.
+			node, timestamp := pod.Spec.NodeName, "20240101000000UTC"
+			if node == "" {
+				node, timestamp = "node2", "20240103000000UTC"
+			}
+			_, err := stdout.Write(tarball(t, map[string]string{
+				fmt.Sprintf("cap-%s-%s.tar.gz", node, timestamp): node,
+				"../../etc/cap-node1-20240102000000UTC.tar.gz":   "evil",
+				"notes.txt": "not an artifact",
+			}))
+			return err
+		},
+	}
+
+	err := d.download(context.Background(), &captureArtifacts{
+		output: retinav1alpha1.OutputConfiguration{
+			HostPath:              ptr.To("/mnt/retina/captures"),
+			PersistentVolumeClaim: ptr.To("captures"),
+		},
+		nodes: []string{"node1", "node2"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, execPods, 3)
+	assert.Equal(t, "captures", execPods[0].Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Empty(t, execPods[0].Spec.NodeName)
+	for i, node := range []string{"node1", "node2"} {
+		pod := execPods[i+1]
+		assert.Equal(t, node, pod.Spec.NodeName)
+		assert.Equal(t, "/mnt/retina/captures", pod.Spec.Volumes[0].HostPath.Path)
+		assert.True(t, pod.Spec.Containers[0].VolumeMounts[0].ReadOnly)
+		assert.Equal(t, "helper:latest", pod.Spec.Containers[0].Image)
+	}
+
+	// The download pods are deleted.
+	pods, err := kubeClient.CoreV1().Pods("capture").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	names := []string{}
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	// The entry escaping the archive is written in the output directory.
+	assert.ElementsMatch(t, []string{
+		"cap-node2-20240103000000UTC.tar.gz",
+		"cap-node1-20240101000000UTC.tar.gz",
+		"cap-node2-20240101000000UTC.tar.gz",
+		"cap-node1-20240102000000UTC.tar.gz",
+	}, names)
+}
+
+func TestDownloadNoArtifacts(t *testing.T) {
+	d := &downloader{kubeClient: fake.NewSimpleClientset(), name: "cap", namespace: "capture", outputDir: t.TempDir()}
+	err := d.download(context.Background(), &captureArtifacts{})
+	require.Error(t, err)
+}
+
+func TestIsCaptureArtifact(t *testing.T) {
+	nodes := []string{"node1", "aks-nodepool1-12345678-vmss000000"}
+	for fileName, want := range map[string]bool{
+		"cap-node1-20240101000000UTC.tar.gz":                             true,
+		"cap-aks-nodepool1-12345678-vmss000000-20240101000000UTC.tar.gz": true,
+		"cap-node2-20240101000000UTC.tar.gz":                             false,
+		"cap-2-node1-20240101000000UTC.tar.gz":                           false,
+		"cap-node1-20240101000000UTC.pcap":                               false,
+		"cap-node1-notatimestamp.tar.gz":                                 false,
+		"other-node1-20240101000000UTC.tar.gz":                           false,
+	} {
+		assert.Equal(t, want, isCaptureArtifact("cap", nodes, fileName), fileName)
+	}
+
+	// Without the nodes, any node name is accepted.
+	assert.True(t, isCaptureArtifact("cap", nil, "cap-2-node1-20240101000000UTC.tar.gz"))
+	assert.False(t, isCaptureArtifact("cap", nil, "cap-20240101000000UTC.tar.gz"))
+}
+
+func TestCheckBlobSASPermissions(t *testing.T) {
+	for rawURL, wantErr := range map[string]bool{
+		"https://account.blob.core.windows.net/container?sp=racwl&sv=2022-11-02&sr=c&sig=x": false,
+		"https://account.blob.core.windows.net/container?sp=rl&sv=2022-11-02&sr=c&sig=x":    false,
+		"https://account.blob.core.windows.net/container?sp=cw&sv=2022-11-02&sr=c&sig=x":    true,
+		"https://account.blob.core.windows.net/container?sp=rw&sv=2022-11-02&sr=c&sig=x":    true,
+		"https://account.blob.core.windows.net/container?sv=2022-11-02&sr=c&sig=x":          true,
+		// The permissions of a stored access policy are not in the URL.
+		"https://account.blob.core.windows.net/container?si=policy&sv=2022-11-02&sr=c&sig=x": false,
+	} {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		err = checkBlobSASPermissions(u)
+		if wantErr {
+			require.ErrorIs(t, err, ErrBlobSASPermissions, rawURL)
+		} else {
+			require.NoError(t, err, rawURL)
+		}
+	}
+}
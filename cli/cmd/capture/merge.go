@@ -0,0 +1,178 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	retinacmd "github.com/microsoft/retina/cli/cmd"
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/capture/merge"
+)
+
+var (
+	mergeInputDir string
+	mergeOutput   string
+	mergeResolve  bool
+)
+
+const (
+	DefaultMergeInputDir string = "."
+	DefaultMergeResolve  bool   = true
+)
+
+var mergeExample = templates.Examples(i18n.T(`
+		# Merge the tarballs of the Retina Capture "retina-capture-8v6wd" in the current directory into retina-capture-8v6wd.pcapng
+		kubectl retina capture merge --name retina-capture-8v6wd
+
+		# Merge the given tarballs into merged.pcapng without naming the pods from the cluster
+		kubectl retina capture merge --name retina-capture-8v6wd --output merged.pcapng --resolve-pods=false \
+			retina-capture-8v6wd-node1-20240320013600UTC.tar.gz retina-capture-8v6wd-node2-20240320013600UTC.tar.gz
+		`))
+
+var mergeCapture = &cobra.Command{
+	Use:     "merge [tarballs]",
+	Short:   "Merge the per-node captures of a Retina Capture into a single pcapng file",
+	Example: mergeExample,
+	RunE: func(_ *cobra.Command, args []string) error {
+		tarballs := args
+		if len(tarballs) == 0 {
+			var err error
+			tarballs, err = filepath.Glob(filepath.Join(mergeInputDir, *opts.Name+"-*.tar.gz"))
+			if err != nil {
+				return errors.Wrap(err, "failed to find capture tarballs")
+			}
+		}
+		if len(tarballs) == 0 {
+			return errors.Errorf("no tarballs of capture %s found in %s", *opts.Name, mergeInputDir)
+		}
+
+		output := mergeOutput
+		if output == "" {
+			output = *opts.Name + ".pcapng"
+		}
+
+		mergeOpts := merge.Options{CaptureName: *opts.Name}
+		if mergeResolve {
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+			defer cancel()
+			names, err := clusterNames(ctx)
+			if err != nil {
+				retinacmd.Logger.Warn("Failed to read the pods from the cluster, only the nodes are named", zap.Error(err))
+			} else {
+				mergeOpts.Resolver = names
+			}
+		}
+
+		f, err := os.Create(output)
+		if err != nil {
+			return errors.Wrap(err, "failed to create output file")
+		}
+		defer f.Close()
+
+		result, err := merge.Merge(f, tarballs, mergeOpts)
+		if err != nil {
+			return errors.Wrap(err, "failed to merge captures")
+		}
+		fmt.Printf("Merged %d packets of nodes %v into %s\n", result.Packets, result.Nodes, output)
+		return nil
+	},
+}
+
+func clusterNames(ctx context.Context) (merge.Names, error) {
+	kubeConfig, err := opts.ToRESTConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compose k8s rest config")
+	}
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize kubernetes client")
+	}
+	scheme := runtime.NewScheme()
+	if err = retinav1alpha1.AddToScheme(scheme); err != nil {
+		return nil, errors.Wrap(err, "failed to add Retina CRDs to scheme")
+	}
+	crClient, err := client.New(kubeConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize Retina CRD client")
+	}
+	return getClusterNames(ctx, kubeClient, crClient)
+}
+
+// getClusterNames names the IP addresses of the pods from the RetinaEndpoints cached by Retina,
+// or from the pods when the RetinaEndpoints are not enabled, and the IP addresses of the nodes.
+func getClusterNames(ctx context.Context, kubeClient kubernetes.Interface, crClient client.Client) (merge.Names, error) {
+	names := merge.Names{}
+
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list nodes")
+	}
+	for i := range nodes.Items {
+		for _, addr := range nodes.Items[i].Status.Addresses {
+			if addr.Type == corev1.NodeInternalIP || addr.Type == corev1.NodeExternalIP {
+				names[addr.Address] = "node " + nodes.Items[i].Name
+			}
+		}
+	}
+
+	endpoints := &retinav1alpha1.RetinaEndpointList{}
+	err = crClient.List(ctx, endpoints)
+	if err != nil && !meta.IsNoMatchError(err) {
+		return nil, errors.Wrap(err, "failed to list RetinaEndpoints")
+	}
+	if err == nil && len(endpoints.Items) > 0 {
+		for i := range endpoints.Items {
+			ep := &endpoints.Items[i]
+			name := fmt.Sprintf("pod %s/%s", ep.Namespace, ep.Name)
+			if ep.Spec.PodIP != "" {
+				names[ep.Spec.PodIP] = name
+			}
+			for _, ip := range ep.Spec.PodIPs {
+				names[ip] = name
+			}
+		}
+		return names, nil
+	}
+
+	pods, err := kubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pods")
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		// Host network pods use the IP addresses of their node.
+		if pod.Spec.HostNetwork {
+			continue
+		}
+		for _, ip := range pod.Status.PodIPs {
+			names[ip.IP] = fmt.Sprintf("pod %s/%s", pod.Namespace, pod.Name)
+		}
+	}
+	return names, nil
+}
+
+func init() {
+	capture.AddCommand(mergeCapture)
+	mergeCapture.Flags().StringVar(&mergeInputDir, "input-dir", DefaultMergeInputDir, "Directory of the capture tarballs, when no tarball is given")
+	mergeCapture.Flags().StringVarP(&mergeOutput, "output", "o", "", "Path of the merged pcapng file, <capture name>.pcapng by default")
+	mergeCapture.Flags().BoolVar(&mergeResolve, "resolve-pods", DefaultMergeResolve, "Name the pods and nodes of the packets from the cluster")
+}
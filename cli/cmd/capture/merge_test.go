@@ -0,0 +1,61 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+)
+
+func TestGetClusterNames(t *testing.T) {
+	ctx := context.Background()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeInternalIP, Address: "10.224.0.4"},
+			{Type: corev1.NodeHostName, Address: "node1"},
+		}},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "default"},
+		Status:     corev1.PodStatus{PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}}},
+	}
+	hostPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "retina-agent", Namespace: "kube-system"},
+		Spec:       corev1.PodSpec{HostNetwork: true},
+		Status:     corev1.PodStatus{PodIPs: []corev1.PodIP{{IP: "10.224.0.4"}}},
+	}
+
+	t.Run("from the pods", func(t *testing.T) {
+		names, err := getClusterNames(ctx, fake.NewSimpleClientset(node, pod, hostPod), newCRClient(t).Build())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"10.224.0.4": "node node1",
+			"10.0.0.1":   "pod default/client",
+			"fd00::1":    "pod default/client",
+		}, map[string]string(names))
+	})
+
+	t.Run("from the RetinaEndpoints", func(t *testing.T) {
+		ep := &retinav1alpha1.RetinaEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "default"},
+			Spec:       retinav1alpha1.RetinaEndpointSpec{PodIP: "10.0.1.1", PodIPs: []string{"10.0.1.1"}},
+		}
+		crClient := newCRClient(t).WithObjects(ep).Build()
+		names, err := getClusterNames(ctx, fake.NewSimpleClientset(node, pod), crClient)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"10.224.0.4": "node node1",
+			"10.0.1.1":   "pod default/server",
+		}, map[string]string(names))
+	})
+}
@@ -6,15 +6,19 @@ package capture
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strings"
 	"text/tabwriter"
 	"time"
 
+	pkgcapture "github.com/microsoft/retina/pkg/capture"
 	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
 	"github.com/microsoft/retina/pkg/label"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	durationUtil "k8s.io/apimachinery/pkg/util/duration"
@@ -54,6 +58,17 @@ func listCapturesAndPrintCaptureResults(ctx context.Context, kubeClient *kuberne
 		return nil
 	}
 	printCaptureResult(jobList.Items)
+
+	// The capture pods report the results of the capture on their nodes in their termination messages.
+	podListOpt := metav1.ListOptions{LabelSelector: labels.SelectorFromSet(captureUtils.GetContainerLabelsFromCaptureName(name)).String()}
+	if len(name) == 0 {
+		podListOpt.LabelSelector = labels.SelectorFromSet(map[string]string{label.AppLabel: captureConstants.CaptureAppname}).String()
+	}
+	podList, err := kubeClient.CoreV1().Pods(namespace).List(ctx, podListOpt)
+	if err != nil {
+		return err
+	}
+	printCaptureNodeResult(os.Stdout, jobList.Items, podList.Items)
 	return nil
 }
 
@@ -104,3 +119,43 @@ func printCaptureResult(captureJobs []batchv1.Job) {
 	w.Flush()
 	fmt.Println()
 }
+
+// printCaptureNodeResult prints the results of the captures on each node of their jobs into properly aligned text.
+func printCaptureNodeResult(out io.Writer, captureJobs []batchv1.Job, capturePods []corev1.Pod) {
+	captureToJobs := map[string][]batchv1.Job{}
+	for _, job := range captureJobs {
+		captureName, ok := job.Labels[label.CaptureNameLabel]
+		if !ok {
+			continue
+		}
+		captureRef := fmt.Sprintf("%s/%s", job.Namespace, captureName)
+		captureToJobs[captureRef] = append(captureToJobs[captureRef], job)
+	}
+	if len(captureToJobs) == 0 {
+		return
+	}
+	captureRefs := make([]string, 0, len(captureToJobs))
+	for captureRef := range captureToJobs {
+		captureRefs = append(captureRefs, captureRef)
+	}
+	sort.Strings(captureRefs)
+
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tCAPTURE NAME\tNODE\tPHASE\tPACKETS\tBYTES\tARTIFACTS\tERROR")
+	for _, captureRef := range captureRefs {
+		captureNamespace, captureName, _ := strings.Cut(captureRef, "/")
+		for _, node := range pkgcapture.NodeStatusesFromJobs(captureToJobs[captureRef], capturePods) {
+			artifacts := make([]string, 0, len(node.Artifacts))
+			for _, artifact := range node.Artifacts {
+				artifacts = append(artifacts, artifact.URI)
+			}
+			// Only the first line of the error is printed to keep the table aligned.
+			nodeErr, _, _ := strings.Cut(node.Error, "\n")
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\t\n", captureNamespace, captureName, node.NodeName, node.Phase,
+				node.PacketsCaptured, node.BytesCaptured, strings.Join(artifacts, ","), nodeErr)
+		}
+	}
+	w.Flush()
+	fmt.Fprintln(out)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
+)
+
+func TestPrintCaptureNodeResult(t *testing.T) {
+	captureJob := func(name, nodeName string) batchv1.Job {
+		return batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "capture", UID: types.UID(name), Labels: captureUtils.GetJobLabelsFromCaptureName("cap")},
+			Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: captureConstants.CaptureContainername,
+				Env:  []corev1.EnvVar{{Name: captureConstants.NodeHostNameEnvKey, Value: nodeName}},
+			}}}}},
+		}
+	}
+	job1, job2 := captureJob("cap-abc", "node1"), captureJob("cap-def", "node2")
+	job1.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	isController := true
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "cap-abc-xyz",
+			Namespace:       "capture",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Job", Name: job1.Name, UID: job1.UID, Controller: &isController}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodSucceeded,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: captureConstants.CaptureContainername,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					Message: `{"packetsCaptured":10,"bytesCaptured":1000,"artifacts":[{"location":"HostPath","uri":"/mnt/capture/cap.tar.gz"}]}`,
+				}},
+			}},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	printCaptureNodeResult(out, []batchv1.Job{job2, job1}, []corev1.Pod{pod})
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, []string{"NAMESPACE", "CAPTURE", "NAME", "NODE", "PHASE", "PACKETS", "BYTES", "ARTIFACTS", "ERROR"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"capture", "cap", "node1", "Succeeded", "10", "1000", "/mnt/capture/cap.tar.gz"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"capture", "cap", "node2", "Pending", "0", "0"}, strings.Fields(lines[2]))
+}
@@ -3,6 +3,11 @@
 package cmd
 
 import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/microsoft/retina/pkg/api"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -12,18 +17,61 @@ var trace = &cobra.Command{
 	Short: "Retrieve status or results from Retina",
 }
 
+var startTrace = &cobra.Command{
+	Use:   "start",
+	Short: "Start a network trace and print its operation ID",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		operationID, _ := cmd.Flags().GetString("operationID")
+		filter, _ := cmd.Flags().GetString("filter")
+		duration, _ := cmd.Flags().GetDuration("duration")
+		t, err := RetinaClient.StartTrace(operationID, filter, duration)
+		if err != nil {
+			return errors.Wrap(err, "failed to start trace")
+		}
+		return printTrace(t)
+	},
+}
+
 var getTrace = &cobra.Command{
 	Use:   "get",
 	Short: "Retrieve network trace results with operation ID",
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		operationID, _ := cmd.Flags().GetString("operationID")
-		return errors.Wrap(RetinaClient.GetTrace(operationID), "failed to get traces")
+		t, err := RetinaClient.GetTrace(operationID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get traces")
+		}
+		return printTrace(t)
 	},
 }
 
+var stopTrace = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop a running network trace with operation ID",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		operationID, _ := cmd.Flags().GetString("operationID")
+		t, err := RetinaClient.StopTrace(operationID)
+		if err != nil {
+			return errors.Wrap(err, "failed to stop trace")
+		}
+		return printTrace(t)
+	},
+}
+
+func printTrace(t *api.Trace) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return errors.Wrap(enc.Encode(t), "failed to print trace")
+}
+
 func init() {
+	startTrace.Flags().String("operationID", "", "Network Trace Operation ID, generated by Retina when empty")
+	startTrace.Flags().String("filter", "", "Space separated key=value terms, keys are ip, srcip, dstip, port, srcport, dstport, protocol, namespace and pod")
+	startTrace.Flags().Duration("duration", 30*time.Second, "Duration of the network trace")
 	getTrace.Flags().String("operationID", "", "Network Trace Operation ID")
 	_ = getTrace.MarkFlagRequired("operationID")
-	trace.AddCommand(getTrace)
+	stopTrace.Flags().String("operationID", "", "Network Trace Operation ID")
+	_ = stopTrace.MarkFlagRequired("operationID")
+	trace.AddCommand(startTrace, getTrace, stopTrace)
 	Retina.AddCommand(trace)
 }
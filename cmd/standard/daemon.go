@@ -27,6 +27,7 @@ import (
 	"github.com/go-logr/zapr"
 	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
 	"github.com/microsoft/retina/internal/buildinfo"
+	"github.com/microsoft/retina/pkg/capture/retention"
 	"github.com/microsoft/retina/pkg/config"
 	controllercache "github.com/microsoft/retina/pkg/controllers/cache"
 	mcc "github.com/microsoft/retina/pkg/controllers/daemon/metricsconfiguration"
@@ -35,13 +36,16 @@ import (
 	pc "github.com/microsoft/retina/pkg/controllers/daemon/pod"
 	kec "github.com/microsoft/retina/pkg/controllers/daemon/retinaendpoint"
 	sc "github.com/microsoft/retina/pkg/controllers/daemon/service"
+	tcc "github.com/microsoft/retina/pkg/controllers/daemon/tracesconfiguration"
 
 	"github.com/microsoft/retina/pkg/enricher"
+	"github.com/microsoft/retina/pkg/exporter"
 	"github.com/microsoft/retina/pkg/log"
 	cm "github.com/microsoft/retina/pkg/managers/controllermanager"
 	"github.com/microsoft/retina/pkg/managers/filtermanager"
 	"github.com/microsoft/retina/pkg/metrics"
 	mm "github.com/microsoft/retina/pkg/module/metrics"
+	tm "github.com/microsoft/retina/pkg/module/traces"
 	"github.com/microsoft/retina/pkg/pubsub"
 	"github.com/microsoft/retina/pkg/telemetry"
 )
@@ -236,6 +240,7 @@ func (d *Daemon) Start() error {
 	ctx := ctrl.SetupSignalHandler()
 	ctrl.SetLogger(zapr.NewLogger(zl.Logger.Named("controller-runtime")))
 
+	var flowReader exporter.FlowReaderFunc
 	if daemonConfig.EnablePodLevel {
 		pubSub := pubsub.New()
 		controllerCache := controllercache.New(pubSub)
@@ -247,6 +252,8 @@ func (d *Daemon) Start() error {
 		}
 		defer fm.Stop() //nolint:errcheck // best effort
 		enrich.Run()
+		d.startNATResolver(ctx, enrich, controllerCache)
+		flowReader = enrich.ExportReader
 		metricsModule := mm.InitModule(ctx, daemonConfig, pubSub, enrich, fm, controllerCache)
 
 		if !daemonConfig.RemoteContext {
@@ -292,6 +299,16 @@ func (d *Daemon) Start() error {
 				mainLogger.Fatal("unable to create metricsConfigController", zap.Error(err))
 			}
 		}
+
+		if daemonConfig.EnableTraces {
+			mainLogger.Info("Initializing TracesConfig controller")
+			tracesModule := tm.NewModule(ctx, pubSub, enrich, fm, controllerCache)
+			tracesModule.Run()
+			tracesConfigController := tcc.New(mgr.GetClient(), mgr.GetScheme(), tracesModule)
+			if err := tracesConfigController.SetupWithManager(mgr); err != nil {
+				mainLogger.Fatal("unable to create tracesConfigController", zap.Error(err))
+			}
+		}
 	}
 
 	controllerMgr, err := cm.NewControllerManager(daemonConfig, cl, tel)
@@ -309,6 +326,28 @@ func (d *Daemon) Start() error {
 	// start heartbeat goroutine for application insights
 	go tel.Heartbeat(ctx, daemonConfig.TelemetryInterval)
 
+	if daemonConfig.OtelExporter.Enabled {
+		otelAgent, err := exporter.NewOtelAgent(zl.Named("otel-exporter"), daemonConfig.OtelExporter, exporter.CombinedGatherer, flowReader)
+		if err != nil {
+			mainLogger.Fatal("unable to create otel exporter", zap.Error(err))
+		}
+		go otelAgent.Start(ctx)
+	}
+
+	if daemonConfig.FlowLogExporter.Enabled {
+		flowLogExporter, err := exporter.NewFlowLogExporter(zl.Named("flowlog-exporter"), daemonConfig.FlowLogExporter, flowReader)
+		if err != nil {
+			mainLogger.Fatal("unable to create flow log exporter", zap.Error(err))
+		}
+		go flowLogExporter.Start(ctx)
+	}
+
+	if daemonConfig.CaptureRetention.Enabled {
+		janitor := retention.NewJanitor(zl.Named("capture-janitor"), daemonConfig.CaptureRetention, os.Getenv(nodeNameEnvKey),
+			mgr.GetAPIReader(), mgr.GetEventRecorderFor("retina-capture-janitor"), retention.NewMetrics(exporter.DefaultRegistry))
+		go janitor.Start(ctx)
+	}
+
 	// Start controller manager, which will start http server and plugin manager.
 	go controllerMgr.Start(ctx)
 	mainLogger.Info("Started controller manager")
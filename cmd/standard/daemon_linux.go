@@ -1,7 +1,22 @@
 package standard
 
-import "github.com/cilium/ebpf/rlimit"
+import (
+	"context"
+
+	"github.com/cilium/ebpf/rlimit"
+	controllercache "github.com/microsoft/retina/pkg/controllers/cache"
+	"github.com/microsoft/retina/pkg/enricher"
+)
 
 func (d *Daemon) RemoveMemlock() error {
 	return rlimit.RemoveMemlock()
 }
+
+// startNATResolver translates the service VIPs of the flows to their backends from the conntrack table.
+func (d *Daemon) startNATResolver(ctx context.Context, e *enricher.Enricher, c *controllercache.Cache) {
+	nat := enricher.NewConntrackNATResolver(func(ip string) bool {
+		return c.GetSvcByIP(ip) != nil
+	})
+	go nat.Run(ctx)
+	e.SetNATResolver(nat)
+}
@@ -1,6 +1,17 @@
 package standard
 
+import (
+	"context"
+
+	controllercache "github.com/microsoft/retina/pkg/controllers/cache"
+	"github.com/microsoft/retina/pkg/enricher"
+)
+
 func (d *Daemon) RemoveMemlock() error {
 	// This function is a no-op on Windows.
 	return nil
 }
+
+func (d *Daemon) startNATResolver(_ context.Context, _ *enricher.Enricher, _ *controllercache.Cache) {
+	// This function is a no-op on Windows, where there is no conntrack table.
+}
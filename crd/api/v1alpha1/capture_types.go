@@ -6,6 +6,7 @@ Licensed under the MIT license.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -47,6 +48,102 @@ type CaptureStatus struct {
 	// The number of failed jobs.
 	// +optional
 	Failed int32 `json:"failed,omitempty" protobuf:"varint,6,opt,name=failed"`
+
+	// LastScheduleTime is the last time a scheduled Capture was due to run.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Runs lists the runs of a scheduled Capture kept by the history limit, from the oldest to the latest.
+	// +optional
+	// +listType=atomic
+	Runs []CaptureRun `json:"runs,omitempty"`
+
+	// Nodes lists the results of the capture on each node, for the latest run of a scheduled Capture.
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeName
+	Nodes []CaptureNodeStatus `json:"nodes,omitempty"`
+}
+
+// CaptureNodePhase is the phase of the capture on a node.
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+type CaptureNodePhase string
+
+const (
+	// CaptureNodePending indicates the capture pod is not running yet.
+	CaptureNodePending CaptureNodePhase = "Pending"
+	// CaptureNodeRunning indicates the capture pod is capturing network packets or uploading the capture files.
+	CaptureNodeRunning CaptureNodePhase = "Running"
+	// CaptureNodeSucceeded indicates the capture files are stored in all the output locations.
+	CaptureNodeSucceeded CaptureNodePhase = "Succeeded"
+	// CaptureNodeFailed indicates the capture failed on the node.
+	CaptureNodeFailed CaptureNodePhase = "Failed"
+)
+
+// CaptureNodeStatus describes the result of the capture on a node.
+type CaptureNodeStatus struct {
+	// NodeName is the name of the node.
+	NodeName string `json:"nodeName"`
+
+	// JobName is the name of the capture job running on the node.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// Phase is the phase of the capture on the node.
+	// +optional
+	Phase CaptureNodePhase `json:"phase,omitempty"`
+
+	// BytesCaptured is the number of bytes of the network packets written to the capture files.
+	// +optional
+	BytesCaptured int64 `json:"bytesCaptured,omitempty"`
+
+	// PacketsCaptured is the number of network packets written to the capture files.
+	// +optional
+	PacketsCaptured int64 `json:"packetsCaptured,omitempty"`
+
+	// Artifacts lists where the capture files of the node are stored.
+	// +optional
+	// +listType=atomic
+	Artifacts []CaptureArtifact `json:"artifacts,omitempty"`
+
+	// Error describes why the capture failed on the node.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// CaptureArtifact describes a capture file stored in an output location.
+type CaptureArtifact struct {
+	// Location is the output location storing the file, one of HostPath, PersistentVolumeClaim, BlobUpload and
+	// S3Upload.
+	Location string `json:"location"`
+
+	// URI is the path of the file on the node or in the persistent volume claim, or the URL of the uploaded file.
+	URI string `json:"uri"`
+}
+
+// CaptureRun describes a run of a scheduled Capture.
+type CaptureRun struct {
+	// Name identifies the run by the start timestamp in the names of its capture files.
+	Name string `json:"name"`
+
+	// ScheduleTime is the time the run was scheduled.
+	ScheduleTime metav1.Time `json:"scheduleTime"`
+
+	// CompletionTime is the time all the jobs of the run finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// The number of pending and running jobs of the run.
+	// +optional
+	Active int32 `json:"active,omitempty"`
+
+	// The number of completed jobs of the run.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// The number of failed jobs of the run.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
 }
 
 // CaptureOption lists the options of the capture.
@@ -65,6 +162,83 @@ type CaptureOption struct {
 	// +kubebuilder:default=100
 	// +optional
 	MaxCaptureSize *int `json:"maxCaptureSize,omitempty"`
+
+	// RingBuffer records network packets continuously into rotating files, and keeps the most recent ones only
+	// when a trigger fires.
+	// In ring buffer mode, MaxCaptureSize is ignored and Duration limits how long to wait for a trigger.
+	// +optional
+	RingBuffer *RingBuffer `json:"ringBuffer,omitempty"`
+
+	// Provider selects how the network packets are captured.
+	// Default runs tcpdump on Linux nodes and netsh on Windows nodes.
+	// eBPF copies the packets from eBPF programs attached to the network interfaces of Linux nodes, and doesn't
+	// support ring buffer mode or TcpdumpFilter.
+	// +kubebuilder:validation:Enum=Default;eBPF
+	// +kubebuilder:default=Default
+	// +optional
+	Provider CaptureProvider `json:"provider,omitempty"`
+}
+
+// CaptureProvider is how the network packets of a capture are captured.
+type CaptureProvider string
+
+const (
+	// CaptureProviderDefault captures network packets with tcpdump on Linux nodes and netsh on Windows nodes.
+	CaptureProviderDefault CaptureProvider = "Default"
+	// CaptureProviderEBPF captures network packets with eBPF programs on Linux nodes.
+	CaptureProviderEBPF CaptureProvider = "eBPF"
+)
+
+// RingBuffer indicates the settings of the ring buffer mode of the capture.
+type RingBuffer struct {
+	// FileCount is the number of rotating capture files.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:default=10
+	// +optional
+	FileCount *int `json:"fileCount,omitempty"`
+
+	// FileSize limits each rotating capture file to MB in size.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	// +optional
+	FileSize *int `json:"fileSize,omitempty"`
+
+	// Window is the length of time before a trigger fires that the capture keeps.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:default="5m"
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// Triggers fire the snapshot of the ring buffer, at least one trigger is required.
+	// +kubebuilder:validation:Required
+	Triggers CaptureTriggers `json:"triggers"`
+}
+
+// CaptureTriggers lists the triggers of the ring buffer snapshot, the first trigger that fires takes the snapshot.
+type CaptureTriggers struct {
+	// Annotation fires the snapshot when the retina.sh/capture-trigger annotation is set on the Capture.
+	// +optional
+	Annotation bool `json:"annotation,omitempty"`
+
+	// Metric fires the snapshot when a metric of the Retina agent on the node crosses a threshold.
+	// +optional
+	Metric *MetricTrigger `json:"metric,omitempty"`
+}
+
+// MetricTrigger fires the snapshot when the value of a Retina metric is greater than the threshold.
+type MetricTrigger struct {
+	// Name of the metric, e.g. networkobservability_drop_count.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Labels select the series of the metric, the values of the selected series are summed.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Threshold is compared to the value of a gauge, or to the increase of a counter since the capture started.
+	// +kubebuilder:validation:Required
+	Threshold resource.Quantity `json:"threshold"`
 }
 
 // CaptureTarget indicates the target on which the network packets capture will be performed.
@@ -125,6 +299,94 @@ type CaptureConfigurationFilters struct {
 	// See Include for detailed explanation.
 	// +optional
 	Exclude []string `json:"exclude,omitempty"`
+	// IncludeRules specifies the packets included in the capture, which match any of the rules. They are combined
+	// with Include, so the packets matching either Include or IncludeRules are captured.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	IncludeRules []CaptureFilterRule `json:"includeRules,omitempty"`
+	// ExcludeRules specifies the packets excluded from the capture, which match any of the rules. They are combined
+	// with Exclude.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	ExcludeRules []CaptureFilterRule `json:"excludeRules,omitempty"`
+}
+
+// CaptureFilterProtocol is a protocol matched by a capture filter rule.
+// +kubebuilder:validation:Enum=TCP;UDP;ICMP;SCTP
+type CaptureFilterProtocol string
+
+const (
+	CaptureFilterProtocolTCP  CaptureFilterProtocol = "TCP"
+	CaptureFilterProtocolUDP  CaptureFilterProtocol = "UDP"
+	CaptureFilterProtocolICMP CaptureFilterProtocol = "ICMP"
+	CaptureFilterProtocolSCTP CaptureFilterProtocol = "SCTP"
+)
+
+// TCPFlag is a flag of the TCP header.
+// +kubebuilder:validation:Enum=SYN;ACK;FIN;RST;PSH;URG
+type TCPFlag string
+
+const (
+	TCPFlagSYN TCPFlag = "SYN"
+	TCPFlagACK TCPFlag = "ACK"
+	TCPFlagFIN TCPFlag = "FIN"
+	TCPFlagRST TCPFlag = "RST"
+	TCPFlagPSH TCPFlag = "PSH"
+	TCPFlagURG TCPFlag = "URG"
+)
+
+// CaptureFilterRule matches the packets matching all of its fields, and at least one field should be set.
+// +kubebuilder:validation:XValidation:rule="has(self.protocol) || has(self.ports) || has(self.cidrs) || has(self.tcpFlags) || has(self.service)",message="at least one field of the filter rule should be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.ports) || (has(self.protocol) && self.protocol != 'ICMP')",message="ports require the TCP, UDP or SCTP protocol"
+// +kubebuilder:validation:XValidation:rule="!has(self.tcpFlags) || (has(self.protocol) && self.protocol == 'TCP')",message="tcpFlags require the TCP protocol"
+// +kubebuilder:validation:XValidation:rule="!has(self.cidrs) || !has(self.service)",message="cidrs and service are mutually exclusive"
+type CaptureFilterRule struct {
+	// Protocol matches the packets of the protocol.
+	// +optional
+	Protocol CaptureFilterProtocol `json:"protocol,omitempty"`
+	// Ports matches the packets whose source or destination port is in any of the port ranges.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	Ports []CapturePortRange `json:"ports,omitempty"`
+	// CIDRs matches the packets whose source or destination IP address is in any of the CIDRs.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	CIDRs []string `json:"cidrs,omitempty"`
+	// TCPFlags matches the TCP packets with all the flags set.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=6
+	TCPFlags []TCPFlag `json:"tcpFlags,omitempty"`
+	// Service matches the packets from or to the cluster IPs and the endpoints of the selected services.
+	// +optional
+	Service *CaptureServiceSelector `json:"service,omitempty"`
+}
+
+// CapturePortRange is a port, or a range of ports when EndPort is set.
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || self.endPort >= self.port",message="endPort should be greater than or equal to port"
+type CapturePortRange struct {
+	// Port is the port, or the first port of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// EndPort is the last port of the range.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+// CaptureServiceSelector selects Kubernetes services by labels.
+type CaptureServiceSelector struct {
+	// Namespace is the namespace of the services, and defaults to the namespace of the Capture.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Selector selects the services by labels.
+	Selector metav1.LabelSelector `json:"selector"`
 }
 
 // OutputConfiguration indicates the location capture will be stored.
@@ -142,6 +404,24 @@ type OutputConfiguration struct {
 	// S3Upload configures the details for uploading capture files to an S3-compatible storage service.
 	// +optional
 	S3Upload *S3Upload `json:"s3Upload,omitempty"`
+	// Retention removes the oldest capture files of the Capture from HostPath, S3Upload and BlobUpload when one of
+	// its limits is exceeded. The limits apply to the capture files of each node.
+	// +optional
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+}
+
+// RetentionPolicy limits the capture files kept in an output location.
+type RetentionPolicy struct {
+	// MaxAge is the age of the capture files after which they are removed.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+	// MaxSize is the total size of the capture files of a node above which the oldest ones are removed.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// MaxCount is the number of capture files of a node above which the oldest ones are removed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
 }
 
 type S3Upload struct {
@@ -168,6 +448,18 @@ type CaptureSpec struct {
 	CaptureConfiguration CaptureConfiguration `json:"captureConfiguration"`
 	// +kubebuilder:validation:Required
 	OutputConfiguration OutputConfiguration `json:"outputConfiguration,omitempty"`
+
+	// Schedule runs the capture on a cron schedule, e.g. "0 2 * * *", instead of once.
+	// A run is skipped when the previous one is still in progress.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// HistoryLimit is the number of runs of a scheduled Capture whose jobs, and capture files in HostPath and S3,
+	// are kept.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=3
+	// +optional
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
 }
 
 // +kubebuilder:object:root=true
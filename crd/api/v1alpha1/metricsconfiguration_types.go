@@ -63,6 +63,9 @@ type MetricsStatus struct {
 	State         string       `json:"state"`
 	Reason        string       `json:"reason"`
 	LastKnownSpec *MetricsSpec `json:"lastKnownSpec,omitempty"`
+	// InEffect is the spec in effect, merged from all the accepted MetricsConfigurations.
+	// +optional
+	InEffect *MetricsSpec `json:"inEffect,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -24,6 +24,13 @@ const (
 	NetworkToNode      string = "NetworkToNode"
 )
 
+const (
+	TraceOutputStdout        string = "stdout"
+	TraceOutputAzureTable    string = "azuretable"
+	TraceOutputLogAnalytics  string = "loganalytics"
+	TraceOutputOpenTelemetry string = "opentelemetry"
+)
+
 type TracePoints []string
 
 type TracePorts struct {
@@ -117,7 +124,7 @@ type TracesConfiguration struct {
 type TracesConfigurationList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []TracesConfigurationList `json:"items"`
+	Items           []TracesConfiguration `json:"items"`
 }
 
 func init() {
@@ -0,0 +1,87 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package validations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/retina/crd/api/v1alpha1"
+)
+
+// MetricsSpecsConflict returns an error if the specs cannot be merged without changing the scope of their metrics. The
+// agents apply the metrics in a single namespace scope, so the specs must have the same namespaces, and a metric can
+// only be configured by one of them.
+func MetricsSpecsConflict(spec, other *v1alpha1.MetricsSpec) error {
+	if (spec.Namespaces.Exclude == nil) != (other.Namespaces.Exclude == nil) ||
+		!sameStrings(spec.Namespaces.Include, other.Namespaces.Include) ||
+		!sameStrings(spec.Namespaces.Exclude, other.Namespaces.Exclude) {
+		return fmt.Errorf("the namespaces differ")
+	}
+
+	names := make(map[string]struct{}, len(other.ContextOptions))
+	for _, option := range other.ContextOptions {
+		names[option.MetricName] = struct{}{}
+	}
+	var shared []string
+	for _, option := range spec.ContextOptions {
+		if _, ok := names[option.MetricName]; ok {
+			shared = append(shared, option.MetricName)
+		}
+	}
+	if len(shared) > 0 {
+		return fmt.Errorf("metrics %s are configured by both", strings.Join(shared, ", "))
+	}
+	return nil
+}
+
+// MergeMetricsSpecs merges the specs of several MetricsConfigurations into the effective spec of the metrics module:
+// the metrics of all the specs in the namespaces they share. Specs conflicting with a previous spec, as reported by
+// MetricsSpecsConflict, are skipped, the operator rejects them. Metrics keep the order of specs, so that merging the
+// same specs in the same order always results in the same spec.
+func MergeMetricsSpecs(specs []*v1alpha1.MetricsSpec) *v1alpha1.MetricsSpec {
+	merged := &v1alpha1.MetricsSpec{}
+	var mergedSpecs []*v1alpha1.MetricsSpec
+	for _, spec := range specs {
+		if spec == nil || slices.ContainsFunc(mergedSpecs, func(other *v1alpha1.MetricsSpec) bool {
+			return MetricsSpecsConflict(spec, other) != nil
+		}) {
+			continue
+		}
+		if len(mergedSpecs) == 0 {
+			merged.Namespaces = *spec.Namespaces.DeepCopy()
+		}
+		mergedSpecs = append(mergedSpecs, spec)
+		for i := range spec.ContextOptions {
+			merged.ContextOptions = append(merged.ContextOptions, *spec.ContextOptions[i].DeepCopy())
+		}
+	}
+	return merged
+}
+
+// sameStrings returns true if a and b have the same strings, in any order.
+func sameStrings(a, b []string) bool {
+	in := make(map[string]struct{}, len(a))
+	for _, s := range a {
+		in[s] = struct{}{}
+	}
+	for _, s := range b {
+		if _, ok := in[s]; !ok {
+			return false
+		}
+	}
+	in = make(map[string]struct{}, len(b))
+	for _, s := range b {
+		in[s] = struct{}{}
+	}
+	for _, s := range a {
+		if _, ok := in[s]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package validations
+
+import (
+	"testing"
+
+	"github.com/microsoft/retina/crd/api/v1alpha1"
+	"gotest.tools/assert"
+)
+
+// TestMergeMetricsSpecs tests the merge of the specs of several metrics configurations
+func TestMergeMetricsSpecs(t *testing.T) {
+	tests := []struct {
+		name  string
+		specs []*v1alpha1.MetricsSpec
+		want  *v1alpha1.MetricsSpec
+	}{
+		{
+			name: "single spec",
+			specs: []*v1alpha1.MetricsSpec{
+				{
+					ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "drop_count", SourceLabels: []string{"ip"}}},
+					Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"default"}},
+				},
+			},
+			want: &v1alpha1.MetricsSpec{
+				ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "drop_count", SourceLabels: []string{"ip"}}},
+				Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"default"}},
+			},
+		},
+		{
+			name: "metrics of specs with the same namespaces are merged",
+			specs: []*v1alpha1.MetricsSpec{
+				{
+					ContextOptions: []v1alpha1.MetricsContextOptions{
+						{MetricName: "drop_count", SourceLabels: []string{"ip", "podname"}},
+						{MetricName: "forward_count", DestinationLabels: []string{"ip"}},
+					},
+					Namespaces: v1alpha1.MetricsNamespaces{Include: []string{"team-a", "shared"}},
+				},
+				{
+					ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "dns", SourceLabels: []string{"ip"}}},
+					Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"shared", "team-a"}},
+				},
+			},
+			want: &v1alpha1.MetricsSpec{
+				ContextOptions: []v1alpha1.MetricsContextOptions{
+					{MetricName: "drop_count", SourceLabels: []string{"ip", "podname"}},
+					{MetricName: "forward_count", DestinationLabels: []string{"ip"}},
+					{MetricName: "dns", SourceLabels: []string{"ip"}},
+				},
+				Namespaces: v1alpha1.MetricsNamespaces{Include: []string{"team-a", "shared"}},
+			},
+		},
+		{
+			name: "conflicting specs are skipped",
+			specs: []*v1alpha1.MetricsSpec{
+				{
+					ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "drop_count"}},
+					Namespaces:     v1alpha1.MetricsNamespaces{Exclude: []string{"kube-system"}},
+				},
+				{
+					ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "forward_count"}},
+					Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"team-a"}},
+				},
+				{
+					ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "drop_count", SourceLabels: []string{"ip"}}},
+					Namespaces:     v1alpha1.MetricsNamespaces{Exclude: []string{"kube-system"}},
+				},
+				{
+					ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "dns"}},
+					Namespaces:     v1alpha1.MetricsNamespaces{Exclude: []string{"kube-system"}},
+				},
+			},
+			want: &v1alpha1.MetricsSpec{
+				ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "drop_count"}, {MetricName: "dns"}},
+				Namespaces:     v1alpha1.MetricsNamespaces{Exclude: []string{"kube-system"}},
+			},
+		},
+		{
+			name:  "no spec",
+			specs: nil,
+			want:  &v1alpha1.MetricsSpec{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeMetricsSpecs(tt.specs)
+			assert.DeepEqual(t, tt.want, got)
+		})
+	}
+}
+
+// TestMetricsSpecsConflict tests the specs which cannot be merged
+func TestMetricsSpecsConflict(t *testing.T) {
+	spec := &v1alpha1.MetricsSpec{
+		ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "drop_count"}, {MetricName: "forward_count"}},
+		Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"team-a", "team-b"}},
+	}
+	tests := []struct {
+		name     string
+		other    *v1alpha1.MetricsSpec
+		conflict bool
+	}{
+		{
+			name: "other metrics in the same namespaces",
+			other: &v1alpha1.MetricsSpec{
+				ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "dns"}},
+				Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"team-b", "team-a"}},
+			},
+		},
+		{
+			name: "same metric",
+			other: &v1alpha1.MetricsSpec{
+				ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "dns"}, {MetricName: "forward_count"}},
+				Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"team-a", "team-b"}},
+			},
+			conflict: true,
+		},
+		{
+			name: "other included namespaces",
+			other: &v1alpha1.MetricsSpec{
+				ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "dns"}},
+				Namespaces:     v1alpha1.MetricsNamespaces{Include: []string{"team-a"}},
+			},
+			conflict: true,
+		},
+		{
+			name: "excluded namespaces",
+			other: &v1alpha1.MetricsSpec{
+				ContextOptions: []v1alpha1.MetricsContextOptions{{MetricName: "dns"}},
+				Namespaces:     v1alpha1.MetricsNamespaces{Exclude: []string{"team-a", "team-b"}},
+			},
+			conflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.conflict, MetricsSpecsConflict(spec, tt.other) != nil)
+			assert.Equal(t, tt.conflict, MetricsSpecsConflict(tt.other, spec) != nil)
+		})
+	}
+}
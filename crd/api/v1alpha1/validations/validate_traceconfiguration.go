@@ -88,13 +88,15 @@ func TraceOutputConfiguration(traceOutputConfig *v1alpha1.TraceOutputConfigurati
 		return fmt.Errorf("trace output configuration is nil")
 	}
 
-	if traceOutputConfig.TraceOutputDestination == "" {
-		return fmt.Errorf("trace output type is invalid")
+	switch traceOutputConfig.TraceOutputDestination {
+	case v1alpha1.TraceOutputStdout:
+		return nil
+	case v1alpha1.TraceOutputAzureTable, v1alpha1.TraceOutputLogAnalytics, v1alpha1.TraceOutputOpenTelemetry:
+		// The agent only writes traced flows to stdout so far.
+		return fmt.Errorf("trace output type %s is not supported yet", traceOutputConfig.TraceOutputDestination)
+	default:
+		return fmt.Errorf("trace output type %s is invalid", traceOutputConfig.TraceOutputDestination)
 	}
-
-	// TODO add actual output type validation
-
-	return nil
 }
 
 func TraceTargets(target *v1alpha1.TraceTargets) error {
@@ -145,7 +145,7 @@ func TestTraceConfiguration(t *testing.T) {
 						},
 					},
 					TraceOutputConfiguration: &v1alpha1.TraceOutputConfiguration{
-						TraceOutputDestination: "Test",
+						TraceOutputDestination: v1alpha1.TraceOutputStdout,
 					},
 				},
 			},
@@ -207,7 +207,7 @@ func TestTraceConfiguration(t *testing.T) {
 						},
 					},
 					TraceOutputConfiguration: &v1alpha1.TraceOutputConfiguration{
-						TraceOutputDestination: "Test",
+						TraceOutputDestination: v1alpha1.TraceOutputStdout,
 					},
 				},
 			},
@@ -385,7 +385,7 @@ func TestTraceConfiguration(t *testing.T) {
 						},
 					},
 					TraceOutputConfiguration: &v1alpha1.TraceOutputConfiguration{
-						TraceOutputDestination: "Test",
+						TraceOutputDestination: v1alpha1.TraceOutputStdout,
 					},
 				},
 			},
@@ -691,3 +691,50 @@ func TestTracePorts(t *testing.T) {
 		})
 	}
 }
+
+func TestTraceOutputConfiguration(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  *v1alpha1.TraceOutputConfiguration
+		wantErr bool
+	}{
+		{
+			name:    "nil output configuration",
+			output:  nil,
+			wantErr: true,
+		},
+		{
+			name:    "stdout output",
+			output:  &v1alpha1.TraceOutputConfiguration{TraceOutputDestination: v1alpha1.TraceOutputStdout},
+			wantErr: false,
+		},
+		{
+			name:    "azure table output is not supported",
+			output:  &v1alpha1.TraceOutputConfiguration{TraceOutputDestination: v1alpha1.TraceOutputAzureTable},
+			wantErr: true,
+		},
+		{
+			name:    "log analytics output is not supported",
+			output:  &v1alpha1.TraceOutputConfiguration{TraceOutputDestination: v1alpha1.TraceOutputLogAnalytics},
+			wantErr: true,
+		},
+		{
+			name:    "opentelemetry output is not supported",
+			output:  &v1alpha1.TraceOutputConfiguration{TraceOutputDestination: v1alpha1.TraceOutputOpenTelemetry},
+			wantErr: true,
+		},
+		{
+			name:    "unknown output",
+			output:  &v1alpha1.TraceOutputConfiguration{TraceOutputDestination: "Test"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := TraceOutputConfiguration(tt.output); (err != nil) != tt.wantErr {
+				t.Errorf("TraceOutputConfiguration() name = %s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -36,6 +36,21 @@ func (in *Capture) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptureArtifact) DeepCopyInto(out *CaptureArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureArtifact.
+func (in *CaptureArtifact) DeepCopy() *CaptureArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptureArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaptureConfiguration) DeepCopyInto(out *CaptureConfiguration) {
 	*out = *in
@@ -76,6 +91,20 @@ func (in *CaptureConfigurationFilters) DeepCopyInto(out *CaptureConfigurationFil
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludeRules != nil {
+		in, out := &in.IncludeRules, &out.IncludeRules
+		*out = make([]CaptureFilterRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludeRules != nil {
+		in, out := &in.ExcludeRules, &out.ExcludeRules
+		*out = make([]CaptureFilterRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureConfigurationFilters.
@@ -88,6 +117,43 @@ func (in *CaptureConfigurationFilters) DeepCopy() *CaptureConfigurationFilters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptureFilterRule) DeepCopyInto(out *CaptureFilterRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]CapturePortRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TCPFlags != nil {
+		in, out := &in.TCPFlags, &out.TCPFlags
+		*out = make([]TCPFlag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(CaptureServiceSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureFilterRule.
+func (in *CaptureFilterRule) DeepCopy() *CaptureFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptureFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaptureList) DeepCopyInto(out *CaptureList) {
 	*out = *in
@@ -120,6 +186,26 @@ func (in *CaptureList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptureNodeStatus) DeepCopyInto(out *CaptureNodeStatus) {
+	*out = *in
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]CaptureArtifact, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureNodeStatus.
+func (in *CaptureNodeStatus) DeepCopy() *CaptureNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptureNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaptureOption) DeepCopyInto(out *CaptureOption) {
 	*out = *in
@@ -138,6 +224,11 @@ func (in *CaptureOption) DeepCopyInto(out *CaptureOption) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.RingBuffer != nil {
+		in, out := &in.RingBuffer, &out.RingBuffer
+		*out = new(RingBuffer)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureOption.
@@ -150,11 +241,72 @@ func (in *CaptureOption) DeepCopy() *CaptureOption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapturePortRange) DeepCopyInto(out *CapturePortRange) {
+	*out = *in
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapturePortRange.
+func (in *CapturePortRange) DeepCopy() *CapturePortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(CapturePortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptureRun) DeepCopyInto(out *CaptureRun) {
+	*out = *in
+	in.ScheduleTime.DeepCopyInto(&out.ScheduleTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureRun.
+func (in *CaptureRun) DeepCopy() *CaptureRun {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptureRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptureServiceSelector) DeepCopyInto(out *CaptureServiceSelector) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureServiceSelector.
+func (in *CaptureServiceSelector) DeepCopy() *CaptureServiceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptureServiceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaptureSpec) DeepCopyInto(out *CaptureSpec) {
 	*out = *in
 	in.CaptureConfiguration.DeepCopyInto(&out.CaptureConfiguration)
 	in.OutputConfiguration.DeepCopyInto(&out.OutputConfiguration)
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureSpec.
@@ -185,6 +337,24 @@ func (in *CaptureStatus) DeepCopyInto(out *CaptureStatus) {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Runs != nil {
+		in, out := &in.Runs, &out.Runs
+		*out = make([]CaptureRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]CaptureNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureStatus.
@@ -227,6 +397,26 @@ func (in *CaptureTarget) DeepCopy() *CaptureTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CaptureTriggers) DeepCopyInto(out *CaptureTriggers) {
+	*out = *in
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(MetricTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CaptureTriggers.
+func (in *CaptureTriggers) DeepCopy() *CaptureTriggers {
+	if in == nil {
+		return nil
+	}
+	out := new(CaptureTriggers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Containers) DeepCopyInto(out *Containers) {
 	*out = *in
@@ -262,6 +452,29 @@ func (in *IPBlock) DeepCopy() *IPBlock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricTrigger) DeepCopyInto(out *MetricTrigger) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Threshold = in.Threshold.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricTrigger.
+func (in *MetricTrigger) DeepCopy() *MetricTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsConfiguration) DeepCopyInto(out *MetricsConfiguration) {
 	*out = *in
@@ -407,6 +620,11 @@ func (in *MetricsStatus) DeepCopyInto(out *MetricsStatus) {
 		*out = new(MetricsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InEffect != nil {
+		in, out := &in.InEffect, &out.InEffect
+		*out = new(MetricsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsStatus.
@@ -442,6 +660,11 @@ func (in *OutputConfiguration) DeepCopyInto(out *OutputConfiguration) {
 		*out = new(S3Upload)
 		**out = **in
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputConfiguration.
@@ -469,6 +692,36 @@ func (in *OwnerReference) DeepCopy() *OwnerReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetinaEndpoint) DeepCopyInto(out *RetinaEndpoint) {
 	*out = *in
@@ -602,6 +855,37 @@ func (in *RetinaEndpointStatusContainers) DeepCopy() *RetinaEndpointStatusContai
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RingBuffer) DeepCopyInto(out *RingBuffer) {
+	*out = *in
+	if in.FileCount != nil {
+		in, out := &in.FileCount, &out.FileCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.FileSize != nil {
+		in, out := &in.FileSize, &out.FileSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.Triggers.DeepCopyInto(&out.Triggers)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RingBuffer.
+func (in *RingBuffer) DeepCopy() *RingBuffer {
+	if in == nil {
+		return nil
+	}
+	out := new(RingBuffer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Upload) DeepCopyInto(out *S3Upload) {
 	*out = *in
@@ -811,7 +1095,7 @@ func (in *TracesConfigurationList) DeepCopyInto(out *TracesConfigurationList) {
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]TracesConfigurationList, len(*in))
+		*out = make([]TracesConfiguration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -18,6 +18,7 @@ const (
 	RetinaCapturesYAMLpath       = "retina.sh_captures.yaml"
 	RetinaEndpointsYAMLpath      = "retina.sh_retinaendpoints.yaml"
 	MetricsConfigurationYAMLpath = "retina.sh_metricsconfigurations.yaml"
+	TracesConfigurationYAMLpath  = "retina.sh_tracesconfigurations.yaml"
 )
 
 //go:embed manifests/controller/helm/retina/crds/retina.sh_captures.yaml
@@ -29,6 +30,9 @@ var RetinaEndpointsYAML []byte
 //go:embed manifests/controller/helm/retina/crds/retina.sh_metricsconfigurations.yaml
 var MetricsConfgurationYAML []byte
 
+//go:embed manifests/controller/helm/retina/crds/retina.sh_tracesconfigurations.yaml
+var TracesConfigurationYAML []byte
+
 func GetRetinaCapturesCRD() (*apiextensionsv1.CustomResourceDefinition, error) {
 	retinaCapturesCRD := &apiextensionsv1.CustomResourceDefinition{}
 	if err := yaml.Unmarshal(RetinaCapturesYAML, &retinaCapturesCRD); err != nil {
@@ -55,8 +59,16 @@ func GetRetinaMetricsConfigurationCRD() (*apiextensionsv1.CustomResourceDefiniti
 	return retinaMetricsConfigurationCRD, nil
 }
 
+func GetRetinaTracesConfigurationCRD() (*apiextensionsv1.CustomResourceDefinition, error) {
+	retinaTracesConfigurationCRD := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(TracesConfigurationYAML, &retinaTracesConfigurationCRD); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling embedded tracesconfiguration")
+	}
+	return retinaTracesConfigurationCRD, nil
+}
+
 func InstallOrUpdateCRDs(ctx context.Context, enableRetinaEndpoint bool, apiExtensionsClient apiextv1.ApiextensionsV1Interface) (map[string]*apiextensionsv1.CustomResourceDefinition, error) {
-	crds := make(map[string]*apiextensionsv1.CustomResourceDefinition, 4)
+	crds := make(map[string]*apiextensionsv1.CustomResourceDefinition, 5)
 
 	retinaCapture, err := GetRetinaCapturesCRD()
 	if err != nil {
@@ -78,6 +90,12 @@ func InstallOrUpdateCRDs(ctx context.Context, enableRetinaEndpoint bool, apiExte
 	}
 	crds[retinaMetricsConfiguration.GetObjectMeta().GetName()] = retinaMetricsConfiguration
 
+	retinaTracesConfiguration, err := GetRetinaTracesConfigurationCRD()
+	if err != nil {
+		return nil, err
+	}
+	crds[retinaTracesConfiguration.GetObjectMeta().GetName()] = retinaTracesConfiguration
+
 	for name, crd := range crds {
 		current, err := apiExtensionsClient.CustomResourceDefinitions().Create(ctx, crd, v1.CreateOptions{})
 		if apierrors.IsAlreadyExists(err) {
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	crzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
 	deploy "github.com/microsoft/retina/deploy/standard"
@@ -37,8 +38,10 @@ import (
 	metricsconfiguration "github.com/microsoft/retina/pkg/controllers/operator/metricsconfiguration"
 	podcontroller "github.com/microsoft/retina/pkg/controllers/operator/pod"
 	retinaendpointcontroller "github.com/microsoft/retina/pkg/controllers/operator/retinaendpoint"
+	tracesconfiguration "github.com/microsoft/retina/pkg/controllers/operator/tracesconfiguration"
 	"github.com/microsoft/retina/pkg/log"
 	"github.com/microsoft/retina/pkg/telemetry"
+	"github.com/microsoft/retina/pkg/webhooks"
 )
 
 var (
@@ -113,8 +116,17 @@ func (o *Operator) Start() {
 
 	ctrl.SetLogger(crzap.New(crzap.UseFlagOptions(opts), crzap.Encoder(zapcore.NewConsoleEncoder(log.EncoderConfig()))))
 
+	var webhookServer webhook.Server
+	if oconfig.EnableWebhook {
+		webhookServer = webhook.NewServer(webhook.Options{
+			Port:    oconfig.WebhookPort,
+			CertDir: oconfig.WebhookCertDir,
+		})
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme: scheme,
+		Scheme:        scheme,
+		WebhookServer: webhookServer,
 		Metrics: metricsserver.Options{
 			BindAddress: o.metricsAddr,
 		},
@@ -234,6 +246,20 @@ func (o *Operator) Start() {
 		os.Exit(1)
 	}
 
+	tc := tracesconfiguration.New(mgr.GetClient(), mgr.GetScheme())
+	if err = (tc).SetupWithManager(mgr); err != nil {
+		mainLogger.Error("Unable to create controller", zap.String("controller", "tracesconfiguration"), zap.Error(err))
+		os.Exit(1)
+	}
+
+	if oconfig.EnableWebhook {
+		mainLogger.Info("Admission webhooks are enabled", zap.Int("port", oconfig.WebhookPort))
+		if err = webhooks.SetupWithManager(mgr); err != nil {
+			mainLogger.Error("Unable to set up webhooks", zap.Error(err))
+			os.Exit(1)
+		}
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
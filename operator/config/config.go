@@ -9,7 +9,10 @@ import (
 	"github.com/spf13/viper"
 )
 
-const MinTelemetryInterval time.Duration = 2 * time.Minute
+const (
+	MinTelemetryInterval time.Duration = 2 * time.Minute
+	DefaultWebhookPort                 = 9443
+)
 
 var (
 	DefaultTelemetryInterval       = 5 * time.Minute
@@ -26,6 +29,12 @@ type OperatorConfig struct {
 	EnableRetinaEndpoint bool          `yaml:"enableRetinaEndpoint"`
 	RemoteContext        bool          `yaml:"remoteContext"`
 	TelemetryInterval    time.Duration `yaml:"telemetryInterval"`
+	// EnableWebhook indicates whether to serve the admission webhooks of the Retina CRDs.
+	EnableWebhook bool `yaml:"enableWebhook"`
+	// WebhookPort is the port of the webhook server.
+	WebhookPort int `yaml:"webhookPort"`
+	// WebhookCertDir is the directory of the serving certificate of the webhook server, tls.crt and tls.key.
+	WebhookCertDir string `yaml:"webhookCertDir"`
 }
 
 func GetConfig(cfgFileName string) (*OperatorConfig, error) {
@@ -40,6 +49,7 @@ func GetConfig(cfgFileName string) (*OperatorConfig, error) {
 
 	var cfg OperatorConfig
 	viper.SetDefault("EnableRetinaEndpoint", true)
+	viper.SetDefault("WebhookPort", DefaultWebhookPort)
 	err = viper.Unmarshal(&cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshalling config: %w", err)
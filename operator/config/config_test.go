@@ -19,7 +19,9 @@ func TestGetConfig(t *testing.T) {
 		c.LogLevel != "info" ||
 		!c.EnableRetinaEndpoint ||
 		!c.RemoteContext ||
-		c.TelemetryInterval != 15*time.Minute {
+		c.TelemetryInterval != 15*time.Minute ||
+		!c.EnableWebhook ||
+		c.WebhookPort != config.DefaultWebhookPort {
 		t.Errorf("Expeted config should be same as ./testwith/config.yaml; instead got %+v", c)
 	}
 }
@@ -0,0 +1,51 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+// Package api contains the types of the Retina agent REST API described in trace.yml.
+package api
+
+import "encoding/json"
+
+const (
+	// OperationStart starts a network trace.
+	OperationStart = "start"
+	// OperationStop stops a running network trace.
+	OperationStop = "stop"
+)
+
+const (
+	// TraceRunning is the result of a trace that is still collecting flows.
+	TraceRunning = "Running"
+	// TraceCompleted is the result of a trace that ran for its whole duration.
+	TraceCompleted = "Completed"
+	// TraceStopped is the result of a trace that was stopped before its duration elapsed.
+	TraceStopped = "Stopped"
+)
+
+// TraceOperation is the request body of POST /trace.
+type TraceOperation struct {
+	Operation   string `json:"operation"`
+	OperationID string `json:"operationID,omitempty"`
+	Filter      string `json:"filter,omitempty"`
+	// Duration is a Go duration string, e.g. "30s".
+	Duration string `json:"duration,omitempty"`
+}
+
+// Trace is the state of a trace operation and the flows collected so far.
+type Trace struct {
+	Operation   string            `json:"operation"`
+	OperationID string            `json:"operationID"`
+	Filter      string            `json:"filter"`
+	Result      string            `json:"result"`
+	Flows       []json.RawMessage `json:"flows,omitempty"`
+}
+
+// Error is returned by the API for any non 2xx response.
+type Error struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
@@ -9,14 +9,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
 	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
 	"github.com/microsoft/retina/pkg/capture/file"
 	captureOutput "github.com/microsoft/retina/pkg/capture/outputlocation"
@@ -32,12 +35,19 @@ type CaptureManager struct {
 	l                      *log.ZapLogger
 	networkCaptureProvider captureProvider.NetworkCaptureProviderInterface
 	tel                    telemetry.Telemetry
+
+	// result is the result of the capture on the node, reported by WriteResult.
+	result retinav1alpha1.CaptureNodeStatus
 }
 
 func NewCaptureManager(logger *log.ZapLogger, tel telemetry.Telemetry) *CaptureManager {
+	networkCaptureProvider := captureProvider.NewNetworkCaptureProvider(logger)
+	if os.Getenv(captureConstants.CaptureProviderEnvKey) == string(retinav1alpha1.CaptureProviderEBPF) {
+		networkCaptureProvider = captureProvider.NewEBPFCaptureProvider(logger)
+	}
 	return &CaptureManager{
 		l:                      logger,
-		networkCaptureProvider: captureProvider.NewNetworkCaptureProvider(logger),
+		networkCaptureProvider: networkCaptureProvider,
 		tel:                    tel,
 	}
 }
@@ -66,9 +76,15 @@ func (cm *CaptureManager) CaptureNetwork(ctx context.Context) (string, error) {
 		return "", err
 	}
 
-	if err := cm.networkCaptureProvider.CaptureNetworkPacket(ctx, captureFilter, captureDuration, captureMaxSizeMB); err != nil {
+	ringBuffer, err := cm.captureRingBuffer(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := cm.networkCaptureProvider.CaptureNetworkPacket(ctx, captureFilter, captureDuration, captureMaxSizeMB, ringBuffer); err != nil {
 		return "", err
 	}
+	cm.result.PacketsCaptured, cm.result.BytesCaptured = countCapturedPackets(tmpLocation)
 
 	if includeMetadata := cm.includeMetadata(); includeMetadata {
 		if err := cm.networkCaptureProvider.CollectMetadata(); err != nil {
@@ -154,6 +170,82 @@ func (cm *CaptureManager) captureMaxSizeMB() (int, error) {
 	return strconv.Atoi(captureMaxSizeMBStr)
 }
 
+// captureRingBuffer returns the ring buffer settings of the capture, and starts to watch its triggers.
+func (cm *CaptureManager) captureRingBuffer(ctx context.Context) (*captureProvider.RingBuffer, error) {
+	fileCountStr := os.Getenv(captureConstants.RingBufferFileCountEnvKey)
+	if len(fileCountStr) == 0 {
+		return nil, nil
+	}
+	fileCount, err := strconv.Atoi(fileCountStr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ring buffer file count")
+	}
+	fileSizeMB, err := strconv.Atoi(os.Getenv(captureConstants.RingBufferFileSizeEnvKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ring buffer file size")
+	}
+	window, err := time.ParseDuration(os.Getenv(captureConstants.RingBufferWindowEnvKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ring buffer window")
+	}
+
+	triggers, err := cm.captureTriggers()
+	if err != nil {
+		return nil, err
+	}
+	if len(triggers) == 0 {
+		return nil, errors.New("no trigger is set for the ring buffer capture")
+	}
+
+	return &captureProvider.RingBuffer{
+		FileCount:  fileCount,
+		FileSizeMB: fileSizeMB,
+		Window:     window,
+		Trigger:    watchTriggers(ctx, cm.l, triggers),
+	}, nil
+}
+
+func (cm *CaptureManager) captureTriggers() ([]trigger, error) {
+	triggers := []trigger{}
+	if annotation, _ := strconv.ParseBool(os.Getenv(captureConstants.TriggerAnnotationEnvKey)); annotation {
+		triggers = append(triggers, &annotationTrigger{
+			path:       filepath.Join(captureConstants.CapturePodInfoPath, captureConstants.CapturePodInfoAnnotationsFile),
+			annotation: captureConstants.CaptureTriggerAnnotation,
+		})
+	}
+
+	metricName := os.Getenv(captureConstants.TriggerMetricNameEnvKey)
+	if len(metricName) == 0 {
+		return triggers, nil
+	}
+	threshold, err := strconv.ParseFloat(os.Getenv(captureConstants.TriggerMetricThresholdEnvKey), 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse metric trigger threshold")
+	}
+	labels := map[string]string{}
+	if labelsStr := os.Getenv(captureConstants.TriggerMetricLabelsEnvKey); len(labelsStr) != 0 {
+		for _, pair := range strings.Split(labelsStr, ",") {
+			key, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				return nil, errors.Errorf("invalid metric trigger label %q", pair)
+			}
+			labels[key] = value
+		}
+	}
+	metricsURL := os.Getenv(captureConstants.TriggerMetricsURLEnvKey)
+	if len(metricsURL) == 0 {
+		metricsURL = captureConstants.DefaultTriggerMetricsURL
+	}
+	triggers = append(triggers, &metricTrigger{
+		url:       metricsURL,
+		name:      metricName,
+		labels:    labels,
+		threshold: threshold,
+		client:    &http.Client{Timeout: triggerCheckInterval},
+	})
+	return triggers, nil
+}
+
 func (cm *CaptureManager) OutputCapture(ctx context.Context, srcDir string) error {
 	var errStr string
 
@@ -167,9 +259,12 @@ func (cm *CaptureManager) OutputCapture(ctx context.Context, srcDir string) erro
 	}
 
 	for _, location := range cm.enabledOutputLocations() {
-		if err := location.Output(ctx, dstTarGz); err != nil {
+		uri, err := location.Output(ctx, dstTarGz)
+		if err != nil {
 			errStr = errStr + fmt.Sprintf("location %q output error: %s\n", location.Name(), err)
+			continue
 		}
+		cm.result.Artifacts = append(cm.result.Artifacts, retinav1alpha1.CaptureArtifact{Location: location.Name(), URI: uri})
 	}
 
 	if len(errStr) != 0 {
@@ -4,12 +4,15 @@
 package capture
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
 	"github.com/microsoft/retina/pkg/capture/file"
 	"github.com/microsoft/retina/pkg/capture/provider"
@@ -55,7 +58,7 @@ func TestCaptureNetwork(t *testing.T) {
 
 	tmpFilename := file.CaptureFilename{CaptureName: captureName, NodeHostname: nodeHostName, StartTimestamp: &timestamp}
 	networkCaptureProvider.EXPECT().Setup(tmpFilename).Return(fmt.Sprintf("%s-%s-%s", captureName, nodeHostName, &timestamp), nil).Times(1)
-	networkCaptureProvider.EXPECT().CaptureNetworkPacket(ctx, filter, duration, maxSize).Return(nil).Times(1)
+	networkCaptureProvider.EXPECT().CaptureNetworkPacket(ctx, filter, duration, maxSize, nil).Return(nil).Times(1)
 
 	_, err := cm.CaptureNetwork(ctx)
 	if err != nil {
@@ -143,3 +146,64 @@ func TestCleanup(t *testing.T) {
 		t.Errorf("Cleanup should have not fail with error %s", err)
 	}
 }
+
+func TestCaptureRingBuffer(t *testing.T) {
+	env := map[string]string{
+		captureConstants.RingBufferFileCountEnvKey:    "4",
+		captureConstants.RingBufferFileSizeEnvKey:     "50",
+		captureConstants.RingBufferWindowEnvKey:       "2m0s",
+		captureConstants.TriggerAnnotationEnvKey:      "true",
+		captureConstants.TriggerMetricNameEnvKey:      "networkobservability_drop_count",
+		captureConstants.TriggerMetricLabelsEnvKey:    "direction=ingress,reason=IPTABLE_RULE_DROP",
+		captureConstants.TriggerMetricThresholdEnvKey: "1500",
+	}
+	for k, v := range env {
+		os.Setenv(k, v)
+	}
+	defer func() {
+		for k := range env {
+			os.Unsetenv(k)
+		}
+	}()
+
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	cm := &CaptureManager{l: log.Logger().Named("test")}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ringBuffer, err := cm.captureRingBuffer(ctx)
+	if err != nil {
+		t.Fatalf("captureRingBuffer should have not fail with error %s", err)
+	}
+	if ringBuffer.FileCount != 4 || ringBuffer.FileSizeMB != 50 || ringBuffer.Window != 2*time.Minute || ringBuffer.Trigger == nil {
+		t.Errorf("captureRingBuffer() got unexpected ring buffer %+v", ringBuffer)
+	}
+
+	triggers, err := cm.captureTriggers()
+	if err != nil {
+		t.Fatalf("captureTriggers should have not fail with error %s", err)
+	}
+	wantTriggers := []trigger{
+		&annotationTrigger{path: "/etc/podinfo/annotations", annotation: captureConstants.CaptureTriggerAnnotation},
+		&metricTrigger{
+			url:       captureConstants.DefaultTriggerMetricsURL,
+			name:      "networkobservability_drop_count",
+			labels:    map[string]string{"direction": "ingress", "reason": "IPTABLE_RULE_DROP"},
+			threshold: 1500,
+		},
+	}
+	if diff := cmp.Diff(wantTriggers, triggers, cmp.AllowUnexported(annotationTrigger{}, metricTrigger{}), cmpopts.IgnoreFields(metricTrigger{}, "client")); diff != "" {
+		t.Errorf("captureTriggers() mismatch (-want, +got):\n%s", diff)
+	}
+
+	os.Unsetenv(captureConstants.TriggerAnnotationEnvKey)
+	os.Unsetenv(captureConstants.TriggerMetricNameEnvKey)
+	if _, err := cm.captureRingBuffer(ctx); err == nil {
+		t.Errorf("captureRingBuffer should fail without trigger")
+	}
+
+	os.Unsetenv(captureConstants.RingBufferFileCountEnvKey)
+	if ringBuffer, err := cm.captureRingBuffer(ctx); err != nil || ringBuffer != nil {
+		t.Errorf("captureRingBuffer() got %+v, %v, want no ring buffer", ringBuffer, err)
+	}
+}
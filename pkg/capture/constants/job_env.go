@@ -16,15 +16,27 @@ const (
 	CaptureOutputLocationEnvKeyS3Bucket              CaptureOutputLocationEnvKey = "S3_BUCKET"
 	CaptureOutputLocationEnvKeyS3Path                CaptureOutputLocationEnvKey = "S3_PATH"
 
-	CaptureNameEnvKey           string = "CAPTURE_NAME"
-	NodeHostNameEnvKey          string = "NODE_HOST_NAME"
-	CaptureStartTimestampEnvKey string = "CAPTURE_START_TIMESTAMP"
+	CaptureNameEnvKey            string = "CAPTURE_NAME"
+	NodeHostNameEnvKey           string = "NODE_HOST_NAME"
+	CaptureStartTimestampEnvKey  string = "CAPTURE_START_TIMESTAMP"
+	CapturePruneTimestampsEnvKey string = "CAPTURE_PRUNE_TIMESTAMPS"
 
 	CaptureFilterEnvKey   string = "CAPTURE_FILTER"
 	CaptureDurationEnvKey string = "CAPTURE_DURATION"
 	CaptureMaxSizeEnvKey  string = "CAPTURE_MAX_SIZE"
 	IncludeMetadataEnvKey string = "INCLUDE_METADATA"
 	PacketSizeEnvKey      string = "CAPTURE_PACKET_SIZE"
+	CaptureProviderEnvKey string = "CAPTURE_PROVIDER"
+
+	RingBufferFileCountEnvKey string = "RING_BUFFER_FILE_COUNT"
+	RingBufferFileSizeEnvKey  string = "RING_BUFFER_FILE_SIZE"
+	RingBufferWindowEnvKey    string = "RING_BUFFER_WINDOW"
+
+	TriggerAnnotationEnvKey      string = "TRIGGER_ANNOTATION"
+	TriggerMetricNameEnvKey      string = "TRIGGER_METRIC_NAME"
+	TriggerMetricLabelsEnvKey    string = "TRIGGER_METRIC_LABELS"
+	TriggerMetricThresholdEnvKey string = "TRIGGER_METRIC_THRESHOLD"
+	TriggerMetricsURLEnvKey      string = "TRIGGER_METRICS_URL"
 
 	TcpdumpFilterEnvKey    string = "TCPDUMP_FILTER"
 	TcpdumpRawFilterEnvKey string = "TCPDUMP_RAW_FILTER"
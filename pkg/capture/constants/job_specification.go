@@ -7,6 +7,19 @@ package constants
 const (
 	CaptureHostPathVolumeName string = "hostpath"
 	CapturePVCVolumeName      string = "pvc"
+	CapturePodInfoVolumeName  string = "podinfo"
+
+	// CapturePodInfoPath is the path of the downward API volume that exposes the annotations of the capture pod.
+	CapturePodInfoPath string = "/etc/podinfo"
+	// CapturePodInfoAnnotationsFile is the file of the downward API volume that stores the annotations of the capture pod.
+	CapturePodInfoAnnotationsFile string = "annotations"
+
+	// CaptureTriggerAnnotation fires the snapshot of a ring buffer capture when it is set on the Capture, and is
+	// propagated to the capture pods by the Capture controller.
+	CaptureTriggerAnnotation string = "retina.sh/capture-trigger"
+
+	// DefaultTriggerMetricsURL is the metrics endpoint of the Retina agent on the node of the capture pod.
+	DefaultTriggerMetricsURL string = "http://localhost:10093/metrics"
 
 	// PersistentVolumeClaimVolumeMountPathLinux is the PVC volume mount path of container hosted on Linux node.
 	PersistentVolumeClaimVolumeMountPathLinux string = "/mnt/azure"
@@ -16,9 +29,16 @@ const (
 	CaptureContainerEntrypoint    string = "./retina/captureworkload"
 	CaptureContainerEntrypointWin string = "captureworkload.exe"
 
+	// CaptureResultPath is the termination message path of the capture container, where the capture workload writes
+	// the result of the capture on the node.
+	CaptureResultPath string = "/dev/termination-log"
+
 	CaptureAppname       string = "capture"
 	CaptureContainername string = "capture"
 
+	// CapturePruneAppname is the app of the jobs removing the capture files of the pruned runs of a scheduled Capture.
+	CapturePruneAppname string = "capture-prune"
+
 	// CaptureOutputLocationBlobUploadSecretName is the name of the secret that stores the blob upload url.
 	CaptureOutputLocationBlobUploadSecretName string = "capture-blob-upload-secret"
 	// CaptureOutputLocationBlobUploadSecretPath is the path of the secret that stores the blob upload url.
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	batchv1 "k8s.io/api/batch/v1"
@@ -32,6 +33,13 @@ import (
 
 const anyIPOrPort = ""
 
+// Defaults of the ring buffer of the Capture CRD.
+const (
+	defaultRingBufferFileCount = 10
+	defaultRingBufferFileSize  = 10
+	defaultRingBufferWindow    = 5 * time.Minute
+)
+
 // CaptureTarget indicates on which the network capture will be performed on a given node.
 type CaptureTarget struct {
 	// PodIpAddresses indicates the capture is performed on the Pods per their IP addresses.
@@ -78,6 +86,11 @@ type CaptureToPodTranslator struct {
 	jobTemplate          *batchv1.Job
 	captureWorkloadImage string
 
+	// filterServiceIPs stores the IP addresses of the services selected by the filter rules of the Capture.
+	filterServiceIPs map[string][]string
+	// netshFilterErr tells why the filter rules of the Capture can't be translated to a netsh filter.
+	netshFilterErr error
+
 	config config.CaptureConfig
 
 	// Apiserver is unique identifier to identify the cluster in the trace capture.
@@ -136,6 +149,10 @@ func (translator *CaptureToPodTranslator) initJobTemplate(ctx context.Context, c
 							Name:            captureConstants.CaptureContainername,
 							Image:           translator.captureWorkloadImage,
 							ImagePullPolicy: corev1.PullIfNotPresent,
+							// The capture workload writes its result as the termination message, and the last logs
+							// tell why it failed when it could not write the result.
+							TerminationMessagePath:   captureConstants.CaptureResultPath,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 							SecurityContext: &corev1.SecurityContext{
 								Capabilities: &corev1.Capabilities{
 									Add: []corev1.Capability{
@@ -198,6 +215,32 @@ func (translator *CaptureToPodTranslator) initJobTemplate(ctx context.Context, c
 		},
 	}
 
+	// The Capture controller propagates the trigger annotation of the Capture to the capture pods, and the downward API
+	// volume exposes it to the ring buffer capture.
+	if ringBuffer := capture.Spec.CaptureConfiguration.CaptureOption.RingBuffer; ringBuffer != nil && ringBuffer.Triggers.Annotation {
+		podInfoVolume := corev1.Volume{
+			Name: captureConstants.CapturePodInfoVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				DownwardAPI: &corev1.DownwardAPIVolumeSource{
+					Items: []corev1.DownwardAPIVolumeFile{
+						{
+							Path:     captureConstants.CapturePodInfoAnnotationsFile,
+							FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations"},
+						},
+					},
+				},
+			},
+		}
+		translator.jobTemplate.Spec.Template.Spec.Volumes = append(translator.jobTemplate.Spec.Template.Spec.Volumes, podInfoVolume)
+
+		podInfoVolumeMount := corev1.VolumeMount{
+			Name:      captureConstants.CapturePodInfoVolumeName,
+			ReadOnly:  true,
+			MountPath: captureConstants.CapturePodInfoPath,
+		}
+		translator.jobTemplate.Spec.Template.Spec.Containers[0].VolumeMounts = append(translator.jobTemplate.Spec.Template.Spec.Containers[0].VolumeMounts, podInfoVolumeMount)
+	}
+
 	if capture.Spec.OutputConfiguration.HostPath != nil && *capture.Spec.OutputConfiguration.HostPath != "" {
 		translator.l.Info("HostPath is not empty", zap.String("HostPath", *capture.Spec.OutputConfiguration.HostPath))
 
@@ -362,6 +405,10 @@ func (translator *CaptureToPodTranslator) TranslateCaptureToJobs(ctx context.Con
 		return nil, err
 	}
 
+	if err := translator.resolveFilterServices(ctx, capture); err != nil {
+		return nil, err
+	}
+
 	jobPodEnv, err := translator.ObtainCaptureJobPodEnv(*capture)
 	if err != nil {
 		return nil, err
@@ -414,6 +461,13 @@ func (translator *CaptureToPodTranslator) renderJob(captureTargetOnNode *Capture
 			},
 		}
 
+		if target.OS != "linux" && len(jobEnv[captureConstants.RingBufferFileCountEnvKey]) != 0 {
+			return nil, fmt.Errorf("ring buffer capture is not supported on Windows node %s", nodeName)
+		}
+		if target.OS != "linux" && jobEnv[captureConstants.CaptureProviderEnvKey] == string(retinav1alpha1.CaptureProviderEBPF) {
+			return nil, fmt.Errorf("eBPF capture provider is not supported on Windows node %s", nodeName)
+		}
+
 		if target.OS == "linux" {
 			// tcpdump requires run as a root for Linux, while for pods deployed on Windows node, there's an ongoing
 			// issue that causes pods to not start on Windows.
@@ -445,7 +499,15 @@ func (translator *CaptureToPodTranslator) renderJob(captureTargetOnNode *Capture
 			}
 
 			delete(jobEnv, captureConstants.TcpdumpFilterEnvKey)
+			if translator.netshFilterErr != nil {
+				return nil, fmt.Errorf("filter rules are not supported on Windows node %s: %w", nodeName, translator.netshFilterErr)
+			}
 			if netshFilter := getNetshFilterWithPodIPAddress(target.PodIpAddresses); len(netshFilter) != 0 {
+				// netsh joins the filters with logic AND, which can't include the traffic of the Pods besides the
+				// traffic matching the filter rules.
+				if len(jobEnv[captureConstants.NetshFilterEnvKey]) != 0 {
+					return nil, fmt.Errorf("filter rules can't be used together with Pod targets on Windows node %s", nodeName)
+				}
 				jobEnv[captureConstants.NetshFilterEnvKey] = netshFilter
 			}
 		}
@@ -462,6 +524,135 @@ func (translator *CaptureToPodTranslator) renderJob(captureTargetOnNode *Capture
 	return jobs, nil
 }
 
+// RenderPruneJobs renders the jobs removing the capture files of the runs of a scheduled Capture started at the
+// timestamps from the HostPath of the nodes, mapped to their operating system.
+func (translator *CaptureToPodTranslator) RenderPruneJobs(capture *retinav1alpha1.Capture, nodes map[string]string, timestamps []string) ([]*batchv1.Job, error) {
+	if capture.Spec.OutputConfiguration.HostPath == nil || *capture.Spec.OutputConfiguration.HostPath == "" {
+		return nil, fmt.Errorf("capture %s/%s has no HostPath to prune", capture.Namespace, capture.Name)
+	}
+	hostPath := *capture.Spec.OutputConfiguration.HostPath
+
+	backoffLimit := int32(0)
+	// The prune jobs are removed shortly after they finish, as the next pruned run creates new ones.
+	ttlSecondsAfterFinished := int32(600)
+	captureFolderHostPathType := corev1.HostPathDirectoryOrCreate
+	jobTemplate := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-prune-", capture.Name),
+			Namespace:    capture.Namespace,
+			Labels:       captureUtils.GetPruneJobLabelsFromCaptureName(capture.Name),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttlSecondsAfterFinished,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:    captureUtils.GetPruneJobLabelsFromCaptureName(capture.Name),
+					Namespace: capture.Namespace,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:            captureConstants.CaptureContainername,
+							Image:           translator.captureWorkloadImage,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							SecurityContext: &corev1.SecurityContext{},
+							Env: []corev1.EnvVar{
+								{Name: string(captureConstants.CaptureOutputLocationEnvKeyHostPath), Value: hostPath},
+								{Name: captureConstants.CaptureNameEnvKey, Value: capture.Name},
+								{Name: captureConstants.CapturePruneTimestampsEnvKey, Value: strings.Join(timestamps, ",")},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: captureConstants.CaptureHostPathVolumeName, MountPath: hostPath},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("10m"),
+									corev1.ResourceMemory: resource.MustParse("64Mi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("100m"),
+									corev1.ResourceMemory: resource.MustParse("300Mi"),
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: captureConstants.CaptureHostPathVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								HostPath: &corev1.HostPathVolumeSource{Path: hostPath, Type: &captureFolderHostPathType},
+							},
+						},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "CriticalAddonsOnly",
+							Operator: "Exists",
+						},
+						{
+							Effect:   "NoExecute",
+							Operator: "Exists",
+						},
+						{
+							Effect:   "NoSchedule",
+							Operator: "Exists",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	nodeNames := make([]string, 0, len(nodes))
+	for nodeName := range nodes {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+
+	jobs := make([]*batchv1.Job, 0, len(nodes))
+	for _, nodeName := range nodeNames {
+		job := jobTemplate.DeepCopy()
+		job.Spec.Template.Spec.Affinity = &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{
+									Key:      corev1.LabelHostname,
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{nodeName},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		container := &job.Spec.Template.Spec.Containers[0]
+		if nodes[nodeName] == "windows" {
+			containerAdministrator := "NT AUTHORITY\\SYSTEM"
+			useHostProcess := true
+			job.Spec.Template.Spec.HostNetwork = true
+			container.SecurityContext.WindowsOptions = &corev1.WindowsSecurityContextOptions{
+				HostProcess:   &useHostProcess,
+				RunAsUserName: &containerAdministrator,
+			}
+			container.Command = []string{captureConstants.CaptureContainerEntrypointWin}
+		} else {
+			// The capture files are written by root.
+			rootUser := int64(0)
+			container.SecurityContext.RunAsUser = &rootUser
+			container.Command = []string{captureConstants.CaptureContainerEntrypoint}
+		}
+		container.Env = append(container.Env, corev1.EnvVar{Name: captureConstants.NodeHostNameEnvKey, Value: nodeName})
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
 func printOutputFileNames(captureTargetOnNode *CaptureTargetsOnNode, envCommon map[string]string, timestamp *file.Timestamp) {
 	captureFileNames := []string{}
 	for k := range *captureTargetOnNode {
@@ -539,6 +730,10 @@ func getNetshFilterWithPodIPAddress(podIPAddresses []string) string {
 
 // validateTargetSelector validate target selectors defined in the capture.
 func (translator *CaptureToPodTranslator) validateTargetSelector(captureTarget retinav1alpha1.CaptureTarget) error {
+	return validateTargetSelector(captureTarget)
+}
+
+func validateTargetSelector(captureTarget retinav1alpha1.CaptureTarget) error {
 	// When NamespaceSelector is nil while PodSelector is specified, the namespace will be determined by capture.Namespace.
 	if captureTarget.NodeSelector == nil && captureTarget.PodSelector == nil {
 		return fmt.Errorf("Neither NodeSelector nor NamespaceSelector&PodSelector is set.")
@@ -552,15 +747,49 @@ func (translator *CaptureToPodTranslator) validateTargetSelector(captureTarget r
 }
 
 func (translator *CaptureToPodTranslator) validateCapture(capture *retinav1alpha1.Capture) error {
-	if err := translator.validateTargetSelector(capture.Spec.CaptureConfiguration.CaptureTarget); err != nil {
+	return ValidateCapture(capture)
+}
+
+// ValidateCapture validates the spec of the Capture beyond the validation of the Capture CRD, which is shared by the
+// Capture controller and the admission webhook.
+func ValidateCapture(capture *retinav1alpha1.Capture) error {
+	if err := validateTargetSelector(capture.Spec.CaptureConfiguration.CaptureTarget); err != nil {
 		return err
 	}
 
-	// TODO(mainred): do we need to set a limitation to the capture file size anyway?
-	if capture.Spec.CaptureConfiguration.CaptureOption.Duration == nil && capture.Spec.CaptureConfiguration.CaptureOption.MaxCaptureSize == nil {
+	// The ring buffer limits the size of the capture files, and the capture stops when a trigger fires.
+	if ringBuffer := capture.Spec.CaptureConfiguration.CaptureOption.RingBuffer; ringBuffer != nil {
+		if !ringBuffer.Triggers.Annotation && ringBuffer.Triggers.Metric == nil {
+			return fmt.Errorf("At least one trigger should be set for the ring buffer")
+		}
+		if ringBuffer.FileCount != nil && *ringBuffer.FileCount < 2 {
+			return fmt.Errorf("The ring buffer should have at least 2 files")
+		}
+		if ringBuffer.Triggers.Metric != nil && ringBuffer.Triggers.Metric.Name == "" {
+			return fmt.Errorf("The metric name of the ring buffer trigger should be set")
+		}
+	} else if capture.Spec.CaptureConfiguration.CaptureOption.Duration == nil && capture.Spec.CaptureConfiguration.CaptureOption.MaxCaptureSize == nil {
+		// TODO(mainred): do we need to set a limitation to the capture file size anyway?
 		return fmt.Errorf("Neither duration nor maxCaptureSize is set to stop the capture")
 	}
 
+	// The eBPF provider writes the capture file itself, and filters the packets with the filters only.
+	if capture.Spec.CaptureConfiguration.CaptureOption.Provider == retinav1alpha1.CaptureProviderEBPF {
+		if capture.Spec.CaptureConfiguration.CaptureOption.RingBuffer != nil {
+			return fmt.Errorf("Ring buffer mode is not supported by the eBPF capture provider")
+		}
+		if capture.Spec.CaptureConfiguration.TcpdumpFilter != nil {
+			return fmt.Errorf("TcpdumpFilter is not supported by the eBPF capture provider, use filters instead")
+		}
+	}
+
+	if _, _, err := parseIncludeAndExcludeFilters(capture.Spec.CaptureConfiguration.Filters); err != nil {
+		return err
+	}
+	if err := validateFilterRules(capture.Spec.CaptureConfiguration.Filters, capture.Spec.CaptureConfiguration.CaptureOption.Provider); err != nil {
+		return err
+	}
+
 	if capture.Spec.OutputConfiguration.BlobUpload == nil &&
 		capture.Spec.OutputConfiguration.HostPath == nil &&
 		capture.Spec.OutputConfiguration.PersistentVolumeClaim == nil &&
@@ -717,47 +946,57 @@ func (translator *CaptureToPodTranslator) calculateCaptureTargetsByPodSelector(c
 // same case for exclude filters, finally the filters overall will be like:
 // ((include1) or (include2)) and not ((exclude1) or (exclude2))
 func tcpdumpFiltersFromIncludeAndExcludeFilters(includeIPPortsFilters, excludeIPPortsFilters map[string][]string) string {
-	getFilterGroupFunc := func(ipPortsFilters map[string][]string) string {
-		if len(ipPortsFilters) == 0 {
-			return ""
-		}
-		ips := make([]string, 0)
-
-		for ip := range ipPortsFilters {
-			ips = append(ips, ip)
-		}
-		sort.Strings(ips)
-		var filterArray []string
-		for _, ip := range ips {
-			ports := ipPortsFilters[ip]
-			sort.Strings(ports)
-			var filter string
-			if ip == anyIPOrPort {
-				for _, port := range ports {
-					filter = fmt.Sprintf("(port %s)", port)
-					filterArray = append(filterArray, filter)
-				}
-				continue
-			}
-			if len(ports) == 1 && ports[0] == anyIPOrPort {
-				filter = fmt.Sprintf("(host %s)", ip)
-				filterArray = append(filterArray, filter)
-				continue
-			}
+	return joinTcpdumpFilterGroups(tcpdumpFiltersFromIPPortsFilters(includeIPPortsFilters), tcpdumpFiltersFromIPPortsFilters(excludeIPPortsFilters))
+}
+
+// tcpdumpFiltersFromIPPortsFilters returns the tcpdump filter of each ip:port filter, in parentheses.
+func tcpdumpFiltersFromIPPortsFilters(ipPortsFilters map[string][]string) []string {
+	if len(ipPortsFilters) == 0 {
+		return nil
+	}
+	ips := make([]string, 0)
+
+	for ip := range ipPortsFilters {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	var filterArray []string
+	for _, ip := range ips {
+		ports := ipPortsFilters[ip]
+		sort.Strings(ports)
+		var filter string
+		if ip == anyIPOrPort {
 			for _, port := range ports {
-				filter = fmt.Sprintf("(host %s and port %s)", ip, port)
+				filter = fmt.Sprintf("(port %s)", port)
 				filterArray = append(filterArray, filter)
 			}
+			continue
+		}
+		if len(ports) == 1 && ports[0] == anyIPOrPort {
+			filter = fmt.Sprintf("(host %s)", ip)
+			filterArray = append(filterArray, filter)
+			continue
 		}
+		for _, port := range ports {
+			filter = fmt.Sprintf("(host %s and port %s)", ip, port)
+			filterArray = append(filterArray, filter)
+		}
+	}
+	return filterArray
+}
+
+// joinTcpdumpFilterGroups groups the include filters and the exclude filters in parentheses, and joins the groups as
+// (include1 or include2) and not (exclude1 or exclude2).
+func joinTcpdumpFilterGroups(includeFilters, excludeFilters []string) string {
+	getFilterGroupFunc := func(filterArray []string) string {
 		if len(filterArray) == 0 {
 			return ""
 		}
-		filterGroup := fmt.Sprintf("(%s)", strings.Join(filterArray, " or "))
-		return filterGroup
+		return fmt.Sprintf("(%s)", strings.Join(filterArray, " or "))
 	}
 
-	includeFilterGroup := getFilterGroupFunc(includeIPPortsFilters)
-	excludeFilterGroup := getFilterGroupFunc(excludeIPPortsFilters)
+	includeFilterGroup := getFilterGroupFunc(includeFilters)
+	excludeFilterGroup := getFilterGroupFunc(excludeFilters)
 
 	if len(includeFilterGroup) == 0 && len(excludeFilterGroup) == 0 {
 		return ""
@@ -889,8 +1128,15 @@ func (translator *CaptureToPodTranslator) obtainTcpdumpFilters(captureConfig ret
 	if err != nil {
 		return "", err
 	}
+	includeRuleFilters, excludeRuleFilters, err := translator.tcpdumpFiltersFromRules(captureConfig.Filters)
+	if err != nil {
+		return "", err
+	}
 
-	tcpdumpFilter := tcpdumpFiltersFromIncludeAndExcludeFilters(includeIPPortFilters, excludeIPPortFilters)
+	tcpdumpFilter := joinTcpdumpFilterGroups(
+		append(tcpdumpFiltersFromIPPortsFilters(includeIPPortFilters), includeRuleFilters...),
+		append(tcpdumpFiltersFromIPPortsFilters(excludeIPPortFilters), excludeRuleFilters...),
+	)
 	if len(tcpdumpFilter) != 0 {
 		translator.l.Info("Get the parsed filter from include and include filters",
 			zap.String("parsed filter", tcpdumpFilter),
@@ -934,9 +1180,51 @@ func (translator *CaptureToPodTranslator) obtainCaptureOptionEnv(option retinav1
 	if option.MaxCaptureSize != nil {
 		outputEnv[captureConstants.CaptureMaxSizeEnvKey] = strconv.Itoa(*option.MaxCaptureSize)
 	}
+	if option.RingBuffer != nil {
+		for key, val := range obtainRingBufferEnv(option.RingBuffer) {
+			outputEnv[key] = val
+		}
+	}
 	return outputEnv, nil
 }
 
+// obtainRingBufferEnv translates RingBuffer to Environment variables to capture job Pod, with the defaults of the
+// Capture CRD for the Captures created by the CLI.
+func obtainRingBufferEnv(ringBuffer *retinav1alpha1.RingBuffer) map[string]string {
+	fileCount, fileSize, window := defaultRingBufferFileCount, defaultRingBufferFileSize, defaultRingBufferWindow
+	if ringBuffer.FileCount != nil {
+		fileCount = *ringBuffer.FileCount
+	}
+	if ringBuffer.FileSize != nil {
+		fileSize = *ringBuffer.FileSize
+	}
+	if ringBuffer.Window != nil {
+		window = ringBuffer.Window.Duration
+	}
+	ringBufferEnv := map[string]string{
+		captureConstants.RingBufferFileCountEnvKey: strconv.Itoa(fileCount),
+		captureConstants.RingBufferFileSizeEnvKey:  strconv.Itoa(fileSize),
+		captureConstants.RingBufferWindowEnvKey:    window.String(),
+	}
+
+	if ringBuffer.Triggers.Annotation {
+		ringBufferEnv[captureConstants.TriggerAnnotationEnvKey] = strconv.FormatBool(true)
+	}
+	if metric := ringBuffer.Triggers.Metric; metric != nil {
+		ringBufferEnv[captureConstants.TriggerMetricNameEnvKey] = metric.Name
+		ringBufferEnv[captureConstants.TriggerMetricThresholdEnvKey] = strconv.FormatFloat(metric.Threshold.AsApproximateFloat64(), 'f', -1, 64)
+		if len(metric.Labels) != 0 {
+			labels := make([]string, 0, len(metric.Labels))
+			for key, val := range metric.Labels {
+				labels = append(labels, key+"="+val)
+			}
+			sort.Strings(labels)
+			ringBufferEnv[captureConstants.TriggerMetricLabelsEnvKey] = strings.Join(labels, ",")
+		}
+	}
+	return ringBufferEnv
+}
+
 // ObtainCaptureJobPodEnv translates Capture object to Environment variables to capture job Pod.
 func (translator *CaptureToPodTranslator) ObtainCaptureJobPodEnv(capture retinav1alpha1.Capture) (map[string]string, error) {
 	jobPodEnv := map[string]string{}
@@ -965,10 +1253,21 @@ func (translator *CaptureToPodTranslator) ObtainCaptureJobPodEnv(capture retinav
 		jobPodEnv[captureConstants.TcpdumpFilterEnvKey] = tcpdumpFilter
 	}
 
+	// The netsh filter of the filter rules is checked when the capture job is rendered for a Windows node.
+	netshFilter, err := translator.netshFilterFromRules(capture.Spec.CaptureConfiguration.Filters)
+	translator.netshFilterErr = err
+	if len(netshFilter) != 0 {
+		jobPodEnv[captureConstants.NetshFilterEnvKey] = netshFilter
+	}
+
 	if capture.Spec.CaptureConfiguration.CaptureOption.PacketSize != nil {
 		jobPodEnv[captureConstants.PacketSizeEnvKey] = strconv.Itoa(*capture.Spec.CaptureConfiguration.CaptureOption.PacketSize)
 	}
 
+	if provider := capture.Spec.CaptureConfiguration.CaptureOption.Provider; provider != "" && provider != retinav1alpha1.CaptureProviderDefault {
+		jobPodEnv[captureConstants.CaptureProviderEnvKey] = string(provider)
+	}
+
 	if capture.Spec.CaptureConfiguration.TcpdumpFilter != nil {
 		jobPodEnv[captureConstants.TcpdumpRawFilterEnvKey] = *capture.Spec.CaptureConfiguration.TcpdumpFilter
 	}
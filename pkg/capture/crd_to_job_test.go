@@ -531,6 +531,45 @@ func Test_CaptureToPodTranslator_ObtainCaptureJobPodEnv(t *testing.T) {
 				captureConstants.IncludeMetadataEnvKey: "false",
 			},
 		},
+		{
+			name: "eBPF provider",
+			capture: retinav1alpha1.Capture{
+				Spec: retinav1alpha1.CaptureSpec{
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						HostPath: pointerUtil.String("/tmp/capture"),
+					},
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureOption: retinav1alpha1.CaptureOption{
+							Provider: retinav1alpha1.CaptureProviderEBPF,
+						},
+					},
+				},
+			},
+			wantJobEnv: map[string]string{
+				string(captureConstants.CaptureOutputLocationEnvKeyHostPath): "/tmp/capture",
+				captureConstants.IncludeMetadataEnvKey:                       "false",
+				captureConstants.CaptureProviderEnvKey:                       "eBPF",
+			},
+		},
+		{
+			name: "default provider",
+			capture: retinav1alpha1.Capture{
+				Spec: retinav1alpha1.CaptureSpec{
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						HostPath: pointerUtil.String("/tmp/capture"),
+					},
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureOption: retinav1alpha1.CaptureOption{
+							Provider: retinav1alpha1.CaptureProviderDefault,
+						},
+					},
+				},
+			},
+			wantJobEnv: map[string]string{
+				string(captureConstants.CaptureOutputLocationEnvKeyHostPath): "/tmp/capture",
+				captureConstants.IncludeMetadataEnvKey:                       "false",
+			},
+		},
 		{
 			name: "include metadata",
 			capture: retinav1alpha1.Capture{
@@ -569,6 +608,67 @@ func Test_CaptureToPodTranslator_ObtainCaptureJobPodEnv(t *testing.T) {
 				captureConstants.PacketSizeEnvKey:                                         strconv.Itoa(packetSize),
 			},
 		},
+		{
+			name: "ring buffer with defaults",
+			capture: retinav1alpha1.Capture{
+				Spec: retinav1alpha1.CaptureSpec{
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						PersistentVolumeClaim: pointerUtil.String("capture-pvc"),
+					},
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureOption: retinav1alpha1.CaptureOption{
+							RingBuffer: &retinav1alpha1.RingBuffer{
+								Triggers: retinav1alpha1.CaptureTriggers{Annotation: true},
+							},
+						},
+					},
+				},
+			},
+			wantJobEnv: map[string]string{
+				captureConstants.IncludeMetadataEnvKey:                                    "false",
+				string(captureConstants.CaptureOutputLocationEnvKeyPersistentVolumeClaim): "capture-pvc",
+				captureConstants.RingBufferFileCountEnvKey:                                "10",
+				captureConstants.RingBufferFileSizeEnvKey:                                 "10",
+				captureConstants.RingBufferWindowEnvKey:                                   "5m0s",
+				captureConstants.TriggerAnnotationEnvKey:                                  "true",
+			},
+		},
+		{
+			name: "ring buffer with metric trigger",
+			capture: retinav1alpha1.Capture{
+				Spec: retinav1alpha1.CaptureSpec{
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						PersistentVolumeClaim: pointerUtil.String("capture-pvc"),
+					},
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureOption: retinav1alpha1.CaptureOption{
+							RingBuffer: &retinav1alpha1.RingBuffer{
+								FileCount: pointerUtil.Int(4),
+								FileSize:  pointerUtil.Int(50),
+								Window:    &metav1.Duration{Duration: 2 * time.Minute},
+								Triggers: retinav1alpha1.CaptureTriggers{
+									Metric: &retinav1alpha1.MetricTrigger{
+										Name:      "networkobservability_drop_count",
+										Labels:    map[string]string{"reason": "IPTABLE_RULE_DROP", "direction": "ingress"},
+										Threshold: resource.MustParse("1.5k"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantJobEnv: map[string]string{
+				captureConstants.IncludeMetadataEnvKey:                                    "false",
+				string(captureConstants.CaptureOutputLocationEnvKeyPersistentVolumeClaim): "capture-pvc",
+				captureConstants.RingBufferFileCountEnvKey:                                "4",
+				captureConstants.RingBufferFileSizeEnvKey:                                 "50",
+				captureConstants.RingBufferWindowEnvKey:                                   "2m0s",
+				captureConstants.TriggerMetricNameEnvKey:                                  "networkobservability_drop_count",
+				captureConstants.TriggerMetricLabelsEnvKey:                                "direction=ingress,reason=IPTABLE_RULE_DROP",
+				captureConstants.TriggerMetricThresholdEnvKey:                             "1500",
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -606,6 +706,12 @@ func Test_CaptureToPodTranslator_RenderJob_NodeSelected(t *testing.T) {
 			env:                 map[string]string{},
 			wantErr:             true,
 		},
+		{
+			name:                "eBPF provider on Windows node",
+			captureTargetOnNode: &CaptureTargetsOnNode{"node1": {OS: "windows"}},
+			env:                 map[string]string{captureConstants.CaptureProviderEnvKey: string(retinav1alpha1.CaptureProviderEBPF)},
+			wantErr:             true,
+		},
 		{
 			name:                "single node is selected",
 			captureTargetOnNode: &CaptureTargetsOnNode{"node1": {}},
@@ -787,6 +893,145 @@ func Test_CaptureToPodTranslator_ValidateCapture(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "raise error when ring buffer has no trigger",
+			capture: retinav1alpha1.Capture{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: captureName,
+				},
+				Spec: retinav1alpha1.CaptureSpec{
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureTarget: retinav1alpha1.CaptureTarget{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"nodename": nodeName,
+								},
+							},
+						},
+						CaptureOption: retinav1alpha1.CaptureOption{
+							RingBuffer: &retinav1alpha1.RingBuffer{},
+						},
+					},
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						HostPath: &hostPath,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validation is ok for ring buffer without duration and maxCaptureSize",
+			capture: retinav1alpha1.Capture{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: captureName,
+				},
+				Spec: retinav1alpha1.CaptureSpec{
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureTarget: retinav1alpha1.CaptureTarget{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"nodename": nodeName,
+								},
+							},
+						},
+						CaptureOption: retinav1alpha1.CaptureOption{
+							RingBuffer: &retinav1alpha1.RingBuffer{
+								Triggers: retinav1alpha1.CaptureTriggers{Annotation: true},
+							},
+						},
+					},
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						HostPath: &hostPath,
+					},
+				},
+			},
+		},
+		{
+			name: "raise error when eBPF provider is used in ring buffer mode",
+			capture: retinav1alpha1.Capture{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: captureName,
+				},
+				Spec: retinav1alpha1.CaptureSpec{
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureTarget: retinav1alpha1.CaptureTarget{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"nodename": nodeName,
+								},
+							},
+						},
+						CaptureOption: retinav1alpha1.CaptureOption{
+							Provider: retinav1alpha1.CaptureProviderEBPF,
+							RingBuffer: &retinav1alpha1.RingBuffer{
+								Triggers: retinav1alpha1.CaptureTriggers{Annotation: true},
+							},
+						},
+					},
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						HostPath: &hostPath,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "raise error when eBPF provider is used with tcpdumpFilter",
+			capture: retinav1alpha1.Capture{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: captureName,
+				},
+				Spec: retinav1alpha1.CaptureSpec{
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureTarget: retinav1alpha1.CaptureTarget{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"nodename": nodeName,
+								},
+							},
+						},
+						CaptureOption: retinav1alpha1.CaptureOption{
+							Provider: retinav1alpha1.CaptureProviderEBPF,
+							Duration: &metav1.Duration{Duration: 10 * time.Second},
+						},
+						TcpdumpFilter: pointerUtil.String("-i eth0"),
+					},
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						HostPath: &hostPath,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validation is ok when eBPF provider is used with filters",
+			capture: retinav1alpha1.Capture{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: captureName,
+				},
+				Spec: retinav1alpha1.CaptureSpec{
+					CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+						CaptureTarget: retinav1alpha1.CaptureTarget{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"nodename": nodeName,
+								},
+							},
+						},
+						CaptureOption: retinav1alpha1.CaptureOption{
+							Provider: retinav1alpha1.CaptureProviderEBPF,
+							Duration: &metav1.Duration{Duration: 10 * time.Second},
+						},
+						Filters: &retinav1alpha1.CaptureConfigurationFilters{
+							Include: []string{"10.0.0.1:80"},
+						},
+					},
+					OutputConfiguration: retinav1alpha1.OutputConfiguration{
+						HostPath: &hostPath,
+					},
+				},
+			},
+		},
 		{
 			name: "validation is ok when all are set",
 			capture: retinav1alpha1.Capture{
@@ -868,9 +1113,11 @@ func Test_CaptureToPodTranslator_TranslateCaptureToJobs(t *testing.T) {
 					TerminationGracePeriodSeconds: pointerUtil.Int64(1800),
 					Containers: []corev1.Container{
 						{
-							Name:            captureConstants.CaptureContainername,
-							Image:           retinaAgentImageForTest,
-							ImagePullPolicy: corev1.PullIfNotPresent,
+							Name:                     captureConstants.CaptureContainername,
+							Image:                    retinaAgentImageForTest,
+							ImagePullPolicy:          corev1.PullIfNotPresent,
+							TerminationMessagePath:   captureConstants.CaptureResultPath,
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 							SecurityContext: &corev1.SecurityContext{
 								RunAsUser: &rootUser,
 								Capabilities: &corev1.Capabilities{
@@ -2061,6 +2308,31 @@ func Test_CaptureToPodTranslator_obtainTcpdumpFilters(t *testing.T) {
 			},
 			wantedTcpdumpFilter: "((host 192.168.0.1 and port 80)) and not ((host 192.168.1.1 and port 80))",
 		},
+		{
+			name: "filter rules are combined with include and exclude filters",
+			captureConfig: retinav1alpha1.CaptureConfiguration{
+				Filters: &retinav1alpha1.CaptureConfigurationFilters{
+					Include: []string{"192.168.0.1:80"},
+					IncludeRules: []retinav1alpha1.CaptureFilterRule{{
+						Protocol: retinav1alpha1.CaptureFilterProtocolTCP,
+						Ports:    []retinav1alpha1.CapturePortRange{{Port: 443}},
+					}},
+					ExcludeRules: []retinav1alpha1.CaptureFilterRule{{CIDRs: []string{"10.0.0.0/8"}}},
+				},
+			},
+			wantedTcpdumpFilter: "((host 192.168.0.1 and port 80) or (tcp and port 443)) and not ((net 10.0.0.0/8))",
+		},
+		{
+			name: "raise error when the services of the filter rule are not resolved",
+			captureConfig: retinav1alpha1.CaptureConfiguration{
+				Filters: &retinav1alpha1.CaptureConfigurationFilters{
+					IncludeRules: []retinav1alpha1.CaptureFilterRule{{
+						Service: &retinav1alpha1.CaptureServiceSelector{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "raise error when when the port filter exceeds lower limit",
 			captureConfig: retinav1alpha1.CaptureConfiguration{
@@ -2204,3 +2476,43 @@ func TestGetNetshFilterWithPodIPAddress(t *testing.T) {
 		})
 	}
 }
+
+func Test_CaptureToPodTranslator_RenderPruneJobs(t *testing.T) {
+	hostPath := "/tmp/capture"
+	capture := &retinav1alpha1.Capture{
+		ObjectMeta: metav1.ObjectMeta{Name: "capture-test", Namespace: "capture"},
+		Spec: retinav1alpha1.CaptureSpec{
+			OutputConfiguration: retinav1alpha1.OutputConfiguration{HostPath: &hostPath},
+		},
+	}
+
+	captureToPodTranslator := NewCaptureToPodTranslatorForTest(fakeclientset.NewSimpleClientset())
+	jobs, err := captureToPodTranslator.RenderPruneJobs(capture, map[string]string{"node2": "windows", "node1": "linux"}, []string{"20240101000000UTC", "20240101010000UTC"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	for i, nodeName := range []string{"node1", "node2"} {
+		job := jobs[i]
+		require.Equal(t, captureUtils.GetPruneJobLabelsFromCaptureName("capture-test"), job.Labels)
+		require.Equal(t, nodeName, job.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Values[0])
+		require.Equal(t, hostPath, job.Spec.Template.Spec.Volumes[0].HostPath.Path)
+
+		container := job.Spec.Template.Spec.Containers[0]
+		wantEnv := []corev1.EnvVar{
+			{Name: string(captureConstants.CaptureOutputLocationEnvKeyHostPath), Value: hostPath},
+			{Name: captureConstants.CaptureNameEnvKey, Value: "capture-test"},
+			{Name: captureConstants.CapturePruneTimestampsEnvKey, Value: "20240101000000UTC,20240101010000UTC"},
+			{Name: captureConstants.NodeHostNameEnvKey, Value: nodeName},
+		}
+		if diff := cmp.Diff(wantEnv, container.Env); diff != "" {
+			t.Errorf("RenderPruneJobs() env mismatch (-want, +got):\n%s", diff)
+		}
+	}
+	require.Equal(t, []string{captureConstants.CaptureContainerEntrypoint}, jobs[0].Spec.Template.Spec.Containers[0].Command)
+	require.Equal(t, []string{captureConstants.CaptureContainerEntrypointWin}, jobs[1].Spec.Template.Spec.Containers[0].Command)
+	require.True(t, *jobs[1].Spec.Template.Spec.Containers[0].SecurityContext.WindowsOptions.HostProcess)
+
+	capture.Spec.OutputConfiguration.HostPath = nil
+	_, err = captureToPodTranslator.RenderPruneJobs(capture, map[string]string{"node1": "linux"}, []string{"20240101000000UTC"})
+	require.Error(t, err)
+}
@@ -2,8 +2,15 @@ package file
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
+// captureFileExtensions are the extensions of the capture files, the longest first.
+var captureFileExtensions = []string{".tar.gz", ".pcapng", ".pcap", ".etl"}
+
 type CaptureFilename struct {
 	CaptureName    string
 	NodeHostname   string
@@ -14,3 +21,51 @@ func (cf *CaptureFilename) String() string {
 	uniqueName := fmt.Sprintf("%s-%s-%s", cf.CaptureName, cf.NodeHostname, cf.StartTimestamp)
 	return uniqueName
 }
+
+// ParseCaptureFilename parses the name of the capture files of the Capture, without the file extensions,
+// such as the tarball "$(capturename)-$(hostname)-$(timestamp).tar.gz".
+func ParseCaptureFilename(captureName, fileName string) (*CaptureFilename, error) {
+	base := trimCaptureFileExtension(filepath.Base(fileName))
+	rest, found := strings.CutPrefix(base, captureName+"-")
+	if !found {
+		return nil, errors.Errorf("file %s is not a file of capture %s", fileName, captureName)
+	}
+	i := strings.LastIndex(rest, "-")
+	if i <= 0 {
+		return nil, errors.Errorf("file %s has no node name and timestamp", fileName)
+	}
+	timestamp, err := StringToTimestamp(rest[i+1:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "file %s has an invalid timestamp", fileName)
+	}
+	return &CaptureFilename{CaptureName: captureName, NodeHostname: rest[:i], StartTimestamp: timestamp}, nil
+}
+
+// ParseCaptureFileTimestamp parses the start timestamp of a capture file of any Capture, which is the only part of the
+// name that can be told apart without the name of the Capture.
+func ParseCaptureFileTimestamp(fileName string) (*Timestamp, error) {
+	base := trimCaptureFileExtension(filepath.Base(fileName))
+	if base == filepath.Base(fileName) {
+		return nil, errors.Errorf("file %s has no capture file extension", fileName)
+	}
+	i := strings.LastIndex(base, "-")
+	if i <= 0 {
+		return nil, errors.Errorf("file %s has no timestamp", fileName)
+	}
+	timestamp, err := StringToTimestamp(base[i+1:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "file %s has an invalid timestamp", fileName)
+	}
+	return timestamp, nil
+}
+
+// trimCaptureFileExtension removes the extension of a capture file. Node names may contain dots, so only the
+// extensions of the capture files are removed.
+func trimCaptureFileExtension(base string) string {
+	for _, ext := range captureFileExtensions {
+		if trimmed, found := strings.CutSuffix(base, ext); found {
+			return trimmed
+		}
+	}
+	return base
+}
@@ -0,0 +1,55 @@
+package file
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCaptureFilename(t *testing.T) {
+	ts := Timestamp{Time: time.Date(2024, 3, 20, 1, 36, 0, 0, time.UTC)}
+	name := (&CaptureFilename{CaptureName: "retina-capture", NodeHostname: "aks-nodepool1-41844487-vmss000000", StartTimestamp: &ts}).String()
+
+	for _, fileName := range []string{name + ".tar.gz", "/tmp/captures/" + name + ".pcap", name} {
+		cf, err := ParseCaptureFilename("retina-capture", fileName)
+		require.NoError(t, err, fileName)
+		assert.Equal(t, "aks-nodepool1-41844487-vmss000000", cf.NodeHostname)
+		assert.Equal(t, name, cf.String())
+	}
+
+	fqdn := (&CaptureFilename{CaptureName: "retina-capture", NodeHostname: "ip-10-0-0-1.ec2.internal", StartTimestamp: &ts}).String()
+	cf, err := ParseCaptureFilename("retina-capture", fqdn+".tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "ip-10-0-0-1.ec2.internal", cf.NodeHostname)
+
+	for _, fileName := range []string{
+		"other-aks-nodepool1-20240320013600UTC.tar.gz",
+		"retina-capture-20240320013600UTC.tar.gz",
+		"retina-capture-aks-nodepool1-2024.tar.gz",
+	} {
+		_, err := ParseCaptureFilename("retina-capture", fileName)
+		assert.Error(t, err, fileName)
+	}
+}
+
+func TestParseCaptureFileTimestamp(t *testing.T) {
+	for _, fileName := range []string{
+		"retina-capture-aks-nodepool1-20240320013600UTC.tar.gz",
+		"/mnt/retina/captures/retina-capture-ip-10-0-0-1.ec2.internal-20240320013600UTC.pcap",
+	} {
+		ts, err := ParseCaptureFileTimestamp(fileName)
+		require.NoError(t, err, fileName)
+		assert.Equal(t, time.Date(2024, 3, 20, 1, 36, 0, 0, time.UTC), ts.Time)
+	}
+
+	for _, fileName := range []string{
+		"retina-capture-aks-nodepool1-20240320013600UTC",
+		"retina-capture-aks-nodepool1.tar.gz",
+		"flows.json",
+	} {
+		_, err := ParseCaptureFileTimestamp(fileName)
+		assert.Error(t, err, fileName)
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+)
+
+// tcpdumpProtocols maps the protocols of the filter rules to tcpdump primitives.
+var tcpdumpProtocols = map[retinav1alpha1.CaptureFilterProtocol]string{
+	retinav1alpha1.CaptureFilterProtocolTCP:  "tcp",
+	retinav1alpha1.CaptureFilterProtocolUDP:  "udp",
+	retinav1alpha1.CaptureFilterProtocolICMP: "(icmp or icmp6)",
+	retinav1alpha1.CaptureFilterProtocolSCTP: "sctp",
+}
+
+// netshProtocols maps the protocols of the filter rules to the IP protocol numbers accepted by netsh.
+var netshProtocols = map[retinav1alpha1.CaptureFilterProtocol][]string{
+	retinav1alpha1.CaptureFilterProtocolTCP:  {"6"},
+	retinav1alpha1.CaptureFilterProtocolUDP:  {"17"},
+	retinav1alpha1.CaptureFilterProtocolICMP: {"1", "58"},
+	retinav1alpha1.CaptureFilterProtocolSCTP: {"132"},
+}
+
+var tcpdumpTCPFlags = map[retinav1alpha1.TCPFlag]string{
+	retinav1alpha1.TCPFlagSYN: "tcp-syn",
+	retinav1alpha1.TCPFlagACK: "tcp-ack",
+	retinav1alpha1.TCPFlagFIN: "tcp-fin",
+	retinav1alpha1.TCPFlagRST: "tcp-rst",
+	retinav1alpha1.TCPFlagPSH: "tcp-push",
+	retinav1alpha1.TCPFlagURG: "tcp-urg",
+}
+
+// validateFilterRules validates the filter rules as the Capture CRD does, for the Captures created by the CLI.
+func validateFilterRules(filters *retinav1alpha1.CaptureConfigurationFilters, provider retinav1alpha1.CaptureProvider) error {
+	if filters == nil {
+		return nil
+	}
+	for _, rule := range append(append([]retinav1alpha1.CaptureFilterRule{}, filters.IncludeRules...), filters.ExcludeRules...) {
+		if rule.Protocol == "" && len(rule.Ports) == 0 && len(rule.CIDRs) == 0 && len(rule.TCPFlags) == 0 && rule.Service == nil {
+			return fmt.Errorf("At least one field of the filter rule should be set")
+		}
+		if rule.Protocol != "" {
+			if _, ok := tcpdumpProtocols[rule.Protocol]; !ok {
+				return fmt.Errorf("Unsupported protocol %s in the filter rule", rule.Protocol)
+			}
+		}
+		if len(rule.Ports) != 0 && (rule.Protocol == "" || rule.Protocol == retinav1alpha1.CaptureFilterProtocolICMP) {
+			return fmt.Errorf("Ports of the filter rule require the TCP, UDP or SCTP protocol")
+		}
+		for _, port := range rule.Ports {
+			if port.Port < 1 || port.Port > 65535 {
+				return fmt.Errorf("Invalid port %d in the filter rule", port.Port)
+			}
+			if port.EndPort != nil && (*port.EndPort < port.Port || *port.EndPort > 65535) {
+				return fmt.Errorf("Invalid port range %d-%d in the filter rule", port.Port, *port.EndPort)
+			}
+		}
+		for _, cidr := range rule.CIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("Invalid CIDR %s in the filter rule: %w", cidr, err)
+			}
+		}
+		if len(rule.TCPFlags) != 0 {
+			if rule.Protocol != retinav1alpha1.CaptureFilterProtocolTCP {
+				return fmt.Errorf("TCP flags of the filter rule require the TCP protocol")
+			}
+			if provider == retinav1alpha1.CaptureProviderEBPF {
+				return fmt.Errorf("TCP flags of the filter rule are not supported by the eBPF capture provider")
+			}
+			for _, flag := range rule.TCPFlags {
+				if _, ok := tcpdumpTCPFlags[flag]; !ok {
+					return fmt.Errorf("Unsupported TCP flag %s in the filter rule", flag)
+				}
+			}
+		}
+		if rule.Service != nil {
+			if len(rule.CIDRs) != 0 {
+				return fmt.Errorf("CIDRs and service of the filter rule are mutually exclusive")
+			}
+			if _, err := metav1.LabelSelectorAsSelector(&rule.Service.Selector); err != nil {
+				return fmt.Errorf("Invalid service selector in the filter rule: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// serviceSelectorKey identifies the services selected by a filter rule.
+func serviceSelectorKey(service *retinav1alpha1.CaptureServiceSelector) string {
+	return service.Namespace + "/" + metav1.FormatLabelSelector(&service.Selector)
+}
+
+// resolveFilterServices resolves the IP addresses of the services selected by the filter rules of the Capture, which
+// are the cluster IPs and the endpoint addresses of the services.
+func (translator *CaptureToPodTranslator) resolveFilterServices(ctx context.Context, capture *retinav1alpha1.Capture) error {
+	translator.filterServiceIPs = map[string][]string{}
+	filters := capture.Spec.CaptureConfiguration.Filters
+	if filters == nil {
+		return nil
+	}
+
+	for _, rule := range append(append([]retinav1alpha1.CaptureFilterRule{}, filters.IncludeRules...), filters.ExcludeRules...) {
+		if rule.Service == nil {
+			continue
+		}
+		key := serviceSelectorKey(rule.Service)
+		if _, ok := translator.filterServiceIPs[key]; ok {
+			continue
+		}
+		namespace := rule.Service.Namespace
+		if namespace == "" {
+			namespace = capture.Namespace
+		}
+		ips, err := translator.serviceIPs(ctx, namespace, &rule.Service.Selector)
+		if err != nil {
+			return err
+		}
+		if len(ips) == 0 {
+			return fmt.Errorf("no IP addresses are found for the services selected by %q in namespace %s", metav1.FormatLabelSelector(&rule.Service.Selector), namespace)
+		}
+		translator.filterServiceIPs[key] = ips
+	}
+	return nil
+}
+
+func (translator *CaptureToPodTranslator) serviceIPs(ctx context.Context, namespace string, selector *metav1.LabelSelector) ([]string, error) {
+	serviceList, err := translator.kubeClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(selector),
+	})
+	if err != nil {
+		translator.l.Error("Failed to list Service", zap.String("serviceSelector", selector.String()), zap.Error(err))
+		return nil, err
+	}
+
+	ips := map[string]struct{}{}
+	for _, service := range serviceList.Items {
+		for _, clusterIP := range service.Spec.ClusterIPs {
+			if clusterIP != "" && clusterIP != corev1.ClusterIPNone {
+				ips[clusterIP] = struct{}{}
+			}
+		}
+
+		endpointSliceList, err := translator.kubeClient.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, service.Name),
+		})
+		if err != nil {
+			translator.l.Error("Failed to list EndpointSlice", zap.String("service", service.Name), zap.Error(err))
+			return nil, err
+		}
+		for _, endpointSlice := range endpointSliceList.Items {
+			if endpointSlice.AddressType == discoveryv1.AddressTypeFQDN {
+				continue
+			}
+			for _, endpoint := range endpointSlice.Endpoints {
+				for _, address := range endpoint.Addresses {
+					ips[address] = struct{}{}
+				}
+			}
+		}
+	}
+
+	sortedIPs := make([]string, 0, len(ips))
+	for ip := range ips {
+		sortedIPs = append(sortedIPs, ip)
+	}
+	sort.Strings(sortedIPs)
+	return sortedIPs, nil
+}
+
+// tcpdumpFiltersFromRules returns the tcpdump filters of the include and exclude filter rules, in parentheses.
+func (translator *CaptureToPodTranslator) tcpdumpFiltersFromRules(filters *retinav1alpha1.CaptureConfigurationFilters) ([]string, []string, error) {
+	if filters == nil {
+		return nil, nil, nil
+	}
+	rulesToFilters := func(rules []retinav1alpha1.CaptureFilterRule) ([]string, error) {
+		var filterArray []string
+		for i := range rules {
+			filter, err := translator.tcpdumpFilterFromRule(&rules[i])
+			if err != nil {
+				return nil, err
+			}
+			filterArray = append(filterArray, filter)
+		}
+		return filterArray, nil
+	}
+
+	includeFilters, err := rulesToFilters(filters.IncludeRules)
+	if err != nil {
+		return nil, nil, err
+	}
+	excludeFilters, err := rulesToFilters(filters.ExcludeRules)
+	if err != nil {
+		return nil, nil, err
+	}
+	return includeFilters, excludeFilters, nil
+}
+
+// tcpdumpFilterFromRule joins the primitives of the fields of the filter rule with logic AND, for example:
+// (tcp and (port 80 or portrange 8000-8080) and net 10.0.0.0/8 and tcp[tcpflags] & (tcp-syn) == (tcp-syn))
+func (translator *CaptureToPodTranslator) tcpdumpFilterFromRule(rule *retinav1alpha1.CaptureFilterRule) (string, error) {
+	primitives := []string{}
+	if rule.Protocol != "" {
+		protocol, ok := tcpdumpProtocols[rule.Protocol]
+		if !ok {
+			return "", fmt.Errorf("unsupported protocol %s in the filter rule", rule.Protocol)
+		}
+		primitives = append(primitives, protocol)
+	}
+
+	if len(rule.Ports) != 0 {
+		ports := make([]string, 0, len(rule.Ports))
+		for _, port := range rule.Ports {
+			if port.EndPort != nil && *port.EndPort != port.Port {
+				ports = append(ports, fmt.Sprintf("portrange %d-%d", port.Port, *port.EndPort))
+				continue
+			}
+			ports = append(ports, fmt.Sprintf("port %d", port.Port))
+		}
+		primitives = append(primitives, tcpdumpAlternatives(ports))
+	}
+
+	if len(rule.CIDRs) != 0 {
+		nets := make([]string, 0, len(rule.CIDRs))
+		for _, cidr := range rule.CIDRs {
+			nets = append(nets, fmt.Sprintf("net %s", cidr))
+		}
+		primitives = append(primitives, tcpdumpAlternatives(nets))
+	}
+
+	if rule.Service != nil {
+		ips, ok := translator.filterServiceIPs[serviceSelectorKey(rule.Service)]
+		if !ok {
+			return "", fmt.Errorf("the services selected by %q of the filter rule are not resolved", metav1.FormatLabelSelector(&rule.Service.Selector))
+		}
+		hosts := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			hosts = append(hosts, fmt.Sprintf("host %s", ip))
+		}
+		primitives = append(primitives, tcpdumpAlternatives(hosts))
+	}
+
+	if len(rule.TCPFlags) != 0 {
+		flags := make([]string, 0, len(rule.TCPFlags))
+		for _, flag := range rule.TCPFlags {
+			tcpFlag, ok := tcpdumpTCPFlags[flag]
+			if !ok {
+				return "", fmt.Errorf("unsupported TCP flag %s in the filter rule", flag)
+			}
+			flags = append(flags, tcpFlag)
+		}
+		mask := strings.Join(flags, "|")
+		primitives = append(primitives, fmt.Sprintf("tcp[tcpflags] & (%s) == (%s)", mask, mask))
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(primitives, " and ")), nil
+}
+
+// tcpdumpAlternatives joins the primitives with logic OR, in parentheses when there are more than one primitive.
+func tcpdumpAlternatives(primitives []string) string {
+	if len(primitives) == 1 {
+		return primitives[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(primitives, " or "))
+}
+
+// netshFilterFromRules returns the netsh filter of the filter rules. netsh joins its capture filters with logic AND,
+// so only one include rule matching the protocol and the services is supported.
+// Please check `netsh trace show capturefilterhelp` for the detail.
+func (translator *CaptureToPodTranslator) netshFilterFromRules(filters *retinav1alpha1.CaptureConfigurationFilters) (string, error) {
+	if filters == nil || (len(filters.IncludeRules) == 0 && len(filters.ExcludeRules) == 0) {
+		return "", nil
+	}
+	if len(filters.ExcludeRules) != 0 {
+		return "", fmt.Errorf("exclude filter rules are not supported by netsh")
+	}
+	if len(filters.IncludeRules) > 1 {
+		return "", fmt.Errorf("only one include filter rule is supported by netsh")
+	}
+
+	rule := filters.IncludeRules[0]
+	if len(rule.Ports) != 0 || len(rule.CIDRs) != 0 || len(rule.TCPFlags) != 0 {
+		return "", fmt.Errorf("ports, CIDRs and TCP flags of the filter rule are not supported by netsh")
+	}
+
+	filterGroups := []string{}
+	if rule.Protocol != "" {
+		protocols, ok := netshProtocols[rule.Protocol]
+		if !ok {
+			return "", fmt.Errorf("unsupported protocol %s in the filter rule", rule.Protocol)
+		}
+		filterGroups = append(filterGroups, fmt.Sprintf("Protocol=(%s)", strings.Join(protocols, ",")))
+	}
+	if rule.Service != nil {
+		ips, ok := translator.filterServiceIPs[serviceSelectorKey(rule.Service)]
+		if !ok {
+			return "", fmt.Errorf("the services selected by %q of the filter rule are not resolved", metav1.FormatLabelSelector(&rule.Service.Selector))
+		}
+		if ipFilter := getNetshFilterWithPodIPAddress(ips); len(ipFilter) != 0 {
+			filterGroups = append(filterGroups, ipFilter)
+		}
+	}
+	return strings.Join(filterGroups, " "), nil
+}
@@ -0,0 +1,302 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	pointerUtil "k8s.io/utils/pointer"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+)
+
+func webServiceSelector(namespace string) *retinav1alpha1.CaptureServiceSelector {
+	return &retinav1alpha1.CaptureServiceSelector{
+		Namespace: namespace,
+		Selector:  metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+	}
+}
+
+func TestValidateFilterRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     retinav1alpha1.CaptureFilterRule
+		provider retinav1alpha1.CaptureProvider
+		wantErr  bool
+	}{
+		{
+			name: "valid rule",
+			rule: retinav1alpha1.CaptureFilterRule{
+				Protocol: retinav1alpha1.CaptureFilterProtocolTCP,
+				Ports:    []retinav1alpha1.CapturePortRange{{Port: 80}, {Port: 8000, EndPort: pointerUtil.Int32(8080)}},
+				CIDRs:    []string{"10.0.0.0/8", "fd00::/64"},
+				TCPFlags: []retinav1alpha1.TCPFlag{retinav1alpha1.TCPFlagSYN},
+			},
+		},
+		{
+			name:    "empty rule",
+			rule:    retinav1alpha1.CaptureFilterRule{},
+			wantErr: true,
+		},
+		{
+			name:    "ports without protocol",
+			rule:    retinav1alpha1.CaptureFilterRule{Ports: []retinav1alpha1.CapturePortRange{{Port: 80}}},
+			wantErr: true,
+		},
+		{
+			name: "ports with ICMP",
+			rule: retinav1alpha1.CaptureFilterRule{
+				Protocol: retinav1alpha1.CaptureFilterProtocolICMP,
+				Ports:    []retinav1alpha1.CapturePortRange{{Port: 80}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid port range",
+			rule: retinav1alpha1.CaptureFilterRule{
+				Protocol: retinav1alpha1.CaptureFilterProtocolUDP,
+				Ports:    []retinav1alpha1.CapturePortRange{{Port: 80, EndPort: pointerUtil.Int32(79)}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid CIDR",
+			rule:    retinav1alpha1.CaptureFilterRule{CIDRs: []string{"10.0.0.1"}},
+			wantErr: true,
+		},
+		{
+			name: "TCP flags with UDP",
+			rule: retinav1alpha1.CaptureFilterRule{
+				Protocol: retinav1alpha1.CaptureFilterProtocolUDP,
+				TCPFlags: []retinav1alpha1.TCPFlag{retinav1alpha1.TCPFlagSYN},
+			},
+			wantErr: true,
+		},
+		{
+			name: "TCP flags with the eBPF provider",
+			rule: retinav1alpha1.CaptureFilterRule{
+				Protocol: retinav1alpha1.CaptureFilterProtocolTCP,
+				TCPFlags: []retinav1alpha1.TCPFlag{retinav1alpha1.TCPFlagSYN},
+			},
+			provider: retinav1alpha1.CaptureProviderEBPF,
+			wantErr:  true,
+		},
+		{
+			name:    "CIDRs with service",
+			rule:    retinav1alpha1.CaptureFilterRule{CIDRs: []string{"10.0.0.0/8"}, Service: webServiceSelector("")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := &retinav1alpha1.CaptureConfigurationFilters{ExcludeRules: []retinav1alpha1.CaptureFilterRule{tt.rule}}
+			err := validateFilterRules(filters, tt.provider)
+			assert.Equal(t, tt.wantErr, err != nil, "validateFilterRules() error %v", err)
+		})
+	}
+}
+
+func TestTcpdumpFilterFromRule(t *testing.T) {
+	translator := &CaptureToPodTranslator{
+		filterServiceIPs: map[string][]string{serviceSelectorKey(webServiceSelector("")): {"10.0.0.10", "10.244.0.5"}},
+	}
+
+	tests := []struct {
+		name string
+		rule retinav1alpha1.CaptureFilterRule
+		want string
+	}{
+		{
+			name: "protocol",
+			rule: retinav1alpha1.CaptureFilterRule{Protocol: retinav1alpha1.CaptureFilterProtocolICMP},
+			want: "((icmp or icmp6))",
+		},
+		{
+			name: "ports and port ranges",
+			rule: retinav1alpha1.CaptureFilterRule{
+				Protocol: retinav1alpha1.CaptureFilterProtocolSCTP,
+				Ports:    []retinav1alpha1.CapturePortRange{{Port: 80}, {Port: 8000, EndPort: pointerUtil.Int32(8080)}, {Port: 90, EndPort: pointerUtil.Int32(90)}},
+			},
+			want: "(sctp and (port 80 or portrange 8000-8080 or port 90))",
+		},
+		{
+			name: "CIDRs and TCP flags",
+			rule: retinav1alpha1.CaptureFilterRule{
+				Protocol: retinav1alpha1.CaptureFilterProtocolTCP,
+				CIDRs:    []string{"10.0.0.0/8", "fd00::/64"},
+				TCPFlags: []retinav1alpha1.TCPFlag{retinav1alpha1.TCPFlagSYN, retinav1alpha1.TCPFlagACK},
+			},
+			want: "(tcp and (net 10.0.0.0/8 or net fd00::/64) and tcp[tcpflags] & (tcp-syn|tcp-ack) == (tcp-syn|tcp-ack))",
+		},
+		{
+			name: "service",
+			rule: retinav1alpha1.CaptureFilterRule{Protocol: retinav1alpha1.CaptureFilterProtocolUDP, Service: webServiceSelector("")},
+			want: "(udp and (host 10.0.0.10 or host 10.244.0.5))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := translator.tcpdumpFilterFromRule(&tt.rule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter)
+		})
+	}
+}
+
+func TestNetshFilterFromRules(t *testing.T) {
+	translator := &CaptureToPodTranslator{
+		filterServiceIPs: map[string][]string{serviceSelectorKey(webServiceSelector("")): {"10.0.0.10", "fd00::10"}},
+	}
+
+	tests := []struct {
+		name    string
+		filters *retinav1alpha1.CaptureConfigurationFilters
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "no filter rules",
+			filters: &retinav1alpha1.CaptureConfigurationFilters{Include: []string{"10.0.0.1"}},
+		},
+		{
+			name: "protocol and service",
+			filters: &retinav1alpha1.CaptureConfigurationFilters{IncludeRules: []retinav1alpha1.CaptureFilterRule{{
+				Protocol: retinav1alpha1.CaptureFilterProtocolICMP,
+				Service:  webServiceSelector(""),
+			}}},
+			want: "Protocol=(1,58) IPv4.Address=(10.0.0.10) IPv6.Address=(fd00::10)",
+		},
+		{
+			name: "multiple include rules",
+			filters: &retinav1alpha1.CaptureConfigurationFilters{IncludeRules: []retinav1alpha1.CaptureFilterRule{
+				{Protocol: retinav1alpha1.CaptureFilterProtocolTCP},
+				{Protocol: retinav1alpha1.CaptureFilterProtocolUDP},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "exclude rules",
+			filters: &retinav1alpha1.CaptureConfigurationFilters{ExcludeRules: []retinav1alpha1.CaptureFilterRule{
+				{Protocol: retinav1alpha1.CaptureFilterProtocolTCP},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "ports",
+			filters: &retinav1alpha1.CaptureConfigurationFilters{IncludeRules: []retinav1alpha1.CaptureFilterRule{{
+				Protocol: retinav1alpha1.CaptureFilterProtocolTCP,
+				Ports:    []retinav1alpha1.CapturePortRange{{Port: 80}},
+			}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := translator.netshFilterFromRules(tt.filters)
+			assert.Equal(t, tt.wantErr, err != nil, "netshFilterFromRules() error %v", err)
+			assert.Equal(t, tt.want, filter)
+		})
+	}
+}
+
+func TestResolveFilterServices(t *testing.T) {
+	ctx, cancel := TestContext(t)
+	defer cancel()
+
+	k8sClient := fakeclientset.NewSimpleClientset(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "capture", Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.ServiceSpec{ClusterIPs: []string{"10.0.0.10", "fd00::10"}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-headless", Namespace: "capture", Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.ServiceSpec{ClusterIPs: []string{corev1.ClusterIPNone}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "other", Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.ServiceSpec{ClusterIPs: []string{"10.0.0.20"}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta:  metav1.ObjectMeta{Name: "web-abcde", Namespace: "capture", Labels: map[string]string{discoveryv1.LabelServiceName: "web"}},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints:   []discoveryv1.Endpoint{{Addresses: []string{"10.244.0.5"}}, {Addresses: []string{"10.244.1.5"}}},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta:  metav1.ObjectMeta{Name: "web-headless-abcde", Namespace: "capture", Labels: map[string]string{discoveryv1.LabelServiceName: "web-headless"}},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints:   []discoveryv1.Endpoint{{Addresses: []string{"10.244.2.5"}}},
+		},
+	)
+	translator := NewCaptureToPodTranslatorForTest(k8sClient)
+
+	capture := &retinav1alpha1.Capture{
+		ObjectMeta: metav1.ObjectMeta{Name: "capture", Namespace: "capture"},
+		Spec: retinav1alpha1.CaptureSpec{
+			CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+				Filters: &retinav1alpha1.CaptureConfigurationFilters{
+					IncludeRules: []retinav1alpha1.CaptureFilterRule{{Service: webServiceSelector("")}},
+					ExcludeRules: []retinav1alpha1.CaptureFilterRule{{Service: webServiceSelector("other")}},
+				},
+			},
+		},
+	}
+	require.NoError(t, translator.resolveFilterServices(ctx, capture))
+	assert.Equal(t, map[string][]string{
+		serviceSelectorKey(webServiceSelector("")):      {"10.0.0.10", "10.244.0.5", "10.244.1.5", "10.244.2.5", "fd00::10"},
+		serviceSelectorKey(webServiceSelector("other")): {"10.0.0.20"},
+	}, translator.filterServiceIPs)
+
+	// No services are selected.
+	capture.Spec.CaptureConfiguration.Filters.IncludeRules[0].Service.Namespace = "empty"
+	assert.Error(t, translator.resolveFilterServices(ctx, capture))
+}
+
+func TestRenderJobWithFilterRulesOnWindows(t *testing.T) {
+	translator := NewCaptureToPodTranslatorForTest(fakeclientset.NewSimpleClientset())
+	translator.filterServiceIPs = map[string][]string{serviceSelectorKey(webServiceSelector("")): {"10.0.0.10"}}
+	capture := retinav1alpha1.Capture{
+		ObjectMeta: metav1.ObjectMeta{Name: "capture", Namespace: "capture"},
+		Spec: retinav1alpha1.CaptureSpec{
+			CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+				CaptureOption: retinav1alpha1.CaptureOption{Duration: &metav1.Duration{}},
+				Filters: &retinav1alpha1.CaptureConfigurationFilters{
+					IncludeRules: []retinav1alpha1.CaptureFilterRule{{Protocol: retinav1alpha1.CaptureFilterProtocolTCP, Service: webServiceSelector("")}},
+				},
+			},
+			OutputConfiguration: retinav1alpha1.OutputConfiguration{HostPath: pointerUtil.String("/tmp/capture")},
+		},
+	}
+	require.NoError(t, translator.initJobTemplate(context.Background(), &capture))
+
+	env, err := translator.ObtainCaptureJobPodEnv(capture)
+	require.NoError(t, err)
+	jobs, err := translator.renderJob(&CaptureTargetsOnNode{"node1": {OS: "windows"}}, env)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Contains(t, jobs[0].Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: captureConstants.NetshFilterEnvKey, Value: "Protocol=(6) IPv4.Address=(10.0.0.10)"})
+
+	// netsh can't include the traffic of the Pods besides the traffic matching the filter rules.
+	_, err = translator.renderJob(&CaptureTargetsOnNode{"node1": {OS: "windows", PodIpAddresses: []string{"10.244.0.5"}}}, env)
+	assert.Error(t, err)
+
+	capture.Spec.CaptureConfiguration.Filters.IncludeRules[0].Ports = []retinav1alpha1.CapturePortRange{{Port: 80}}
+	env, err = translator.ObtainCaptureJobPodEnv(capture)
+	require.NoError(t, err)
+	_, err = translator.renderJob(&CaptureTargetsOnNode{"node1": {OS: "windows"}}, env)
+	assert.Error(t, err)
+	jobs, err = translator.renderJob(&CaptureTargetsOnNode{"node1": {OS: "linux"}}, env)
+	require.NoError(t, err)
+	assert.Contains(t, jobs[0].Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: captureConstants.TcpdumpFilterEnvKey, Value: "((tcp and port 80 and host 10.0.0.10))"})
+}
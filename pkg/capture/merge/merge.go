@@ -0,0 +1,405 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+// Package merge merges the packets of the capture tarballs of the nodes into a single
+// time-ordered pcapng trace, annotated with the nodes and pods of the packets.
+package merge
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"container/heap"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/pkg/errors"
+
+	"github.com/microsoft/retina/pkg/capture/file"
+)
+
+// ipResourcesFileName is the metadata file with the IP addresses of the node.
+const ipResourcesFileName = "ip-resources.txt"
+
+// Resolver names the owners of the IP addresses of the packets, such as "pod default/web-0" or "node aks-1".
+type Resolver interface {
+	Resolve(ip string) (string, bool)
+}
+
+// Names is a Resolver from IP addresses to names.
+type Names map[string]string
+
+func (n Names) Resolve(ip string) (string, bool) {
+	name, ok := n[ip]
+	return name, ok
+}
+
+// Options configures the merge.
+type Options struct {
+	// CaptureName is the name of the Capture of the tarballs, naming the nodes of the tarballs.
+	CaptureName string
+	// Resolver names the pods of the packets, optional.
+	// The IP addresses of the nodes are also named from the metadata of the tarballs.
+	Resolver Resolver
+}
+
+// Result summarizes the merged trace.
+type Result struct {
+	Nodes   []string
+	Packets int
+}
+
+// packetSource reads the packets of a pcap or pcapng file of a node.
+type packetSource struct {
+	node   string
+	file   *os.File
+	reader interface {
+		ReadPacketData() ([]byte, gopacket.CaptureInfo, error)
+	}
+	// interfaces maps the interfaces of the file to the interfaces of the merged trace.
+	interfaces map[int]int
+	linkTypes  map[int]layers.LinkType
+
+	// next is the next packet of the source.
+	data []byte
+	ci   gopacket.CaptureInfo
+}
+
+func (s *packetSource) advance() (bool, error) {
+	data, ci, err := s.reader.ReadPacketData()
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to read packet of node %s", s.node)
+	}
+	s.data, s.ci = data, ci
+	return true, nil
+}
+
+// sourceHeap orders the sources by the timestamp of their next packet.
+type sourceHeap []*packetSource
+
+func (h sourceHeap) Len() int           { return len(h) }
+func (h sourceHeap) Less(i, j int) bool { return h[i].ci.Timestamp.Before(h[j].ci.Timestamp) }
+func (h sourceHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *sourceHeap) Push(x any)        { *h = append(*h, x.(*packetSource)) }
+
+func (h *sourceHeap) Pop() any {
+	old := *h
+	s := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return s
+}
+
+// Merge unpacks the capture tarballs of the nodes and writes their packets to w as a pcapng trace ordered by time.
+// Each capture file is described as an interface named after its node, and each packet is commented
+// with its node and the names of its source and destination.
+func Merge(w io.Writer, tarballs []string, opts Options) (*Result, error) {
+	tmpDir, err := os.MkdirTemp("", "retina-capture-merge-")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	names := Names{}
+	var sources []*packetSource
+	defer func() {
+		for _, s := range sources {
+			s.file.Close()
+		}
+	}()
+
+	result := &Result{}
+	for i, tarball := range tarballs {
+		cf, err := file.ParseCaptureFilename(opts.CaptureName, tarball)
+		if err != nil {
+			return nil, err
+		}
+		dir := filepath.Join(tmpDir, fmt.Sprint(i))
+		pcaps, err := unpack(tarball, dir)
+		if err != nil {
+			return nil, err
+		}
+		nodeIPs, err := readNodeIPs(filepath.Join(dir, ipResourcesFileName))
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range nodeIPs {
+			names[ip] = "node " + cf.NodeHostname
+		}
+		for _, pcap := range pcaps {
+			s, err := openSource(cf.NodeHostname, pcap)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, s)
+		}
+		result.Nodes = append(result.Nodes, cf.NodeHostname)
+	}
+
+	ngw, err := newNgWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	h := &sourceHeap{}
+	for _, s := range sources {
+		if err := addInterfaces(ngw, s, opts.CaptureName); err != nil {
+			return nil, err
+		}
+		ok, err := s.advance()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heap.Push(h, s)
+		}
+	}
+
+	for h.Len() > 0 {
+		s := (*h)[0]
+		id, ok := s.interfaces[s.ci.InterfaceIndex]
+		if !ok {
+			return nil, errors.Errorf("packet of node %s on unknown interface %d", s.node, s.ci.InterfaceIndex)
+		}
+		comment := packetComment(s.node, s.data, s.linkTypes[s.ci.InterfaceIndex], opts.Resolver, names)
+		if err := ngw.writePacket(id, s.ci.Timestamp, s.data, s.ci.Length, comment); err != nil {
+			return nil, err
+		}
+		result.Packets++
+
+		ok, err := s.advance()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	if err := ngw.flush(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// unpack extracts the capture files and the IP metadata of the tarball into dir, and returns the capture files.
+func unpack(tarball, dir string) ([]string, error) {
+	f, err := os.Open(tarball)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open tarball")
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read tarball %s", tarball)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(dir, 0o750); err != nil { //nolint:gomnd // private temporary directory
+		return nil, errors.Wrap(err, "failed to create directory")
+	}
+
+	var pcaps []string
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read tarball %s", tarball)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		base := filepath.Base(hdr.Name)
+		ext := filepath.Ext(base)
+		isPcap := ext == ".pcap" || ext == ".pcapng"
+		if !isPcap && base != ipResourcesFileName {
+			continue
+		}
+		// The entries are written by their base name, inside dir.
+		dst := filepath.Join(dir, base)
+		if isPcap {
+			dst = filepath.Join(dir, fmt.Sprintf("%d-%s", len(pcaps), base))
+		}
+		if err := writeFile(dst, tr); err != nil {
+			return nil, err
+		}
+		if isPcap {
+			pcaps = append(pcaps, dst)
+		}
+	}
+	if len(pcaps) == 0 {
+		return nil, errors.Errorf("no capture file found in tarball %s", tarball)
+	}
+	sort.Strings(pcaps)
+	return pcaps, nil
+}
+
+func writeFile(dst string, r io.Reader) error {
+	f, err := os.Create(dst)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil { //nolint:gosec // the capture files are trusted
+		return errors.Wrap(err, "failed to extract file")
+	}
+	return nil
+}
+
+// readNodeIPs reads the addresses of the node from the output of "ip -j addr show" in the metadata, if any.
+func readNodeIPs(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open metadata")
+	}
+	defer f.Close()
+
+	var ips []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024) //nolint:gomnd // the JSON output is on a single line
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("[{")) {
+			continue
+		}
+		var links []struct {
+			IfName   string `json:"ifname"`
+			AddrInfo []struct {
+				Local string `json:"local"`
+			} `json:"addr_info"`
+		}
+		if err := json.Unmarshal(line, &links); err != nil {
+			// Other JSON outputs.
+			continue
+		}
+		for _, l := range links {
+			if l.IfName == "lo" {
+				continue
+			}
+			for _, a := range l.AddrInfo {
+				if a.Local != "" {
+					ips = append(ips, a.Local)
+				}
+			}
+		}
+	}
+	return ips, errors.Wrap(scanner.Err(), "failed to read metadata")
+}
+
+func openSource(node, path string) (*packetSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open capture file")
+	}
+	s := &packetSource{node: node, file: f, interfaces: map[int]int{}, linkTypes: map[int]layers.LinkType{}}
+
+	br := bufio.NewReader(f)
+	magic, err := br.Peek(4) //nolint:gomnd // size of the magic number
+	if err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "failed to read capture file of node %s", node)
+	}
+	if binary.LittleEndian.Uint32(magic) == blockTypeSectionHeader {
+		r, err := pcapgo.NewNgReader(br, pcapgo.DefaultNgReaderOptions)
+		if err != nil {
+			f.Close()
+			return nil, errors.Wrapf(err, "failed to read pcapng file of node %s", node)
+		}
+		s.reader = r
+		for i := 0; i < r.NInterfaces(); i++ {
+			intf, _ := r.Interface(i)
+			s.linkTypes[i] = intf.LinkType
+		}
+		return s, nil
+	}
+
+	r, err := pcapgo.NewReader(br)
+	if err != nil {
+		f.Close()
+		return nil, errors.Wrapf(err, "failed to read pcap file of node %s", node)
+	}
+	s.reader = r
+	s.linkTypes[0] = r.LinkType()
+	return s, nil
+}
+
+// addInterfaces describes the interfaces of the source in the merged trace.
+func addInterfaces(ngw *ngWriter, s *packetSource, captureName string) error {
+	snapLen := func(int) uint32 { return 0 }
+	var intfNames func(int) string
+	if r, ok := s.reader.(*pcapgo.NgReader); ok {
+		snapLen = func(i int) uint32 {
+			intf, _ := r.Interface(i)
+			return intf.SnapLength
+		}
+		intfNames = func(i int) string {
+			intf, _ := r.Interface(i)
+			return intf.Name
+		}
+	} else if r, ok := s.reader.(*pcapgo.Reader); ok {
+		snapLen = func(int) uint32 { return r.Snaplen() }
+	}
+
+	indexes := make([]int, 0, len(s.linkTypes))
+	for i := range s.linkTypes {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		description := fmt.Sprintf("Retina capture %s on node %s", captureName, s.node)
+		if intfNames != nil && intfNames(i) != "" {
+			description += ", interface " + intfNames(i)
+		}
+		id, err := ngw.addInterface(s.linkTypes[i], snapLen(i), s.node, description)
+		if err != nil {
+			return err
+		}
+		s.interfaces[i] = id
+	}
+	return nil
+}
+
+// packetComment names the node capturing the packet, and the source and destination of the packet
+// with the resolver, then with the node addresses of the metadata.
+func packetComment(node string, data []byte, linkType layers.LinkType, resolver Resolver, names Names) string {
+	parts := []string{"node: " + node}
+	packet := gopacket.NewPacket(data, linkType, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	if nl := packet.NetworkLayer(); nl != nil {
+		src, dst := nl.NetworkFlow().Endpoints()
+		if name, ok := resolve(src.String(), resolver, names); ok {
+			parts = append(parts, "source: "+name)
+		}
+		if name, ok := resolve(dst.String(), resolver, names); ok {
+			parts = append(parts, "destination: "+name)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func resolve(ip string, resolver Resolver, names Names) (string, bool) {
+	if resolver != nil {
+		if name, ok := resolver.Resolve(ip); ok {
+			return name, true
+		}
+	}
+	return names.Resolve(ip)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package merge
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ipAddrShow = `Summary:
+
+/bin/ip -d -j addr show(IP address configuration)
+
+Execute:
+
+[{"ifindex":1,"ifname":"lo","addr_info":[{"family":"inet","local":"127.0.0.1","prefixlen":8}]},{"ifindex":2,"ifname":"eth0","addr_info":[{"family":"inet","local":"10.224.0.4","prefixlen":16}]}]
+[{"dst":"10.224.0.1","dev":"eth0","lladdr":"12:34:56:78:9a:bc","state":["REACHABLE"]}]
+`
+
+func udpPacket(t *testing.T, src, dst string) []byte {
+	t.Helper()
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+		DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.ParseIP(src).To4(), DstIP: net.ParseIP(dst).To4()}
+	udp := &layers.UDP{SrcPort: 40000, DstPort: 53}
+	require.NoError(t, udp.SetNetworkLayerForChecksum(ip))
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, eth, ip, udp, gopacket.Payload("dns")))
+	return buf.Bytes()
+}
+
+type testPacket struct {
+	ts       time.Time
+	src, dst string
+}
+
+// writeTarball writes the capture tarball of the node with a pcap of the packets, and the metadata if not empty.
+func writeTarball(t *testing.T, dir, name string, packets []testPacket, ipResources string) string {
+	t.Helper()
+	var pcap bytes.Buffer
+	w := pcapgo.NewWriter(&pcap)
+	require.NoError(t, w.WriteFileHeader(65535, layers.LinkTypeEthernet))
+	for _, p := range packets {
+		data := udpPacket(t, p.src, p.dst)
+		require.NoError(t, w.WritePacket(gopacket.CaptureInfo{Timestamp: p.ts, CaptureLength: len(data), Length: len(data)}, data))
+	}
+
+	path := filepath.Join(dir, name+".tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	files := map[string][]byte{name + "/" + name + ".pcap": pcap.Bytes(), name + "/tcpdump.log": []byte("log")}
+	if ipResources != "" {
+		files[name+"/"+ipResourcesFileName] = []byte(ipResources)
+	}
+	for n, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: n, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return path
+}
+
+func TestMerge(t *testing.T) {
+	dir := t.TempDir()
+	t0 := time.Date(2024, 3, 20, 1, 36, 0, 0, time.UTC)
+	node1 := writeTarball(t, dir, "cap-aks-node-1-20240320013600UTC", []testPacket{
+		{ts: t0, src: "10.0.0.1", dst: "10.0.1.1"},
+		{ts: t0.Add(2 * time.Millisecond), src: "10.224.0.4", dst: "10.0.0.1"},
+	}, ipAddrShow)
+	node2 := writeTarball(t, dir, "cap-aks-node-2-20240320013600UTC", []testPacket{
+		{ts: t0.Add(time.Millisecond), src: "10.0.0.1", dst: "10.0.1.1"},
+		{ts: t0.Add(3*time.Millisecond + 500*time.Microsecond), src: "10.0.1.1", dst: "10.0.0.1"},
+	}, "")
+
+	var out bytes.Buffer
+	result, err := Merge(&out, []string{node1, node2}, Options{
+		CaptureName: "cap",
+		Resolver:    Names{"10.0.0.1": "pod default/client", "10.0.1.1": "pod kube-system/coredns-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aks-node-1", "aks-node-2"}, result.Nodes)
+	assert.Equal(t, 4, result.Packets)
+
+	r, err := pcapgo.NewNgReader(bytes.NewReader(out.Bytes()), pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	wantTimes := []time.Time{t0, t0.Add(time.Millisecond), t0.Add(2 * time.Millisecond), t0.Add(3*time.Millisecond + 500*time.Microsecond)}
+	wantInterfaces := []int{0, 1, 0, 1}
+	for i := range wantTimes {
+		_, ci, err := r.ReadPacketData()
+		require.NoError(t, err)
+		assert.True(t, wantTimes[i].Equal(ci.Timestamp), "packet %d at %s", i, ci.Timestamp)
+		assert.Equal(t, wantInterfaces[i], ci.InterfaceIndex)
+	}
+	require.Equal(t, 2, r.NInterfaces())
+	intf, err := r.Interface(1)
+	require.NoError(t, err)
+	assert.Equal(t, "aks-node-2", intf.Name)
+	assert.Equal(t, "Retina capture cap on node aks-node-2", intf.Description)
+	assert.Equal(t, layers.LinkTypeEthernet, intf.LinkType)
+
+	// The node addresses are named from the metadata.
+	assert.Contains(t, out.String(), "node: aks-node-1, source: node aks-node-1, destination: pod default/client")
+	assert.Contains(t, out.String(), "node: aks-node-2, source: pod default/client, destination: pod kube-system/coredns-1")
+}
+
+func TestMergeInvalidTarballs(t *testing.T) {
+	dir := t.TempDir()
+	node1 := writeTarball(t, dir, "cap-aks-node-1-20240320013600UTC", nil, "")
+
+	_, err := Merge(&bytes.Buffer{}, []string{node1}, Options{CaptureName: "other"})
+	require.Error(t, err)
+
+	notGzip := filepath.Join(dir, "cap-aks-node-2-20240320013600UTC.tar.gz")
+	require.NoError(t, os.WriteFile(notGzip, []byte("not a tarball"), 0o600))
+	_, err = Merge(&bytes.Buffer{}, []string{notGzip}, Options{CaptureName: "cap"})
+	require.Error(t, err)
+}
+
+func TestReadNodeIPs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ipResourcesFileName)
+	require.NoError(t, os.WriteFile(path, []byte(ipAddrShow), 0o600))
+	ips, err := readNodeIPs(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.224.0.4"}, ips)
+
+	ips, err = readNodeIPs(filepath.Join(t.TempDir(), ipResourcesFileName))
+	require.NoError(t, err)
+	assert.Empty(t, ips)
+}
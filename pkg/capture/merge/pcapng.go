@@ -0,0 +1,150 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package merge
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/google/gopacket/layers"
+	"github.com/pkg/errors"
+)
+
+// pcapng block types and options, see https://www.ietf.org/archive/id/draft-ietf-opsawg-pcapng-01.html
+const (
+	blockTypeSectionHeader        uint32 = 0x0A0D0D0A
+	blockTypeInterfaceDescription uint32 = 0x00000001
+	blockTypeEnhancedPacket       uint32 = 0x00000006
+
+	byteOrderMagic uint32 = 0x1A2B3C4D
+
+	optionEndOfOpt      uint16 = 0
+	optionComment       uint16 = 1
+	optionShbUserAppl   uint16 = 4
+	optionIfName        uint16 = 2
+	optionIfDescription uint16 = 3
+	optionIfTsresol     uint16 = 9
+
+	// tsresolNanoseconds is the if_tsresol of the interfaces, timestamps are in nanoseconds.
+	tsresolNanoseconds byte = 9
+
+	userApplication = "retina"
+)
+
+type option struct {
+	code  uint16
+	value []byte
+}
+
+// ngWriter writes a pcapng section with the interfaces added to it, and packets with comments,
+// which gopacket's pcapgo.NgWriter does not support.
+type ngWriter struct {
+	w          *bufio.Writer
+	interfaces int
+}
+
+func newNgWriter(w io.Writer) (*ngWriter, error) {
+	ngw := &ngWriter{w: bufio.NewWriter(w)}
+
+	body := make([]byte, 16) //nolint:gomnd // byte order magic, version and section length
+	binary.LittleEndian.PutUint32(body[0:4], byteOrderMagic)
+	binary.LittleEndian.PutUint16(body[4:6], 1)
+	binary.LittleEndian.PutUint16(body[6:8], 0)
+	// The section length is not specified.
+	binary.LittleEndian.PutUint64(body[8:16], ^uint64(0))
+	body = appendOptions(body, []option{{code: optionShbUserAppl, value: []byte(userApplication)}})
+
+	if err := ngw.writeBlock(blockTypeSectionHeader, body); err != nil {
+		return nil, err
+	}
+	return ngw, nil
+}
+
+// addInterface writes the description of an interface, and returns its ID for the packets.
+func (ngw *ngWriter) addInterface(linkType layers.LinkType, snapLen uint32, name, description string) (int, error) {
+	body := make([]byte, 8) //nolint:gomnd // link type, reserved and snap length
+	binary.LittleEndian.PutUint16(body[0:2], uint16(linkType))
+	binary.LittleEndian.PutUint32(body[4:8], snapLen)
+	opts := []option{{code: optionIfTsresol, value: []byte{tsresolNanoseconds}}}
+	if name != "" {
+		opts = append(opts, option{code: optionIfName, value: []byte(name)})
+	}
+	if description != "" {
+		opts = append(opts, option{code: optionIfDescription, value: []byte(description)})
+	}
+	body = appendOptions(body, opts)
+
+	if err := ngw.writeBlock(blockTypeInterfaceDescription, body); err != nil {
+		return 0, err
+	}
+	id := ngw.interfaces
+	ngw.interfaces++
+	return id, nil
+}
+
+// writePacket writes the packet captured on the interface, with the comment if it is not empty.
+func (ngw *ngWriter) writePacket(interfaceID int, ts time.Time, data []byte, length int, comment string) error {
+	if interfaceID >= ngw.interfaces {
+		return errors.Errorf("unknown interface %d", interfaceID)
+	}
+	body := make([]byte, 20, 20+len(data)+len(comment)+16) //nolint:gomnd // interface, timestamp and lengths
+	nanos := uint64(ts.UnixNano())
+	binary.LittleEndian.PutUint32(body[0:4], uint32(interfaceID))
+	binary.LittleEndian.PutUint32(body[4:8], uint32(nanos>>32))
+	binary.LittleEndian.PutUint32(body[8:12], uint32(nanos))
+	binary.LittleEndian.PutUint32(body[12:16], uint32(len(data)))
+	binary.LittleEndian.PutUint32(body[16:20], uint32(length))
+	body = append(body, data...)
+	body = pad(body)
+	if comment != "" {
+		body = appendOptions(body, []option{{code: optionComment, value: []byte(comment)}})
+	}
+	return ngw.writeBlock(blockTypeEnhancedPacket, body)
+}
+
+func (ngw *ngWriter) flush() error {
+	return errors.Wrap(ngw.w.Flush(), "failed to flush pcapng")
+}
+
+// writeBlock writes the block with its body, padded to 32 bits.
+func (ngw *ngWriter) writeBlock(blockType uint32, body []byte) error {
+	var header [8]byte
+	length := uint32(12 + len(body)) //nolint:gomnd // block type and the two block lengths
+	binary.LittleEndian.PutUint32(header[0:4], blockType)
+	binary.LittleEndian.PutUint32(header[4:8], length)
+	if _, err := ngw.w.Write(header[:]); err != nil {
+		return errors.Wrap(err, "failed to write pcapng block")
+	}
+	if _, err := ngw.w.Write(body); err != nil {
+		return errors.Wrap(err, "failed to write pcapng block")
+	}
+	if _, err := ngw.w.Write(header[4:8]); err != nil {
+		return errors.Wrap(err, "failed to write pcapng block")
+	}
+	return nil
+}
+
+// appendOptions appends the options and the end of options to the body.
+func appendOptions(body []byte, opts []option) []byte {
+	var header [4]byte
+	for _, o := range opts {
+		binary.LittleEndian.PutUint16(header[0:2], o.code)
+		binary.LittleEndian.PutUint16(header[2:4], uint16(len(o.value)))
+		body = append(body, header[:]...)
+		body = append(body, o.value...)
+		body = pad(body)
+	}
+	binary.LittleEndian.PutUint16(header[0:2], optionEndOfOpt)
+	binary.LittleEndian.PutUint16(header[2:4], 0)
+	return append(body, header[:]...)
+}
+
+func pad(b []byte) []byte {
+	for len(b)%4 != 0 {
+		b = append(b, 0)
+	}
+	return b
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 	"go.uber.org/zap"
 
 	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
@@ -42,30 +43,30 @@ func (bu *BlobUpload) Enabled() bool {
 	return true
 }
 
-func (bu *BlobUpload) Output(ctx context.Context, srcFilePath string) error {
+func (bu *BlobUpload) Output(ctx context.Context, srcFilePath string) (string, error) {
 	bu.l.Info("Upload capture file to blob.", zap.String("location", bu.Name()))
 	blobURL, err := readBlobSASURL()
 	if err != nil {
 		bu.l.Error("Failed to read blob url", zap.Error(err))
-		return err
+		return "", err
 	}
 
 	if err = validateBlobSASURL(blobURL); err != nil {
 		bu.l.Error("Failed to validate blob url", zap.Error(err))
-		return err
+		return "", err
 	}
 
 	// TODO: add retry policy
 	azClient, err := azblob.NewClientWithNoCredential(blobURL, nil)
 	if err != nil {
 		bu.l.Error("Failed to create blob client", zap.String("location", bu.Name()), zap.Error(err))
-		return err
+		return "", err
 	}
 
 	blobFile, err := os.Open(srcFilePath)
 	if err != nil {
 		bu.l.Error("Failed to open capture file", zap.Error(err))
-		return err
+		return "", err
 	}
 	defer blobFile.Close()
 
@@ -79,9 +80,62 @@ func (bu *BlobUpload) Output(ctx context.Context, srcFilePath string) error {
 		&azblob.UploadFileOptions{})
 	if err != nil {
 		bu.l.Error("Failed to upload file to storage account", zap.String("location", bu.Name()), zap.Error(err))
-		return err
+		return "", err
 	}
 	bu.l.Info("Done for uploading capture file to storage account", zap.String("location", bu.Name()))
+	return blobURLWithoutSAS(blobURL, blobName), nil
+}
+
+// blobURLWithoutSAS returns the URL of the blob in the container of the SAS URL, without the SAS token.
+func blobURLWithoutSAS(containerSASURL, blobName string) string {
+	u, err := url.Parse(containerSASURL)
+	if err != nil {
+		return blobName
+	}
+	u.RawQuery = ""
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + blobName
+	return u.String()
+}
+
+// ListBlobs lists the blobs of the container of the SAS URL whose names start with the prefix.
+func ListBlobs(ctx context.Context, containerSASURL, prefix string) ([]StoredFile, error) {
+	containerClient, err := container.NewClientWithNoCredential(trimBlobSASURL(containerSASURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob container client: %w", err)
+	}
+
+	files := []StoredFile{}
+	pager := containerClient.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list blobs: %w", err)
+		}
+		for _, item := range page.Segment.BlobItems {
+			file := StoredFile{Key: *item.Name}
+			if item.Properties != nil {
+				if item.Properties.ContentLength != nil {
+					file.Size = *item.Properties.ContentLength
+				}
+				if item.Properties.LastModified != nil {
+					file.LastModified = *item.Properties.LastModified
+				}
+			}
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// DeleteBlob deletes the blob from the container of the SAS URL.
+func DeleteBlob(ctx context.Context, containerSASURL, name string) error {
+	containerClient, err := container.NewClientWithNoCredential(trimBlobSASURL(containerSASURL), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create blob container client: %w", err)
+	}
+	if _, err := containerClient.NewBlobClient(name).Delete(ctx, nil); err != nil {
+		return fmt.Errorf("failed to delete blob %s: %w", name, err)
+	}
 	return nil
 }
 
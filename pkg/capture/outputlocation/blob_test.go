@@ -94,3 +94,33 @@ func TestValidateBlobSASURL(t *testing.T) {
 		})
 	}
 }
+
+func TestBlobURLWithoutSAS(t *testing.T) {
+	tests := []struct {
+		name            string
+		containerSASURL string
+		blobName        string
+		expectedURL     string
+	}{
+		{
+			name:            "container SAS URL",
+			containerSASURL: "https://retina.blob.core.windows.net/container?sv=2022-11-02&sig=secret",
+			blobName:        "capture.tar.gz",
+			expectedURL:     "https://retina.blob.core.windows.net/container/capture.tar.gz",
+		},
+		{
+			name:            "container SAS URL with a trailing slash",
+			containerSASURL: "https://retina.blob.core.windows.net/container/?sig=secret",
+			blobName:        "capture.tar.gz",
+			expectedURL:     "https://retina.blob.core.windows.net/container/capture.tar.gz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actualURL := blobURLWithoutSAS(tt.containerSASURL, tt.blobName); actualURL != tt.expectedURL {
+				t.Errorf("Expected blob URL %s, but got %s", tt.expectedURL, actualURL)
+			}
+		})
+	}
+}
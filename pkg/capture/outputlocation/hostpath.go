@@ -38,7 +38,7 @@ func (hp *HostPath) Enabled() bool {
 	return true
 }
 
-func (hp *HostPath) Output(_ context.Context, srcFilePath string) error {
+func (hp *HostPath) Output(_ context.Context, srcFilePath string) (string, error) {
 	hostPath := os.Getenv(string(captureConstants.CaptureOutputLocationEnvKeyHostPath))
 	hp.l.Info("Copy file",
 		zap.String("location", hp.Name()),
@@ -48,7 +48,7 @@ func (hp *HostPath) Output(_ context.Context, srcFilePath string) error {
 
 	srcFile, err := os.Open(srcFilePath)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer srcFile.Close()
 
@@ -56,10 +56,12 @@ func (hp *HostPath) Output(_ context.Context, srcFilePath string) error {
 	fileHostPath := filepath.Join(hostPath, fileName)
 	destFile, err := os.Create(fileHostPath)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer destFile.Close()
 
-	_, err = io.Copy(destFile, srcFile)
-	return err
+	if _, err = io.Copy(destFile, srcFile); err != nil {
+		return "", err
+	}
+	return fileHostPath, nil
 }
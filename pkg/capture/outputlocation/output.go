@@ -3,13 +3,25 @@
 
 package outputlocation
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Location interface {
 	// Name returns the name of the output location.
 	Name() string
 	// Enabled checks whether a output location is enabled.
 	Enabled() bool
-	// Output outputs source file to the location specified by the users.
-	Output(ctx context.Context, srcFilePath string) error
+	// Output outputs source file to the location specified by the users, and returns the path or URL of the stored
+	// file.
+	Output(ctx context.Context, srcFilePath string) (string, error)
+}
+
+// StoredFile is a capture file stored in an object storage output location.
+type StoredFile struct {
+	// Key is the key of the object, or the name of the blob.
+	Key          string
+	Size         int64
+	LastModified time.Time
 }
@@ -6,9 +6,11 @@ package outputlocation
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
 	"github.com/microsoft/retina/pkg/log"
@@ -126,8 +128,21 @@ func TestOutput(t *testing.T) {
 				}
 			}()
 
-			err := tt.outputLocation.Output(ctx, tt.srcPath)
+			_, err := tt.outputLocation.Output(ctx, tt.srcPath)
 			assert.Equal(t, tt.hasError, err != nil, "Output check failed on source file open")
 		})
 	}
 }
+
+func TestHostPathOutput(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	srcDir, hostPath := t.TempDir(), t.TempDir()
+	srcPath := filepath.Join(srcDir, "capture.tar.gz")
+	require.NoError(t, os.WriteFile(srcPath, []byte("capture"), 0o600))
+	t.Setenv(string(captureConstants.CaptureOutputLocationEnvKeyHostPath), hostPath)
+
+	uri, err := NewHostPath(log.Logger().Named("hostpath")).Output(context.Background(), srcPath)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(hostPath, "capture.tar.gz"), uri)
+	assert.FileExists(t, uri)
+}
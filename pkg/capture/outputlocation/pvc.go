@@ -38,7 +38,7 @@ func (pvc *PersistentVolumeClaim) Enabled() bool {
 	return true
 }
 
-func (pvc *PersistentVolumeClaim) Output(_ context.Context, srcFilePath string) error {
+func (pvc *PersistentVolumeClaim) Output(_ context.Context, srcFilePath string) (string, error) {
 	dstDir := captureConstants.PersistentVolumeClaimVolumeMountPathLinux
 	pvc.l.Info("Copy file",
 		zap.String("location", pvc.Name()),
@@ -47,7 +47,7 @@ func (pvc *PersistentVolumeClaim) Output(_ context.Context, srcFilePath string)
 	)
 	srcFile, err := os.Open(srcFilePath)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer srcFile.Close()
 
@@ -55,10 +55,14 @@ func (pvc *PersistentVolumeClaim) Output(_ context.Context, srcFilePath string)
 	fileHostPath := filepath.Join(dstDir, fileName)
 	destFile, err := os.Create(fileHostPath)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer destFile.Close()
 
-	_, err = io.Copy(destFile, srcFile)
-	return err
+	if _, err = io.Copy(destFile, srcFile); err != nil {
+		return "", err
+	}
+	// The file is identified by the claim and its path in the volume, as the mount path is only known to the pod.
+	pvcName := os.Getenv(string(captureConstants.CaptureOutputLocationEnvKeyPersistentVolumeClaim))
+	return pvcName + "/" + fileName, nil
 }
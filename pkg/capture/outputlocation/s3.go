@@ -77,7 +77,7 @@ func (su *S3Upload) Enabled() bool {
 	return true
 }
 
-func (su *S3Upload) Output(ctx context.Context, srcFilePath string) error {
+func (su *S3Upload) Output(ctx context.Context, srcFilePath string) (string, error) {
 	objectKey := path.Join(su.path, srcFilePath)
 
 	su.l.Info("Upload capture file to s3",
@@ -90,14 +90,14 @@ func (su *S3Upload) Output(ctx context.Context, srcFilePath string) error {
 	s3Client, err := su.getClient(ctx)
 	if err != nil {
 		su.l.Error("Failed to get AWS client", zap.Error(err))
-		return err
+		return "", err
 	}
 
 	s3File, err := os.Open(srcFilePath)
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to open src file %s: %w", srcFilePath, err)
 		su.l.Error("Failed to open capture file", zap.Error(wrappedErr))
-		return wrappedErr
+		return "", wrappedErr
 	}
 	defer s3File.Close()
 
@@ -113,19 +113,26 @@ func (su *S3Upload) Output(ctx context.Context, srcFilePath string) error {
 			zap.String("bucketName", su.bucket),
 			zap.String("objectKey", objectKey),
 			zap.Error(wrappedErr))
+		return "", wrappedErr
 	}
-	return nil
+	return fmt.Sprintf("s3://%s/%s", su.bucket, objectKey), nil
 }
 
 func (su *S3Upload) getClient(ctx context.Context) (*s3.Client, error) {
+	return NewS3Client(ctx, su.endpoint, su.region, su.accessKeyID, su.secretAccessKey)
+}
+
+// NewS3Client returns a client of the S3 compatible storage service at the endpoint, or of AWS S3 in the region
+// when the endpoint is empty. The default AWS credentials are used when the access key id is empty.
+func NewS3Client(ctx context.Context, endpoint, region, accessKeyID, secretAccessKey string) (*s3.Client, error) {
 	var opts []func(options *config.LoadOptions) error
 
-	if su.endpoint != "" {
+	if endpoint != "" {
 		opts = append(opts,
 			config.WithEndpointResolverWithOptions(
 				aws.EndpointResolverWithOptionsFunc(func(service, region string, options ...interface{}) (aws.Endpoint, error) {
 					return aws.Endpoint{
-						URL:               su.endpoint,
+						URL:               endpoint,
 						HostnameImmutable: true,
 					}, nil
 				}),
@@ -133,16 +140,16 @@ func (su *S3Upload) getClient(ctx context.Context) (*s3.Client, error) {
 		)
 	}
 
-	if su.region != "" {
-		opts = append(opts, config.WithRegion(su.region))
+	if region != "" {
+		opts = append(opts, config.WithRegion(region))
 	} else {
 		opts = append(opts, config.WithRegion("auto"))
 	}
 
-	if su.accessKeyID != "" {
+	if accessKeyID != "" {
 		opts = append(opts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			su.accessKeyID,
-			su.secretAccessKey,
+			accessKeyID,
+			secretAccessKey,
 			"",
 		)))
 	}
@@ -157,6 +164,37 @@ func (su *S3Upload) getClient(ctx context.Context) (*s3.Client, error) {
 	return s3.NewFromConfig(cfg), nil
 }
 
+// ListS3Files lists the objects of the bucket whose keys start with the prefix.
+func ListS3Files(ctx context.Context, s3Client *s3.Client, bucket, prefix string) ([]StoredFile, error) {
+	files := []StoredFile{}
+	paginator := s3.NewListObjectsV2Paginator(s3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list S3 objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			files = append(files, StoredFile{
+				Key:          aws.ToString(obj.Key),
+				Size:         aws.ToInt64(obj.Size),
+				LastModified: aws.ToTime(obj.LastModified),
+			})
+		}
+	}
+	return files, nil
+}
+
+// DeleteS3File deletes the object of the bucket.
+func DeleteS3File(ctx context.Context, s3Client *s3.Client, bucket, key string) error {
+	if _, err := s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)}); err != nil {
+		return fmt.Errorf("failed to delete S3 object %s: %w", key, err)
+	}
+	return nil
+}
+
 func readAccessKeyID() (string, error) {
 	secretPath := filepath.Join(captureConstants.CaptureOutputLocationS3UploadSecretPath, captureConstants.CaptureOutputLocationS3UploadAccessKeyID)
 	if runtime.GOOS == "windows" {
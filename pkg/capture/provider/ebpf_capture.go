@@ -0,0 +1,43 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"errors"
+
+	"github.com/microsoft/retina/pkg/capture/file"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+var errEBPFRingBufferNotSupported = errors.New("ring buffer capture is not supported by the eBPF capture provider")
+
+// EBPFCaptureProvider captures network packets with eBPF programs attached to the network interfaces of the node, and
+// writes them to a pcapng file without running tcpdump. The network metadata are collected as the default provider
+// does.
+type EBPFCaptureProvider struct {
+	NetworkCaptureProviderInterface
+	TmpCaptureDir string
+	Filename      file.CaptureFilename
+
+	l *log.ZapLogger
+}
+
+var _ NetworkCaptureProviderInterface = &EBPFCaptureProvider{}
+
+func NewEBPFCaptureProvider(logger *log.ZapLogger) NetworkCaptureProviderInterface {
+	return &EBPFCaptureProvider{
+		NetworkCaptureProviderInterface: NewNetworkCaptureProvider(logger),
+		l:                               logger,
+	}
+}
+
+func (ecp *EBPFCaptureProvider) Setup(filename file.CaptureFilename) (string, error) {
+	captureFolderDir, err := ecp.NetworkCaptureProviderInterface.Setup(filename)
+	if err != nil {
+		return "", err
+	}
+	ecp.TmpCaptureDir = captureFolderDir
+	ecp.Filename = filename
+	return captureFolderDir, nil
+}
@@ -0,0 +1,247 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/cilium/ebpf/asm"
+	"golang.org/x/sys/unix"
+)
+
+// Stack of the capture program, below the metadata of the samples. The headers are loaded with bpf_skb_load_bytes
+// and the scratch area is zeroed first, so that the filter only reads initialized stack.
+const (
+	// ebpfFilterHasPortsOffset is set to 1 when the ports were loaded.
+	ebpfFilterHasPortsOffset = -72
+	// ebpfFilterPortsOffset holds the source and the destination ports, in network byte order.
+	ebpfFilterPortsOffset = -68
+	// ebpfFilterIPHeaderOffset holds the fixed part of the IPv4 or the IPv6 header.
+	ebpfFilterIPHeaderOffset = -64
+
+	ipv4HeaderLen = 20
+	ipv6HeaderLen = 40
+
+	// The registers holding the IP version and the protocol of the packet, 0 if unknown.
+	ebpfFilterFamilyReg   = asm.R7
+	ebpfFilterProtocolReg = asm.R8
+
+	ebpfFilterPassLabel    = "pass"
+	ebpfFilterCaptureLabel = "capture"
+)
+
+// ebpfFilterAddrOffsets are the stack offsets of the source and the destination addresses in the IP headers.
+var ebpfFilterAddrOffsets = map[bool][2]int16{
+	false: {ebpfFilterIPHeaderOffset + 12, ebpfFilterIPHeaderOffset + 16}, //nolint:gomnd // IPv4 header
+	true:  {ebpfFilterIPHeaderOffset + 8, ebpfFilterIPHeaderOffset + 24},  //nolint:gomnd // IPv6 header
+}
+
+// ebpfFilterInstructions returns the instructions decoding the IP header and the ports of the packet, at l3Offset of
+// the packet data, and jumping to ebpfFilterCaptureLabel if the packet matches the filter, or to ebpfFilterPassLabel
+// otherwise. The packets are decoded as decodePacketInfo does, and the checks are the ones of the filter
+// expressions.
+func ebpfFilterInstructions(filter filterExpr, l3Offset int32) (asm.Instructions, error) {
+	g := &ebpfFilterGenerator{}
+	for off := int16(ebpfFilterHasPortsOffset); off < -ebpfCaptureMetaSize; off += 8 {
+		g.emit(asm.StoreImm(asm.RFP, off, 0, asm.DWord))
+	}
+	g.emit(
+		asm.Mov.Imm(ebpfFilterFamilyReg, 0),
+		asm.Mov.Imm(ebpfFilterProtocolReg, 0),
+		asm.LoadMem(asm.R1, asm.R6, skbProtocolOffset, asm.Word),
+		asm.JEq.Imm(asm.R1, int32(htons(unix.ETH_P_IP)), "ipv4"),
+		asm.JEq.Imm(asm.R1, int32(htons(unix.ETH_P_IPV6)), "ipv6"),
+		// Only IP packets can match a filter.
+		asm.Ja.Label(ebpfFilterPassLabel),
+	)
+
+	g.label("ipv4")
+	g.emit(asm.Mov.Imm(asm.R2, l3Offset))
+	g.loadBytes(ebpfFilterIPHeaderOffset, ipv4HeaderLen, ebpfFilterPassLabel)
+	g.emit(
+		asm.Mov.Imm(ebpfFilterFamilyReg, 4), //nolint:gomnd // IP version
+		asm.LoadMem(ebpfFilterProtocolReg, asm.RFP, ebpfFilterIPHeaderOffset+9, asm.Byte),
+		// The fragments have no transport header, or only a part of it.
+		asm.LoadMem(asm.R1, asm.RFP, ebpfFilterIPHeaderOffset+6, asm.Half),
+		asm.And.Imm(asm.R1, int32(htons(0x3fff))), //nolint:gomnd // more fragments flag and fragment offset
+		asm.JNE.Imm(asm.R1, 0, "filter"),
+		asm.LoadMem(asm.R2, asm.RFP, ebpfFilterIPHeaderOffset, asm.Byte),
+		asm.And.Imm(asm.R2, 0xf), //nolint:gomnd // IHL
+		asm.LSh.Imm(asm.R2, 2),   //nolint:gomnd // 32-bit words
+		asm.Add.Imm(asm.R2, l3Offset),
+	)
+	g.loadPorts()
+
+	g.label("ipv6")
+	g.emit(asm.Mov.Imm(asm.R2, l3Offset))
+	g.loadBytes(ebpfFilterIPHeaderOffset, ipv6HeaderLen, ebpfFilterPassLabel)
+	g.emit(
+		asm.Mov.Imm(ebpfFilterFamilyReg, 6), //nolint:gomnd // IP version
+		// The next header, the extension headers are not followed.
+		asm.LoadMem(ebpfFilterProtocolReg, asm.RFP, ebpfFilterIPHeaderOffset+6, asm.Byte),
+		asm.Mov.Imm(asm.R2, l3Offset+ipv6HeaderLen),
+	)
+	g.loadPorts()
+
+	g.label("filter")
+	if err := g.cond(filter, ebpfFilterCaptureLabel, ebpfFilterPassLabel); err != nil {
+		return nil, err
+	}
+	return g.insns, nil
+}
+
+type ebpfFilterGenerator struct {
+	insns  asm.Instructions
+	labels int
+}
+
+func (g *ebpfFilterGenerator) emit(insns ...asm.Instruction) {
+	g.insns = append(g.insns, insns...)
+}
+
+// newLabel returns a label that is not used yet.
+func (g *ebpfFilterGenerator) newLabel() string {
+	g.labels++
+	return fmt.Sprintf("filter_%d", g.labels)
+}
+
+// label marks the next instruction. An instruction has a single symbol, so the label is set on a no-op, clobbering R0
+// which is not live in the filter.
+func (g *ebpfFilterGenerator) label(label string) {
+	g.emit(asm.Mov.Imm(asm.R0, 0).WithSymbol(label))
+}
+
+// loadBytes copies size bytes of the packet, at the offset in R2, to the stack, and jumps to onError if the packet is
+// too short.
+func (g *ebpfFilterGenerator) loadBytes(stackOffset int16, size int32, onError string) {
+	g.emit(
+		asm.Mov.Reg(asm.R1, asm.R6),
+		asm.Mov.Reg(asm.R3, asm.RFP),
+		asm.Add.Imm(asm.R3, int32(stackOffset)),
+		asm.Mov.Imm(asm.R4, size),
+		asm.FnSkbLoadBytes.Call(),
+		asm.JNE.Imm(asm.R0, 0, onError),
+	)
+}
+
+// loadPorts loads the ports after the IP header, at the offset of the packet in R2, if the protocol has ports, then
+// jumps to the filter.
+func (g *ebpfFilterGenerator) loadPorts() {
+	ports := g.newLabel()
+	g.emit(
+		asm.JEq.Imm(ebpfFilterProtocolReg, unix.IPPROTO_TCP, ports),
+		asm.JEq.Imm(ebpfFilterProtocolReg, unix.IPPROTO_UDP, ports),
+		asm.JEq.Imm(ebpfFilterProtocolReg, unix.IPPROTO_SCTP, ports),
+		asm.Ja.Label("filter"),
+	)
+	g.label(ports)
+	g.loadBytes(ebpfFilterPortsOffset, 4, "filter") //nolint:gomnd // source and destination ports
+	g.emit(
+		asm.StoreImm(asm.RFP, ebpfFilterHasPortsOffset, 1, asm.Word),
+		asm.Ja.Label("filter"),
+	)
+}
+
+// cond emits the checks of the expression, jumping to onTrue if it matches and to onFalse otherwise.
+func (g *ebpfFilterGenerator) cond(e filterExpr, onTrue, onFalse string) error {
+	switch e := e.(type) {
+	case andExpr:
+		right := g.newLabel()
+		if err := g.cond(e.left, right, onFalse); err != nil {
+			return err
+		}
+		g.label(right)
+		return g.cond(e.right, onTrue, onFalse)
+	case orExpr:
+		right := g.newLabel()
+		if err := g.cond(e.left, onTrue, right); err != nil {
+			return err
+		}
+		g.label(right)
+		return g.cond(e.right, onTrue, onFalse)
+	case notExpr:
+		return g.cond(e.expr, onFalse, onTrue)
+	case familyExpr:
+		version := int32(4) //nolint:gomnd // IP version
+		if e.ipv6 {
+			version = 6
+		}
+		g.emit(asm.JNE.Imm(ebpfFilterFamilyReg, version, onFalse), asm.Ja.Label(onTrue))
+	case protocolExpr:
+		g.emit(asm.JNE.Imm(ebpfFilterProtocolReg, int32(e.protocol), onFalse), asm.Ja.Label(onTrue))
+	case prefixExpr:
+		ipv6 := e.prefix.Addr().Is6()
+		version := int32(4) //nolint:gomnd // IP version
+		if ipv6 {
+			version = 6
+		}
+		g.emit(asm.JNE.Imm(ebpfFilterFamilyReg, version, onFalse))
+		if e.prefix.Bits() == 0 {
+			// Any address of the family, the checks of the directions would be unreachable.
+			g.emit(asm.Ja.Label(onTrue))
+			return nil
+		}
+		g.directions(e.src, e.dst, onTrue, onFalse, func(i int, onMismatch string) {
+			g.prefix(e.prefix, ebpfFilterAddrOffsets[ipv6][i], onMismatch)
+		})
+	case portRangeExpr:
+		g.emit(
+			asm.LoadMem(asm.R1, asm.RFP, ebpfFilterHasPortsOffset, asm.Word),
+			asm.JEq.Imm(asm.R1, 0, onFalse),
+		)
+		g.directions(e.src, e.dst, onTrue, onFalse, func(i int, onMismatch string) {
+			g.emit(
+				asm.LoadMem(asm.R1, asm.RFP, ebpfFilterPortsOffset+int16(2*i), asm.Half), //nolint:gomnd // 16-bit ports
+				// From network byte order.
+				asm.HostTo(asm.BE, asm.R1, asm.Half),
+				asm.JLT.Imm(asm.R1, int32(e.start), onMismatch),
+				asm.JGT.Imm(asm.R1, int32(e.end), onMismatch),
+			)
+		})
+	default:
+		return fmt.Errorf("unsupported filter expression %T", e)
+	}
+	return nil
+}
+
+// directions emits the check of the source, index 0, and of the destination, index 1, jumping to onTrue as soon as one
+// matches.
+func (g *ebpfFilterGenerator) directions(src, dst bool, onTrue, onFalse string, check func(i int, onMismatch string)) {
+	if src && dst {
+		next := g.newLabel()
+		check(0, next)
+		g.emit(asm.Ja.Label(onTrue))
+		g.label(next)
+		check(1, onFalse)
+	} else if src {
+		check(0, onFalse)
+	} else {
+		check(1, onFalse)
+	}
+	g.emit(asm.Ja.Label(onTrue))
+}
+
+// prefix compares the address at the stack offset with the prefix, 32 bits at a time.
+func (g *ebpfFilterGenerator) prefix(prefix netip.Prefix, stackOffset int16, onMismatch string) {
+	addr := prefix.Addr().AsSlice()
+	for i := 0; i < prefix.Bits(); i += 32 {
+		bits := min(prefix.Bits()-i, 32) //nolint:gomnd // 32-bit words
+		mask := ^uint32(0) << (32 - bits)
+		g.emit(
+			asm.LoadMem(asm.R1, asm.RFP, stackOffset+int16(i/8), asm.Word), //nolint:gomnd // bytes
+			asm.HostTo(asm.BE, asm.R1, asm.Word),
+			// The upper bits of the sign extended mask are cleared in R1.
+			asm.And.Imm(asm.R1, int32(mask)),
+			asm.LoadImm(asm.R2, int64(binary.BigEndian.Uint32(addr[i/8:])&mask), asm.DWord),
+			asm.JNE.Reg(asm.R1, asm.R2, onMismatch),
+		)
+	}
+}
+
+// htons returns the value in network byte order, as the kernel stores it in the native integer.
+func htons(v uint16) uint16 {
+	return binary.NativeEndian.Uint16(binary.BigEndian.AppendUint16(nil, v))
+}
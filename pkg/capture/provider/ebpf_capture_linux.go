@@ -0,0 +1,464 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/perf"
+	"github.com/cilium/ebpf/rlimit"
+	tc "github.com/florianl/go-tc"
+	helper "github.com/florianl/go-tc/core"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/vishvananda/netlink"
+	vnl "github.com/vishvananda/netlink/nl"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/microsoft/retina/internal/ktime"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	"github.com/microsoft/retina/pkg/utils"
+)
+
+const (
+	// ebpfCaptureFilterPriority is the priority of the capture programs among the tc filters of the interfaces, after
+	// the packetparser programs.
+	ebpfCaptureFilterPriority uint16 = 0x2
+	ebpfCaptureProgramName           = "retina_capture"
+
+	// ebpfCaptureMetaSize is the size of the metadata the capture program writes before each packet: the boot time
+	// timestamp, the interface index, the packet length and the captured length.
+	ebpfCaptureMetaSize = 24
+	// defaultEBPFSnapLen is the snapshot length of tcpdump, which is truncated to maxEBPFSnapLen.
+	defaultEBPFSnapLen = 262144
+	// maxEBPFSnapLen keeps the perf records, packet and metadata included, below the 64KiB limit of their size.
+	maxEBPFSnapLen = 0xffff - 64
+	// enhancedPacketBlockSize is the size of a pcapng enhanced packet block without the packet data.
+	enhancedPacketBlockSize = 32
+
+	// Offsets of the fields of struct __sk_buff.
+	skbLenOffset      = 0
+	skbProtocolOffset = 16
+	skbIfindexOffset  = 40
+
+	ethernetHeaderLen = 14
+
+	// bpfFCurrentCPU is BPF_F_CURRENT_CPU, the lower 32 bits of the flags of bpf_perf_event_output.
+	bpfFCurrentCPU = 0xffffffff
+	// tcActUnspec is TC_ACT_UNSPEC, which passes the packets to the next tc filter.
+	tcActUnspec = -1
+)
+
+// CaptureNetworkPacket copies the packets of all the non-loopback interfaces of the node matching the filter to a perf
+// buffer, and writes them to a pcapng file, until the duration elapses or the file reaches maxSizeMB. The filter is
+// compiled into the capture programs, so that the other packets are not copied to user space.
+func (ecp *EBPFCaptureProvider) CaptureNetworkPacket(ctx context.Context, filter string, duration, maxSizeMB int, ringBuffer *RingBuffer) error {
+	if ringBuffer != nil {
+		return errEBPFRingBufferNotSupported
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if duration != 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(duration)*time.Second)
+		defer cancel()
+	}
+
+	packetFilter, err := compileFilter(filter)
+	if err != nil {
+		return err
+	}
+	snapLen, err := ebpfSnapLen()
+	if err != nil {
+		return err
+	}
+
+	links, err := netlink.LinkList()
+	if err != nil {
+		return fmt.Errorf("failed to list network interfaces: %w", err)
+	}
+	maxIfindex := 0
+	for _, link := range links {
+		maxIfindex = max(maxIfindex, link.Attrs().Index)
+	}
+
+	if err := rlimit.RemoveMemlock(); err != nil {
+		ecp.l.Warn("Failed to remove memlock limit", zap.Error(err))
+	}
+	events, err := ebpf.NewMap(&ebpf.MapSpec{Type: ebpf.PerfEventArray, Name: "retina_capture"})
+	if err != nil {
+		return fmt.Errorf("failed to create perf event array: %w", err)
+	}
+	defer events.Close()
+	lost, err := newEBPFCaptureLostMap(maxIfindex)
+	if err != nil {
+		return err
+	}
+	defer lost.Close()
+	// The programs of the interfaces with and without link layer header.
+	progs := map[layers.LinkType]*ebpf.Program{}
+	defer func() {
+		for _, prog := range progs {
+			prog.Close()
+		}
+	}()
+
+	reader, err := perf.NewReader(events, 256*os.Getpagesize()) //nolint:gomnd // 1MiB per CPU on 4KiB pages
+	if err != nil {
+		return fmt.Errorf("failed to create perf reader: %w", err)
+	}
+	defer reader.Close()
+	go func() {
+		<-ctx.Done()
+		// Unblocks the read of the perf buffer.
+		reader.Close() //nolint:errcheck // the reader is closed again on return
+	}()
+
+	captureFilePath := filepath.Join(ecp.TmpCaptureDir, fmt.Sprintf("%s.pcapng", &ecp.Filename))
+	captureFile, err := os.Create(captureFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to create capture file: %w", err)
+	}
+	defer captureFile.Close()
+
+	var ngWriter *pcapgo.NgWriter
+	interfaces := map[uint32]ebpfCaptureInterface{}
+	for _, link := range links {
+		attrs := link.Attrs()
+		if attrs.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		ngInterface := pcapgo.NgInterface{
+			Name:                attrs.Name,
+			LinkType:            layers.LinkTypeEthernet,
+			SnapLength:          snapLen,
+			TimestampResolution: 9, //nolint:gomnd // nanoseconds
+			OS:                  "linux",
+		}
+		// The packets of the interfaces without link layer header start with the IP header.
+		if len(attrs.HardwareAddr) == 0 {
+			ngInterface.LinkType = layers.LinkTypeRaw
+		}
+		prog, ok := progs[ngInterface.LinkType]
+		if !ok {
+			prog, err = newEBPFCaptureProgram(events, lost, snapLen, packetFilter, ngInterface.LinkType)
+			if err != nil {
+				return err
+			}
+			progs[ngInterface.LinkType] = prog
+		}
+
+		var id int
+		if ngWriter == nil {
+			ngWriter, err = pcapgo.NewNgWriterInterface(captureFile, ngInterface, pcapgo.NgWriterOptions{
+				SectionInfo: pcapgo.NgSectionInfo{Application: "retina", OS: "linux"},
+			})
+		} else {
+			id, err = ngWriter.AddInterface(ngInterface)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write pcapng interface: %w", err)
+		}
+
+		detach, err := attachEBPFCaptureProgram(prog, attrs.Index)
+		if err != nil {
+			ecp.l.Warn("Failed to attach capture program, skipping interface", zap.String("interface", attrs.Name), zap.Error(err))
+			continue
+		}
+		defer func(name string) {
+			if err := detach(); err != nil {
+				ecp.l.Warn("Failed to detach capture program", zap.String("interface", name), zap.Error(err))
+			}
+		}(attrs.Name)
+		interfaces[uint32(attrs.Index)] = ebpfCaptureInterface{id: id, name: attrs.Name}
+	}
+	if len(interfaces) == 0 {
+		return fmt.Errorf("failed to attach capture program to any network interface")
+	}
+
+	startTime := time.Now()
+	ecp.l.Info("Capturing network packets with eBPF", zap.Int("interfaces", len(interfaces)), zap.Uint32("snap length", snapLen),
+		zap.String("filter", filter), zap.Int("duration", duration), zap.Int("max size MB", maxSizeMB))
+	maxBytes := int64(maxSizeMB) * 1024 * 1024
+	var written int64
+	var packets, lostSamples uint64
+	for maxBytes == 0 || written < maxBytes {
+		record, err := reader.Read()
+		if errors.Is(err, perf.ErrClosed) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read perf buffer: %w", err)
+		}
+		if record.LostSamples != 0 {
+			lostSamples += record.LostSamples
+			continue
+		}
+
+		sample, ok := parseEBPFCaptureSample(record.RawSample)
+		if !ok {
+			continue
+		}
+		iface, ok := interfaces[sample.ifindex]
+		if !ok {
+			continue
+		}
+
+		ci := gopacket.CaptureInfo{
+			Timestamp:      time.Unix(0, int64(sample.timestamp)+int64(ktime.MonotonicOffset)),
+			CaptureLength:  len(sample.data),
+			Length:         int(sample.length),
+			InterfaceIndex: iface.id,
+		}
+		if err := ngWriter.WritePacket(ci, sample.data); err != nil {
+			return fmt.Errorf("failed to write packet: %w", err)
+		}
+		packets++
+		written += int64(len(sample.data)) + enhancedPacketBlockSize
+	}
+
+	// The samples lost when the perf buffer was full are counted per interface by the capture programs, and written
+	// to the interface statistics of the capture file.
+	endTime := time.Now()
+	var lostPackets uint64
+	for ifindex, iface := range interfaces {
+		var dropped uint64
+		if err := lost.Lookup(ifindex, &dropped); err != nil {
+			ecp.l.Warn("Failed to read lost samples", zap.String("interface", iface.name), zap.Error(err))
+			continue
+		}
+		lostPackets += dropped
+		stats := pcapgo.NgInterfaceStatistics{
+			LastUpdate:      endTime,
+			StartTime:       startTime,
+			EndTime:         endTime,
+			PacketsReceived: pcapgo.NgNoValue64,
+			PacketsDropped:  dropped,
+		}
+		if err := ngWriter.WriteInterfaceStats(iface.id, stats); err != nil {
+			return fmt.Errorf("failed to write interface statistics: %w", err)
+		}
+	}
+	if err := ngWriter.Flush(); err != nil {
+		return fmt.Errorf("failed to write capture file: %w", err)
+	}
+	if lostPackets != 0 || lostSamples != 0 {
+		ecp.l.Warn("Packets were lost because the perf buffer was full", zap.Uint64("lost packets", lostPackets),
+			zap.Uint64("lost samples", lostSamples))
+	}
+	ecp.l.Info("Stopped capturing network packets with eBPF", zap.String("capture file", captureFilePath),
+		zap.Uint64("packets", packets), zap.Uint64("lost packets", lostPackets), zap.Int64("bytes", written))
+	return nil
+}
+
+type ebpfCaptureInterface struct {
+	id   int
+	name string
+}
+
+type ebpfCaptureSample struct {
+	timestamp uint64
+	ifindex   uint32
+	length    uint32
+	data      []byte
+}
+
+// parseEBPFCaptureSample parses the metadata written by the capture program, followed by the captured packet and
+// the padding of the perf record.
+func parseEBPFCaptureSample(raw []byte) (ebpfCaptureSample, bool) {
+	if len(raw) < ebpfCaptureMetaSize {
+		return ebpfCaptureSample{}, false
+	}
+	capLen := int(binary.NativeEndian.Uint32(raw[16:20]))
+	if len(raw) < ebpfCaptureMetaSize+capLen {
+		return ebpfCaptureSample{}, false
+	}
+	return ebpfCaptureSample{
+		timestamp: binary.NativeEndian.Uint64(raw[0:8]),
+		ifindex:   binary.NativeEndian.Uint32(raw[8:12]),
+		length:    binary.NativeEndian.Uint32(raw[12:16]),
+		data:      raw[ebpfCaptureMetaSize : ebpfCaptureMetaSize+capLen],
+	}, true
+}
+
+// ebpfSnapLen returns the snapshot length of the packets, from the packet size of the Capture.
+func ebpfSnapLen() (uint32, error) {
+	snapLen := defaultEBPFSnapLen
+	if packetSize := os.Getenv(captureConstants.PacketSizeEnvKey); len(packetSize) != 0 {
+		size, err := strconv.Atoi(packetSize)
+		if err != nil || size <= 0 {
+			return 0, fmt.Errorf("invalid packet size %q", packetSize)
+		}
+		snapLen = size
+	}
+	return uint32(min(snapLen, maxEBPFSnapLen)), nil
+}
+
+// ebpfCaptureInstructions returns a tc program copying the first snapLen bytes of each packet matching the filter to
+// the perf event array, after the metadata parsed by parseEBPFCaptureSample, and counting the packets it fails to copy
+// in the lost map, by interface index. The program is small enough to be assembled here, so that the capture image
+// needs neither tcpdump nor a BPF compiler.
+func ebpfCaptureInstructions(events, lost *ebpf.Map, snapLen uint32, filter filterExpr, linkType layers.LinkType) (asm.Instructions, error) {
+	insns := asm.Instructions{asm.Mov.Reg(asm.R6, asm.R1)}
+	if filter != nil {
+		l3Offset := int32(0)
+		if linkType == layers.LinkTypeEthernet {
+			l3Offset = ethernetHeaderLen
+		}
+		filterInsns, err := ebpfFilterInstructions(filter, l3Offset)
+		if err != nil {
+			return nil, err
+		}
+		insns = append(insns, filterInsns...)
+	}
+	return append(insns,
+		asm.FnKtimeGetBootNs.Call().WithSymbol(ebpfFilterCaptureLabel),
+		asm.StoreMem(asm.RFP, -24, asm.R0, asm.DWord),
+		asm.LoadMem(asm.R1, asm.R6, skbIfindexOffset, asm.Word),
+		asm.StoreMem(asm.RFP, -16, asm.R1, asm.Word),
+		asm.LoadMem(asm.R2, asm.R6, skbLenOffset, asm.Word),
+		asm.StoreMem(asm.RFP, -12, asm.R2, asm.Word),
+		// The captured length is the packet length, truncated to the snapshot length.
+		asm.JLE.Imm(asm.R2, int32(snapLen), "caplen"),
+		asm.Mov.Imm(asm.R2, int32(snapLen)),
+		asm.StoreMem(asm.RFP, -8, asm.R2, asm.Word).WithSymbol("caplen"),
+		asm.StoreImm(asm.RFP, -4, 0, asm.Word),
+		// The upper 32 bits of the flags are the number of bytes of the packet appended to the metadata.
+		asm.LSh.Imm(asm.R2, 32), //nolint:gomnd // bits
+		asm.LoadImm(asm.R3, bpfFCurrentCPU, asm.DWord),
+		asm.Or.Reg(asm.R3, asm.R2),
+		asm.Mov.Reg(asm.R1, asm.R6),
+		asm.LoadMapPtr(asm.R2, events.FD()),
+		asm.Mov.Reg(asm.R4, asm.RFP),
+		asm.Add.Imm(asm.R4, -ebpfCaptureMetaSize),
+		asm.Mov.Imm(asm.R5, ebpfCaptureMetaSize),
+		asm.FnPerfEventOutput.Call(),
+		asm.JEq.Imm(asm.R0, 0, ebpfFilterPassLabel),
+		// The perf buffer is full, the packet is counted as lost. The key is the interface index of the metadata.
+		asm.LoadMapPtr(asm.R1, lost.FD()),
+		asm.Mov.Reg(asm.R2, asm.RFP),
+		asm.Add.Imm(asm.R2, -16), //nolint:gomnd // interface index
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, ebpfFilterPassLabel),
+		asm.Mov.Imm(asm.R1, 1),
+		asm.StoreXAdd(asm.R0, asm.R1, asm.DWord),
+		asm.Mov.Imm(asm.R0, tcActUnspec).WithSymbol(ebpfFilterPassLabel),
+		asm.Return(),
+	), nil
+}
+
+// newEBPFCaptureLostMap returns the array counting the lost packets, indexed by the interface indexes up to maxIfindex.
+func newEBPFCaptureLostMap(maxIfindex int) (*ebpf.Map, error) {
+	lost, err := ebpf.NewMap(&ebpf.MapSpec{
+		Type:       ebpf.Array,
+		Name:       "retina_cap_lost",
+		KeySize:    4, //nolint:gomnd // interface index
+		ValueSize:  8, //nolint:gomnd // counter
+		MaxEntries: uint32(maxIfindex) + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lost packets map: %w", err)
+	}
+	return lost, nil
+}
+
+func newEBPFCaptureProgram(events, lost *ebpf.Map, snapLen uint32, filter filterExpr, linkType layers.LinkType) (*ebpf.Program, error) {
+	insns, err := ebpfCaptureInstructions(events, lost, snapLen, filter, linkType)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
+		Name:         ebpfCaptureProgramName,
+		Type:         ebpf.SchedCLS,
+		License:      "GPL",
+		Instructions: insns,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load capture program: %w", err)
+	}
+	return prog, nil
+}
+
+// attachEBPFCaptureProgram attaches the program to the ingress and the egress of the interface, creating its clsact
+// qdisc if needed, and returns the function detaching it.
+func attachEBPFCaptureProgram(prog *ebpf.Program, ifindex int) (func() error, error) {
+	rtnl, err := tc.Open(&tc.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rtnetlink socket: %w", err)
+	}
+
+	clsactQdisc := &tc.Object{
+		Msg: tc.Msg{
+			Family:  unix.AF_UNSPEC,
+			Ifindex: uint32(ifindex),
+			Handle:  helper.BuildHandle(0xFFFF, 0x0000), //nolint:gomnd // special handle for clsact qdisc
+			Parent:  tc.HandleIngress,
+		},
+		Attribute: tc.Attribute{Kind: "clsact"},
+	}
+	createdQdisc := true
+	if err := rtnl.Qdisc().Add(clsactQdisc); err != nil {
+		if !errors.Is(err, os.ErrExist) {
+			rtnl.Close()
+			return nil, fmt.Errorf("failed to add clsact qdisc: %w", err)
+		}
+		createdQdisc = false
+	}
+
+	filters := []*tc.Object{}
+	detach := func() error {
+		var errs []error
+		for _, filter := range filters {
+			if err := rtnl.Filter().Delete(filter); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete bpf filter: %w", err))
+			}
+		}
+		// Other programs may be attached to an existing qdisc.
+		if createdQdisc {
+			if err := rtnl.Qdisc().Delete(clsactQdisc); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete clsact qdisc: %w", err))
+			}
+		}
+		if err := rtnl.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close rtnetlink socket: %w", err))
+		}
+		return errors.Join(errs...)
+	}
+
+	for _, parent := range []uint32{tc.HandleMinIngress, tc.HandleMinEgress} {
+		filter := &tc.Object{
+			Msg: tc.Msg{
+				Family:  unix.AF_UNSPEC,
+				Ifindex: uint32(ifindex),
+				Handle:  1,
+				Parent:  helper.BuildHandle(0xFFFF, parent),                                        //nolint:gomnd // same major as clsact
+				Info:    netlink.MakeHandle(ebpfCaptureFilterPriority, vnl.Swap16(unix.ETH_P_ALL)), // priority and protocol
+			},
+			Attribute: tc.Attribute{
+				Kind: "bpf",
+				BPF: &tc.Bpf{
+					FD:    utils.Uint32Ptr(uint32(prog.FD())),
+					Name:  utils.StringPtr(ebpfCaptureProgramName),
+					Flags: utils.Uint32Ptr(0x1), // direct action
+				},
+			},
+		}
+		if err := rtnl.Filter().Add(filter); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to add bpf filter: %w", err), detach())
+		}
+		filters = append(filters, filter)
+	}
+	return detach, nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/perf"
+	"github.com/cilium/ebpf/rlimit"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+)
+
+func TestParseEBPFCaptureSample(t *testing.T) {
+	raw := make([]byte, ebpfCaptureMetaSize+8)
+	binary.NativeEndian.PutUint64(raw[0:8], 42)
+	binary.NativeEndian.PutUint32(raw[8:12], 3)
+	binary.NativeEndian.PutUint32(raw[12:16], 1500)
+	binary.NativeEndian.PutUint32(raw[16:20], 5)
+	copy(raw[ebpfCaptureMetaSize:], "hello")
+
+	sample, ok := parseEBPFCaptureSample(raw)
+	require.True(t, ok)
+	assert.Equal(t, ebpfCaptureSample{timestamp: 42, ifindex: 3, length: 1500, data: []byte("hello")}, sample)
+
+	// The captured length exceeds the sample.
+	binary.NativeEndian.PutUint32(raw[16:20], 9)
+	_, ok = parseEBPFCaptureSample(raw)
+	assert.False(t, ok)
+	_, ok = parseEBPFCaptureSample(raw[:ebpfCaptureMetaSize-1])
+	assert.False(t, ok)
+}
+
+func TestEBPFSnapLen(t *testing.T) {
+	snapLen, err := ebpfSnapLen()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(maxEBPFSnapLen), snapLen)
+
+	t.Setenv(captureConstants.PacketSizeEnvKey, "96")
+	snapLen, err = ebpfSnapLen()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(96), snapLen)
+
+	t.Setenv(captureConstants.PacketSizeEnvKey, "-1")
+	_, err = ebpfSnapLen()
+	assert.Error(t, err)
+}
+
+func TestEBPFCaptureProgram(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("loading eBPF programs requires root")
+	}
+	require.NoError(t, rlimit.RemoveMemlock())
+
+	events, err := ebpf.NewMap(&ebpf.MapSpec{Type: ebpf.PerfEventArray, Name: "test_capture"})
+	require.NoError(t, err)
+	defer events.Close()
+	lost, err := newEBPFCaptureLostMap(16)
+	require.NoError(t, err)
+	defer lost.Close()
+	prog, err := newEBPFCaptureProgram(events, lost, 64, nil, layers.LinkTypeEthernet)
+	if err != nil {
+		t.Skipf("failed to load eBPF program, the kernel may not allow it: %s", err)
+	}
+	defer prog.Close()
+	reader, err := perf.NewReader(events, os.Getpagesize())
+	require.NoError(t, err)
+	defer reader.Close()
+
+	// The smallest packet accepted by BPF_PROG_TEST_RUN is an Ethernet header.
+	packet := make([]byte, 100)
+	for i := range packet {
+		packet[i] = byte(i)
+	}
+	ret, err := prog.Run(&ebpf.RunOptions{Data: packet})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0xffffffff), ret, "the program should return TC_ACT_UNSPEC")
+
+	reader.SetDeadline(time.Now().Add(time.Second))
+	record, err := reader.Read()
+	require.NoError(t, err)
+	sample, ok := parseEBPFCaptureSample(record.RawSample)
+	require.True(t, ok)
+	assert.Equal(t, uint32(len(packet)), sample.length)
+	assert.Equal(t, packet[:64], sample.data)
+	assert.NotZero(t, sample.timestamp)
+}
+
+// readEBPFCaptureSamples returns the packets of the samples in the perf buffer.
+func readEBPFCaptureSamples(t *testing.T, reader *perf.Reader) [][]byte {
+	t.Helper()
+	packets := [][]byte{}
+	reader.SetDeadline(time.Now().Add(100 * time.Millisecond))
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return packets
+		}
+		require.NoError(t, err)
+		sample, ok := parseEBPFCaptureSample(record.RawSample)
+		require.True(t, ok)
+		packets = append(packets, sample.data)
+	}
+}
+
+// TestEBPFCaptureFilter checks that the capture program matches the packets as the filter expressions do.
+func TestEBPFCaptureFilter(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("loading eBPF programs requires root")
+	}
+	require.NoError(t, rlimit.RemoveMemlock())
+
+	events, err := ebpf.NewMap(&ebpf.MapSpec{Type: ebpf.PerfEventArray, Name: "test_capture"})
+	require.NoError(t, err)
+	defer events.Close()
+	lost, err := newEBPFCaptureLostMap(16)
+	require.NoError(t, err)
+	defer lost.Close()
+	reader, err := perf.NewReader(events, 16*os.Getpagesize())
+	require.NoError(t, err)
+	defer reader.Close()
+	prog, err := newEBPFCaptureProgram(events, lost, 256, nil, layers.LinkTypeEthernet)
+	if err != nil {
+		t.Skipf("failed to load eBPF program, the kernel may not allow it: %s", err)
+	}
+	prog.Close()
+
+	packets := [][]byte{
+		udpPacket(t, "10.0.0.1", "10.0.0.2", 1234, 53),
+		udpPacket(t, "fd00::1", "fd00::2", 1234, 53),
+		tcpPacket(t, "10.0.0.1", "192.168.1.10", 40000, 80),
+		tcpPacket(t, "192.168.1.10", "10.0.0.1", 80, 40000),
+		fragmentedUDPPacket(t, "10.0.0.1", "10.0.0.2", 1234, 53),
+		icmpPacket(t, "10.0.0.1", "10.0.0.2"),
+	}
+	for _, filter := range []string{
+		"host 10.0.0.1",
+		"src host 10.0.0.2",
+		"dst host 10.0.0.2",
+		"net 10.0.0.0/24",
+		"net 192.168.0.0/16",
+		"dst net 192.168.1.0/24 and tcp and port 80",
+		"host fd00::2",
+		"net fd00::/8 and udp",
+		"ip6 and dst port 53",
+		"port 53",
+		"src port 1234",
+		"portrange 70-90",
+		"dst portrange 40000-40010",
+		"tcp",
+		"udp and ip",
+		"not ip",
+		"icmp or icmp6",
+		"((host 10.0.0.3 and port 80) or (port 53)) and not ((host 10.0.0.1 and port 1234))",
+		"!tcp && (port 1 || port 1234)",
+		"sctp or net 0.0.0.0/0",
+		"src net ::/0",
+	} {
+		t.Run(filter, func(t *testing.T) {
+			expr, err := compileFilter(filter)
+			require.NoError(t, err)
+			prog, err := newEBPFCaptureProgram(events, lost, 256, expr, layers.LinkTypeEthernet)
+			require.NoError(t, err)
+			defer prog.Close()
+
+			want := [][]byte{}
+			for _, packet := range packets {
+				ret, err := prog.Run(&ebpf.RunOptions{Data: packet})
+				require.NoError(t, err)
+				assert.Equal(t, uint32(0xffffffff), ret, "the program should return TC_ACT_UNSPEC")
+				info, ok := decodePacketInfo(packet, layers.LinkTypeEthernet)
+				if ok && expr.match(&info) {
+					want = append(want, packet)
+				}
+			}
+			assert.Equal(t, want, readEBPFCaptureSamples(t, reader))
+		})
+	}
+}
+
+func TestEBPFCaptureLostPackets(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("loading eBPF programs requires root")
+	}
+	require.NoError(t, rlimit.RemoveMemlock())
+
+	// Without a reader, the perf buffers cannot be written.
+	events, err := ebpf.NewMap(&ebpf.MapSpec{Type: ebpf.PerfEventArray, Name: "test_capture"})
+	require.NoError(t, err)
+	defer events.Close()
+	lost, err := newEBPFCaptureLostMap(16)
+	require.NoError(t, err)
+	defer lost.Close()
+	prog, err := newEBPFCaptureProgram(events, lost, 64, nil, layers.LinkTypeEthernet)
+	if err != nil {
+		t.Skipf("failed to load eBPF program, the kernel may not allow it: %s", err)
+	}
+	defer prog.Close()
+
+	for range 3 {
+		_, err = prog.Run(&ebpf.RunOptions{Data: make([]byte, 100)})
+		require.NoError(t, err)
+	}
+	var total, count uint64
+	var ifindex uint32
+	iter := lost.Iterate()
+	for iter.Next(&ifindex, &count) {
+		total += count
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, uint64(3), total)
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"context"
+	"errors"
+)
+
+var errEBPFCaptureNotSupported = errors.New("eBPF capture provider is only supported on Linux")
+
+func (ecp *EBPFCaptureProvider) CaptureNetworkPacket(_ context.Context, _ string, _, _ int, _ *RingBuffer) error {
+	return errEBPFCaptureNotSupported
+}
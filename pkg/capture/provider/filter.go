@@ -0,0 +1,294 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// packetInfo is what a packet filter matches on.
+type packetInfo struct {
+	src, dst         netip.Addr
+	srcPort, dstPort uint16
+	hasPorts         bool
+	protocol         layers.IPProtocol
+}
+
+// decodePacketInfo decodes the addresses, the ports and the protocol of a packet of the link type, and returns false
+// when the packet is not an IP packet. As in the eBPF capture program, the ports are only decoded when the transport
+// header follows the IP header, which excludes the IPv4 fragments and the IPv6 extension headers.
+func decodePacketInfo(data []byte, linkType layers.LinkType) (packetInfo, bool) {
+	var first gopacket.LayerType
+	switch linkType {
+	case layers.LinkTypeEthernet:
+		first = layers.LayerTypeEthernet
+	default:
+		// Packets of links without a link layer header start with the IP header.
+		if len(data) == 0 {
+			return packetInfo{}, false
+		}
+		first = layers.LayerTypeIPv4
+		if data[0]>>4 == 6 { //nolint:gomnd // IP version
+			first = layers.LayerTypeIPv6
+		}
+	}
+
+	var info packetInfo
+	packet := gopacket.NewPacket(data, first, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	switch ip := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		info.src, _ = netip.AddrFromSlice(ip.SrcIP.To4())
+		info.dst, _ = netip.AddrFromSlice(ip.DstIP.To4())
+		info.protocol = ip.Protocol
+	case *layers.IPv6:
+		info.src, _ = netip.AddrFromSlice(ip.SrcIP)
+		info.dst, _ = netip.AddrFromSlice(ip.DstIP)
+		info.protocol = ip.NextHeader
+	default:
+		return packetInfo{}, false
+	}
+	switch info.protocol {
+	case layers.IPProtocolTCP, layers.IPProtocolUDP, layers.IPProtocolSCTP:
+	default:
+		return info, true
+	}
+	switch transport := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		info.srcPort, info.dstPort, info.hasPorts = uint16(transport.SrcPort), uint16(transport.DstPort), true
+	case *layers.UDP:
+		info.srcPort, info.dstPort, info.hasPorts = uint16(transport.SrcPort), uint16(transport.DstPort), true
+	case *layers.SCTP:
+		info.srcPort, info.dstPort, info.hasPorts = uint16(transport.SrcPort), uint16(transport.DstPort), true
+	}
+	return info, true
+}
+
+// filterExpr is a node of a compiled capture filter. The Go matcher is the reference of the checks the eBPF capture
+// program runs in the kernel.
+type filterExpr interface {
+	match(info *packetInfo) bool
+}
+
+type (
+	andExpr struct{ left, right filterExpr }
+	orExpr  struct{ left, right filterExpr }
+	notExpr struct{ expr filterExpr }
+	// familyExpr matches the IPv4 packets, or the IPv6 packets when ipv6 is true.
+	familyExpr   struct{ ipv6 bool }
+	protocolExpr struct{ protocol layers.IPProtocol }
+	prefixExpr   struct {
+		prefix   netip.Prefix
+		src, dst bool
+	}
+	portRangeExpr struct {
+		start, end uint16
+		src, dst   bool
+	}
+)
+
+func (e andExpr) match(info *packetInfo) bool { return e.left.match(info) && e.right.match(info) }
+
+func (e orExpr) match(info *packetInfo) bool { return e.left.match(info) || e.right.match(info) }
+
+func (e notExpr) match(info *packetInfo) bool { return !e.expr.match(info) }
+
+func (e familyExpr) match(info *packetInfo) bool {
+	if e.ipv6 {
+		return info.src.Is6()
+	}
+	return info.src.Is4()
+}
+
+func (e protocolExpr) match(info *packetInfo) bool { return info.protocol == e.protocol }
+
+func (e prefixExpr) match(info *packetInfo) bool {
+	return (e.src && e.prefix.Contains(info.src)) || (e.dst && e.prefix.Contains(info.dst))
+}
+
+func (e portRangeExpr) match(info *packetInfo) bool {
+	return info.hasPorts && ((e.src && info.srcPort >= e.start && info.srcPort <= e.end) ||
+		(e.dst && info.dstPort >= e.start && info.dstPort <= e.end))
+}
+
+// compileFilter compiles the subset of the tcpdump filter syntax the capture filters are translated to: the
+// primitives "[src|dst] host <ip>", "[src|dst] net <cidr>", "[src|dst] port <port>", "[src|dst] portrange <start-end>",
+// "ip", "ip6", "tcp", "udp", "sctp", "icmp" and "icmp6", combined with "and", "or", "not" and parentheses. An empty
+// filter captures all the packets and compiles to nil.
+func compileFilter(expr string) (filterExpr, error) {
+	p := &filterParser{tokens: tokenizeFilter(expr)}
+	if len(p.tokens) == 0 {
+		return nil, nil
+	}
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid capture filter %q: %w", expr, err)
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("invalid capture filter %q: unexpected %q", expr, p.tokens[p.pos])
+	}
+	return filter, nil
+}
+
+func tokenizeFilter(expr string) []string {
+	expr = strings.NewReplacer("(", " ( ", ")", " ) ", "&&", " and ", "||", " or ", "!", " not ").Replace(expr)
+	return strings.Fields(expr)
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() (string, error) {
+	if p.pos == len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of filter")
+	}
+	p.pos++
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "and" {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filterExpr, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case "not":
+		f, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: f}, nil
+	case "(":
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token, err := p.next(); err != nil || token != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return f, nil
+	default:
+		p.pos--
+		return p.parsePrimitive()
+	}
+}
+
+func (p *filterParser) parsePrimitive() (filterExpr, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case "ip":
+		return familyExpr{}, nil
+	case "ip6":
+		return familyExpr{ipv6: true}, nil
+	case "tcp":
+		return protocolExpr{protocol: layers.IPProtocolTCP}, nil
+	case "udp":
+		return protocolExpr{protocol: layers.IPProtocolUDP}, nil
+	case "sctp":
+		return protocolExpr{protocol: layers.IPProtocolSCTP}, nil
+	case "icmp":
+		return protocolExpr{protocol: layers.IPProtocolICMPv4}, nil
+	case "icmp6":
+		return protocolExpr{protocol: layers.IPProtocolICMPv6}, nil
+	}
+
+	src, dst := true, true
+	switch token {
+	case "src":
+		dst = false
+	case "dst":
+		src = false
+	default:
+		p.pos--
+	}
+
+	kind, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	value, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case "host":
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid host %q", value)
+		}
+		prefix := netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		return prefixExpr{prefix: prefix, src: src, dst: dst}, nil
+	case "net":
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid net %q", value)
+		}
+		return prefixExpr{prefix: prefix.Masked(), src: src, dst: dst}, nil
+	case "port":
+		port, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", value)
+		}
+		return portRangeExpr{start: uint16(port), end: uint16(port), src: src, dst: dst}, nil
+	case "portrange":
+		start, end, ok := strings.Cut(value, "-")
+		startPort, startErr := strconv.ParseUint(start, 10, 16)
+		endPort, endErr := strconv.ParseUint(end, 10, 16)
+		if !ok || startErr != nil || endErr != nil || startPort > endPort {
+			return nil, fmt.Errorf("invalid portrange %q", value)
+		}
+		return portRangeExpr{start: uint16(startPort), end: uint16(endPort), src: src, dst: dst}, nil
+	default:
+		return nil, fmt.Errorf("unsupported primitive %q", kind)
+	}
+}
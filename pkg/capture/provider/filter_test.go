@@ -0,0 +1,152 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func udpPacket(t *testing.T, src, dst string, srcPort, dstPort uint16) []byte {
+	t.Helper()
+	eth := &layers.Ethernet{SrcMAC: net.HardwareAddr{0, 0, 0, 0, 0, 1}, DstMAC: net.HardwareAddr{0, 0, 0, 0, 0, 2}, EthernetType: layers.EthernetTypeIPv4}
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+	if ip.SrcIP.To4() == nil {
+		eth.EthernetType = layers.EthernetTypeIPv6
+	}
+	udp := &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: layers.UDPPort(dstPort)}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if eth.EthernetType == layers.EthernetTypeIPv6 {
+		ip6 := &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: layers.IPProtocolUDP, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+		require.NoError(t, udp.SetNetworkLayerForChecksum(ip6))
+		require.NoError(t, gopacket.SerializeLayers(buf, opts, eth, ip6, udp, gopacket.Payload("payload")))
+		return buf.Bytes()
+	}
+	require.NoError(t, udp.SetNetworkLayerForChecksum(ip))
+	require.NoError(t, gopacket.SerializeLayers(buf, opts, eth, ip, udp, gopacket.Payload("payload")))
+	return buf.Bytes()
+}
+
+func serializePacket(t *testing.T, l ...gopacket.SerializableLayer) []byte {
+	t.Helper()
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, l...))
+	return buf.Bytes()
+}
+
+func ethernet(ethernetType layers.EthernetType) *layers.Ethernet {
+	return &layers.Ethernet{SrcMAC: net.HardwareAddr{0, 0, 0, 0, 0, 1}, DstMAC: net.HardwareAddr{0, 0, 0, 0, 0, 2}, EthernetType: ethernetType}
+}
+
+func tcpPacket(t *testing.T, src, dst string, srcPort, dstPort uint16) []byte {
+	t.Helper()
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+	tcp := &layers.TCP{SrcPort: layers.TCPPort(srcPort), DstPort: layers.TCPPort(dstPort), SYN: true, Window: 1024}
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+	return serializePacket(t, ethernet(layers.EthernetTypeIPv4), ip, tcp)
+}
+
+func icmpPacket(t *testing.T, src, dst string) []byte {
+	t.Helper()
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolICMPv4, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+	icmp := &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0)}
+	return serializePacket(t, ethernet(layers.EthernetTypeIPv4), ip, icmp, gopacket.Payload("ping"))
+}
+
+// fragmentedUDPPacket returns the first fragment of a UDP datagram.
+func fragmentedUDPPacket(t *testing.T, src, dst string, srcPort, dstPort uint16) []byte {
+	t.Helper()
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, Flags: layers.IPv4MoreFragments, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst)}
+	udp := &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: layers.UDPPort(dstPort)}
+	require.NoError(t, udp.SetNetworkLayerForChecksum(ip))
+	return serializePacket(t, ethernet(layers.EthernetTypeIPv4), ip, udp, gopacket.Payload("fragment"))
+}
+
+func TestDecodePacketInfo(t *testing.T) {
+	packet := udpPacket(t, "10.0.0.1", "10.0.0.2", 1234, 53)
+	info, ok := decodePacketInfo(packet, layers.LinkTypeEthernet)
+	require.True(t, ok)
+	assert.Equal(t, "10.0.0.1", info.src.String())
+	assert.Equal(t, "10.0.0.2", info.dst.String())
+	assert.Equal(t, uint16(1234), info.srcPort)
+	assert.Equal(t, uint16(53), info.dstPort)
+	assert.Equal(t, layers.IPProtocolUDP, info.protocol)
+
+	// Without the Ethernet header.
+	info, ok = decodePacketInfo(udpPacket(t, "fd00::1", "fd00::2", 1234, 53)[14:], layers.LinkTypeRaw)
+	require.True(t, ok)
+	assert.Equal(t, "fd00::1", info.src.String())
+	assert.Equal(t, uint16(53), info.dstPort)
+
+	// The ports of the fragments are not decoded.
+	info, ok = decodePacketInfo(fragmentedUDPPacket(t, "10.0.0.1", "10.0.0.2", 1234, 53), layers.LinkTypeEthernet)
+	require.True(t, ok)
+	assert.Equal(t, layers.IPProtocolUDP, info.protocol)
+	assert.False(t, info.hasPorts)
+
+	_, ok = decodePacketInfo([]byte{0, 1, 2}, layers.LinkTypeEthernet)
+	assert.False(t, ok)
+}
+
+func TestCompileFilter(t *testing.T) {
+	packet := udpPacket(t, "10.0.0.1", "10.0.0.2", 1234, 53)
+	info, ok := decodePacketInfo(packet, layers.LinkTypeEthernet)
+	require.True(t, ok)
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{filter: "", want: true},
+		{filter: "host 10.0.0.1", want: true},
+		{filter: "src host 10.0.0.2", want: false},
+		{filter: "dst host 10.0.0.2", want: true},
+		{filter: "net 10.0.0.0/24", want: true},
+		{filter: "port 53", want: true},
+		{filter: "src port 53", want: false},
+		{filter: "tcp", want: false},
+		{filter: "udp and ip", want: true},
+		{filter: "ip6 or icmp", want: false},
+		{filter: "((host 10.0.0.3 and port 80) or (port 53)) and not ((host 10.0.0.1 and port 1234))", want: false},
+		{filter: "((host 10.0.0.3 and port 80) or (port 53)) and not ((host 10.0.0.9))", want: true},
+		{filter: "(host 10.0.0.5) or (host 10.0.0.6 or host 10.0.0.2)", want: true},
+		{filter: "!tcp && (port 1 || port 1234)", want: true},
+		{filter: "portrange 50-60", want: true},
+		{filter: "src portrange 50-60", want: false},
+		{filter: "udp and (port 80 or portrange 1000-2000)", want: true},
+		{filter: "sctp or icmp or icmp6", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			filter, err := compileFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter == nil || filter.match(&info))
+		})
+	}
+}
+
+func TestCompileFilterInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"host",
+		"host 10.0.0",
+		"net 10.0.0.1",
+		"port 70000",
+		"portrange 60-50",
+		"portrange 50",
+		"(port 53",
+		"port 53)",
+		"port 53 and",
+		"vlan 100",
+		"-i eth0 port 53",
+	} {
+		_, err := compileFilter(expr)
+		assert.Error(t, err, expr)
+	}
+}
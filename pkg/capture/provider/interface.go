@@ -5,16 +5,28 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/microsoft/retina/pkg/capture/file"
 )
 
+// RingBuffer configures CaptureNetworkPacket to record network packets continuously into rotating files, and to keep
+// the files written in the last Window when the trigger fires.
+type RingBuffer struct {
+	FileCount  int
+	FileSizeMB int
+	Window     time.Duration
+	// Trigger receives the reason of the trigger that fires the snapshot.
+	Trigger <-chan string
+}
+
 //go:generate go run go.uber.org/mock/mockgen@v0.4.0 -source=interface.go -destination=mock_network_capture.go -package=provider Interface
 type NetworkCaptureProviderInterface interface {
 	// Setup prepares the provider with folder to store network capture for temporary.
 	Setup(filename file.CaptureFilename) (string, error)
 	// CaptureNetworkPacket capture network traffic per user input and store the captured network packets in local directory.
-	CaptureNetworkPacket(ctx context.Context, filter string, duration, maxSize int) error
+	// When ringBuffer is not nil, it returns ErrNotTriggered if the capture stops before the trigger fires.
+	CaptureNetworkPacket(ctx context.Context, filter string, duration, maxSize int, ringBuffer *RingBuffer) error
 	// CollectMetadata collects network metadata and store network metadata info in local directory.
 	CollectMetadata() error
 	// Cleanup removes created resources.
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -55,9 +56,13 @@ func (ncp *NetworkCaptureProvider) Setup(filename file.CaptureFilename) (string,
 	return ncp.TmpCaptureDir, nil
 }
 
-func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, filter string, duration, maxSizeMB int) error {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(duration)*time.Second)
-	defer cancel()
+func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, filter string, duration, maxSizeMB int, ringBuffer *RingBuffer) error {
+	// A ring buffer capture without duration runs until the trigger fires or the capture is stopped.
+	if ringBuffer == nil || duration != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(duration)*time.Second)
+		defer cancel()
+	}
 
 	filename := file.CaptureFilename{CaptureName: ncp.CaptureName, NodeHostname: ncp.NodeHostName, StartTimestamp: ncp.StartTimestamp}
 	captureFileName := fmt.Sprintf("%s.pcap", filename)
@@ -66,6 +71,14 @@ func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, fil
 	// Remove the folder in case it already exists to mislead the file size check.
 	os.Remove(captureFilePath) //nolint:errcheck
 
+	ringDir := filepath.Join(ncp.TmpCaptureDir, ringBufferDirName)
+	if ringBuffer != nil {
+		if err := os.MkdirAll(ringDir, 0o750); err != nil {
+			return fmt.Errorf("failed to create ring buffer folder: %w", err)
+		}
+		captureFilePath = filepath.Join(ringDir, captureFileName)
+	}
+
 	// NOTE(mainred): The tcpdump release of debian:bullseye image, which is for preparing clang and tools, runs as
 	// tcpdump user by default for savefiles for output, but when the binary and library are copied to the distroless
 	// base image, we lost tcpdump user, and the following error will be raised when running tcpdump in our capture pod.
@@ -78,6 +91,15 @@ func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, fil
 		"--relinquish-privileges=root",
 	)
 
+	// Tcpdump rotates the capture file when it reaches FileSizeMB, and overwrites the first file after FileCount files.
+	if ringBuffer != nil {
+		captureStartCmd.Args = append(
+			captureStartCmd.Args,
+			"-C", strconv.Itoa(ringBuffer.FileSizeMB),
+			"-W", strconv.Itoa(ringBuffer.FileCount),
+		)
+	}
+
 	if packetSize := os.Getenv(captureConstants.PacketSizeEnvKey); len(packetSize) != 0 {
 		captureStartCmd.Args = append(
 			captureStartCmd.Args,
@@ -122,6 +144,10 @@ func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, fil
 		return err
 	}
 
+	if ringBuffer != nil {
+		return ncp.waitForTrigger(ctx, captureStartCmd, ringBuffer, ringDir, captureFileName)
+	}
+
 	doneChan := make(chan bool)
 	errChan := make(chan error)
 
@@ -176,6 +202,42 @@ func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, fil
 	case err := <-errChan:
 		return err
 	}
+	return ncp.stopTcpdump(captureStartCmd)
+}
+
+// waitForTrigger keeps tcpdump recording into the ring buffer until the trigger fires, then keeps the capture files
+// written in the window before the trigger.
+func (ncp *NetworkCaptureProvider) waitForTrigger(ctx context.Context, captureStartCmd *exec.Cmd, ringBuffer *RingBuffer, ringDir, captureFileName string) error {
+	ncp.l.Info("Tcpdump records into the ring buffer until a trigger fires",
+		zap.Int("file count", ringBuffer.FileCount), zap.Int("file size MB", ringBuffer.FileSizeMB), zap.Duration("window", ringBuffer.Window))
+
+	var reason string
+	triggered := false
+	select {
+	case reason = <-ringBuffer.Trigger:
+		triggered = true
+	case <-ctx.Done():
+		ncp.l.Info("Tcpdump will be stopped - got OS signal, or timeout reached", zap.Error(ctx.Err()))
+	}
+	triggerTime := time.Now()
+
+	if err := ncp.stopTcpdump(captureStartCmd); err != nil {
+		return err
+	}
+	if !triggered {
+		return ErrNotTriggered
+	}
+
+	ncp.l.Info("Trigger fired, keeping the ring buffer files of the window", zap.String("reason", reason))
+	kept, err := snapshotRingBuffer(ringDir, ncp.TmpCaptureDir, captureFileName, triggerTime.Add(-ringBuffer.Window))
+	if err != nil {
+		return err
+	}
+	ncp.l.Info("Kept ring buffer files", zap.Strings("files", kept))
+	return nil
+}
+
+func (ncp *NetworkCaptureProvider) stopTcpdump(captureStartCmd *exec.Cmd) error {
 	ncp.l.Info("Stop tcpdump")
 	// Kill signal will not wait until the process has actually existed, thus the captured network packets may not be
 	// flushed to the capture file. Instead, we signal terminate and wait until the process to exit.
@@ -186,7 +248,7 @@ func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, fil
 		}
 		return err
 	}
-	if _, err = captureStartCmd.Process.Wait(); err != nil {
+	if _, err := captureStartCmd.Process.Wait(); err != nil {
 		ncp.l.Error("Failed to wait for the process to exit", zap.Error(err))
 		return err
 	}
@@ -7,6 +7,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -37,6 +38,8 @@ type NetworkCaptureProvider struct {
 
 var _ NetworkCaptureProviderInterface = &NetworkCaptureProvider{}
 
+var errRingBufferNotSupported = errors.New("ring buffer capture is not supported on Windows")
+
 func NewNetworkCaptureProvider(logger *log.ZapLogger) NetworkCaptureProviderInterface {
 	return &NetworkCaptureProvider{
 		NetworkCaptureProviderCommon: NetworkCaptureProviderCommon{l: logger},
@@ -60,7 +63,11 @@ func (ncp *NetworkCaptureProvider) Setup(filename file.CaptureFilename) (string,
 	return ncp.TmpCaptureDir, nil
 }
 
-func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, filter string, duration, maxSizeMB int) error {
+func (ncp *NetworkCaptureProvider) CaptureNetworkPacket(ctx context.Context, filter string, duration, maxSizeMB int, ringBuffer *RingBuffer) error {
+	if ringBuffer != nil {
+		return errRingBufferNotSupported
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(duration))
 	defer cancel()
 
@@ -0,0 +1,66 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// ringBufferDirName is the folder in the temporary capture folder where the rotating capture files are written.
+const ringBufferDirName = "ring-buffer"
+
+// ErrNotTriggered is returned by CaptureNetworkPacket in ring buffer mode when the capture stops before the trigger
+// fires, and there is nothing to output.
+var ErrNotTriggered = errors.New("ring buffer capture stopped before a trigger fired")
+
+// snapshotRingBuffer moves the rotating capture files of ringDir modified since the given time into dstDir, named
+// after the capture file with their order, and removes ringDir.
+func snapshotRingBuffer(ringDir, dstDir, captureFileName string, since time.Time) ([]string, error) {
+	entries, err := os.ReadDir(ringDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ring buffer folder: %w", err)
+	}
+
+	type ringFile struct {
+		path    string
+		modTime time.Time
+	}
+	files := []ringFile{}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ring buffer file info: %w", err)
+		}
+		// A file modified in the window contains at least the packets captured in the window.
+		if info.ModTime().Before(since) {
+			continue
+		}
+		files = append(files, ringFile{path: filepath.Join(ringDir, entry.Name()), modTime: info.ModTime()})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+
+	ext := filepath.Ext(captureFileName)
+	base := captureFileName[:len(captureFileName)-len(ext)]
+	kept := make([]string, 0, len(files))
+	for i, f := range files {
+		dst := filepath.Join(dstDir, fmt.Sprintf("%s-%03d%s", base, i, ext))
+		if err := os.Rename(f.path, dst); err != nil {
+			return nil, fmt.Errorf("failed to move ring buffer file: %w", err)
+		}
+		kept = append(kept, dst)
+	}
+
+	if err := os.RemoveAll(ringDir); err != nil {
+		return nil, fmt.Errorf("failed to remove ring buffer folder: %w", err)
+	}
+	return kept, nil
+}
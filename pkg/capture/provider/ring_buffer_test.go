@@ -0,0 +1,48 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRingBuffer(t *testing.T) {
+	dstDir := t.TempDir()
+	ringDir := filepath.Join(dstDir, ringBufferDirName)
+	require.NoError(t, os.MkdirAll(ringDir, 0o750))
+
+	now := time.Now()
+	// tcpdump reuses the first file after the last one, so the file names are not in the order of the capture.
+	files := map[string]time.Time{
+		"capture.pcap0": now.Add(-time.Minute),
+		"capture.pcap1": now.Add(-30 * time.Minute),
+		"capture.pcap2": now.Add(-10 * time.Minute),
+		"capture.pcap3": now.Add(-3 * time.Minute),
+	}
+	for name, modTime := range files {
+		path := filepath.Join(ringDir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	kept, err := snapshotRingBuffer(ringDir, dstDir, "capture.pcap", now.Add(-5*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dstDir, "capture-000.pcap"), filepath.Join(dstDir, "capture-001.pcap")}, kept)
+
+	content, err := os.ReadFile(kept[0])
+	require.NoError(t, err)
+	assert.Equal(t, "capture.pcap3", string(content))
+	content, err = os.ReadFile(kept[1])
+	require.NoError(t, err)
+	assert.Equal(t, "capture.pcap0", string(content))
+
+	_, err = os.Stat(ringDir)
+	assert.True(t, os.IsNotExist(err), "the ring buffer folder should be removed")
+}
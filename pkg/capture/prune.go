@@ -0,0 +1,98 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.uber.org/zap"
+
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	"github.com/microsoft/retina/pkg/capture/file"
+	"github.com/microsoft/retina/pkg/capture/outputlocation"
+)
+
+// isCaptureFileOfRuns returns whether the file is a capture file of the Capture started at one of the timestamps.
+func isCaptureFileOfRuns(captureName, fileName string, timestamps map[string]struct{}) bool {
+	captureFilename, err := file.ParseCaptureFilename(captureName, fileName)
+	if err != nil {
+		return false
+	}
+	_, ok := timestamps[captureFilename.StartTimestamp.String()]
+	return ok
+}
+
+func timestampSet(timestamps []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(timestamps))
+	for _, timestamp := range timestamps {
+		set[timestamp] = struct{}{}
+	}
+	return set
+}
+
+// PruneHostPath removes the capture files of the Capture started at the timestamps from the directory.
+func PruneHostPath(dir, captureName string, timestamps []string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read capture files: %w", err)
+	}
+
+	runs := timestampSet(timestamps)
+	removed := []string{}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !isCaptureFileOfRuns(captureName, entry.Name(), runs) {
+			continue
+		}
+		filePath := filepath.Join(dir, entry.Name())
+		if err := os.Remove(filePath); err != nil {
+			return removed, fmt.Errorf("failed to remove capture file: %w", err)
+		}
+		removed = append(removed, filePath)
+	}
+	return removed, nil
+}
+
+// PruneS3 removes the capture files of the Capture started at the timestamps from the path of the S3 bucket.
+func PruneS3(ctx context.Context, s3Client *s3.Client, bucket, s3Path, captureName string, timestamps []string) ([]string, error) {
+	files, err := outputlocation.ListS3Files(ctx, s3Client, bucket, s3Path)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is wrapped by outputlocation
+	}
+
+	runs := timestampSet(timestamps)
+	removed := []string{}
+	for _, f := range files {
+		if !isCaptureFileOfRuns(captureName, path.Base(f.Key), runs) {
+			continue
+		}
+		if err := outputlocation.DeleteS3File(ctx, s3Client, bucket, f.Key); err != nil {
+			return removed, err //nolint:wrapcheck // the error is wrapped by outputlocation
+		}
+		removed = append(removed, f.Key)
+	}
+	return removed, nil
+}
+
+// PruneOutput removes the capture files of the pruned runs of a scheduled Capture from the HostPath of the node.
+func (cm *CaptureManager) PruneOutput() error {
+	hostPath := os.Getenv(string(captureConstants.CaptureOutputLocationEnvKeyHostPath))
+	if len(hostPath) == 0 {
+		return fmt.Errorf("no host path to prune")
+	}
+	timestamps := strings.Split(os.Getenv(captureConstants.CapturePruneTimestampsEnvKey), ",")
+	removed, err := PruneHostPath(hostPath, cm.captureName(), timestamps)
+	cm.l.Info("Removed capture files", zap.String("capture name", cm.captureName()), zap.Strings("files", removed))
+	return err
+}
+
+// PruneMode returns whether the capture workload prunes the capture files of a scheduled Capture.
+func PruneMode() bool {
+	return len(os.Getenv(captureConstants.CapturePruneTimestampsEnvKey)) != 0
+}
@@ -0,0 +1,127 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/microsoft/retina/pkg/capture/outputlocation"
+)
+
+func TestPruneHostPath(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{
+		"cap-node1-20240101000000UTC.tar.gz",
+		"cap-ip-10-0-0-1.ec2.internal-20240101000000UTC.tar.gz",
+		"cap-node1-20240101010000UTC.tar.gz",
+		"cap-node1-20240101020000UTC.tar.gz",
+		"other-node1-20240101000000UTC.tar.gz",
+		"cap-node1.txt",
+	}
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(dir, f), []byte(f), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, err := PruneHostPath(dir, "cap", []string{"20240101000000UTC", "20240101010000UTC"})
+	if err != nil {
+		t.Fatalf("PruneHostPath should have not fail with error %s", err)
+	}
+	wantRemoved := []string{
+		filepath.Join(dir, "cap-ip-10-0-0-1.ec2.internal-20240101000000UTC.tar.gz"),
+		filepath.Join(dir, "cap-node1-20240101000000UTC.tar.gz"),
+		filepath.Join(dir, "cap-node1-20240101010000UTC.tar.gz"),
+	}
+	if diff := cmp.Diff(wantRemoved, removed); diff != "" {
+		t.Errorf("PruneHostPath() removed mismatch (-want, +got):\n%s", diff)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kept := []string{}
+	for _, entry := range entries {
+		kept = append(kept, entry.Name())
+	}
+	wantKept := []string{"cap-node1-20240101020000UTC.tar.gz", "cap-node1.txt", "other-node1-20240101000000UTC.tar.gz"}
+	if diff := cmp.Diff(wantKept, kept); diff != "" {
+		t.Errorf("PruneHostPath() kept mismatch (-want, +got):\n%s", diff)
+	}
+
+	if _, err := PruneHostPath(filepath.Join(dir, "missing"), "cap", nil); err == nil {
+		t.Errorf("PruneHostPath should fail for a missing folder")
+	}
+}
+
+func TestPruneS3(t *testing.T) {
+	var mu sync.Mutex
+	objects := map[string]bool{
+		"retina/captures/tmp/cap-node1-20240101000000UTC.tar.gz":   true,
+		"retina/captures/tmp/cap-node2-20240101000000UTC.tar.gz":   true,
+		"retina/captures/tmp/cap-node1-20240101010000UTC.tar.gz":   true,
+		"retina/captures/tmp/other-node1-20240101000000UTC.tar.gz": true,
+		"elsewhere/cap-node1-20240101000000UTC.tar.gz":             true,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/bucket"), "/")
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			prefix := r.URL.Query().Get("prefix")
+			var b strings.Builder
+			b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`)
+			for k := range objects {
+				if strings.HasPrefix(k, prefix) {
+					fmt.Fprintf(&b, "<Contents><Key>%s</Key></Contents>", k)
+				}
+			}
+			b.WriteString("</ListBucketResult>")
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = io.WriteString(w, b.String())
+		case r.Method == http.MethodDelete && objects[key]:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	s3Client, err := outputlocation.NewS3Client(ctx, srv.URL, "", "akid", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := PruneS3(ctx, s3Client, "bucket", "retina/captures", "cap", []string{"20240101000000UTC"})
+	if err != nil {
+		t.Fatalf("PruneS3 should have not fail with error %s", err)
+	}
+	sort.Strings(removed)
+	wantRemoved := []string{
+		"retina/captures/tmp/cap-node1-20240101000000UTC.tar.gz",
+		"retina/captures/tmp/cap-node2-20240101000000UTC.tar.gz",
+	}
+	if diff := cmp.Diff(wantRemoved, removed); diff != "" {
+		t.Errorf("PruneS3() removed mismatch (-want, +got):\n%s", diff)
+	}
+	if len(objects) != 3 {
+		t.Errorf("PruneS3() left %d objects, want 3", len(objects))
+	}
+}
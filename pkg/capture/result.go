@@ -0,0 +1,217 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/google/gopacket/pcapgo"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+)
+
+// maxResultErrorLength keeps the result of the capture within the 4096 bytes limit of termination messages.
+const maxResultErrorLength = 1024
+
+// WriteResult writes the result of the capture on the node as the termination message of the capture container, so
+// that the Capture controller and the CLI can report it. captureErr is the error failing the capture, if any.
+func (cm *CaptureManager) WriteResult(captureErr error) error {
+	resultPath := captureConstants.CaptureResultPath
+	if runtime.GOOS == "windows" {
+		containerSandboxMountPoint := os.Getenv(captureConstants.ContainerSandboxMountPointEnvKey)
+		if len(containerSandboxMountPoint) == 0 {
+			return fmt.Errorf("failed to find sandbox mount path through env %s", captureConstants.ContainerSandboxMountPointEnvKey)
+		}
+		resultPath = filepath.Join(containerSandboxMountPoint, resultPath)
+	}
+	return writeResult(resultPath, cm.result, cm.captureNodeHostName(), captureErr)
+}
+
+func writeResult(path string, result retinav1alpha1.CaptureNodeStatus, nodeName string, captureErr error) error {
+	result.NodeName = nodeName
+	result.Phase = retinav1alpha1.CaptureNodeSucceeded
+	if captureErr != nil {
+		result.Phase = retinav1alpha1.CaptureNodeFailed
+		result.Error = truncateResultError(captureErr.Error())
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal capture result: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write capture result to %s: %w", path, err)
+	}
+	return nil
+}
+
+func truncateResultError(message string) string {
+	message = strings.TrimSpace(message)
+	if len(message) > maxResultErrorLength {
+		message = message[:maxResultErrorLength] + "..."
+	}
+	return message
+}
+
+// countCapturedPackets returns the number of packets and bytes in the pcap and pcapng files of the capture directory.
+// Other files, like the network metadata, are skipped.
+func countCapturedPackets(dir string) (packets, bytes int64) {
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return nil
+		}
+		p, b := countPacketsInFile(path)
+		packets += p
+		bytes += b
+		return nil
+	})
+	return packets, bytes
+}
+
+func countPacketsInFile(path string) (packets, bytes int64) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0
+	}
+	defer f.Close()
+
+	var readPacket func() (int, error)
+	if r, err := pcapgo.NewReader(f); err == nil {
+		readPacket = func() (int, error) {
+			_, ci, err := r.ReadPacketData()
+			return ci.CaptureLength, err
+		}
+	} else {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return 0, 0
+		}
+		r, err := pcapgo.NewNgReader(f, pcapgo.DefaultNgReaderOptions)
+		if err != nil {
+			return 0, 0
+		}
+		readPacket = func() (int, error) {
+			_, ci, err := r.ReadPacketData()
+			return ci.CaptureLength, err
+		}
+	}
+
+	// The last packet can be truncated when the capture is stopped, which ends the count as well.
+	for {
+		length, err := readPacket()
+		if err != nil {
+			return packets, bytes
+		}
+		packets++
+		bytes += int64(length)
+	}
+}
+
+// NodeStatusesFromJobs returns the results of the capture on the nodes of the capture jobs, sorted by node name.
+// The results are read from the termination messages of the capture containers of the pods.
+func NodeStatusesFromJobs(jobs []batchv1.Job, pods []corev1.Pod) []retinav1alpha1.CaptureNodeStatus {
+	statuses := make([]retinav1alpha1.CaptureNodeStatus, 0, len(jobs))
+	for i := range jobs {
+		job := &jobs[i]
+		var jobPod *corev1.Pod
+		for j := range pods {
+			pod := &pods[j]
+			if !metav1.IsControlledBy(pod, job) {
+				continue
+			}
+			if jobPod == nil || pod.CreationTimestamp.After(jobPod.CreationTimestamp.Time) {
+				jobPod = pod
+			}
+		}
+		statuses = append(statuses, NodeStatusFromJob(job, jobPod))
+	}
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].NodeName < statuses[j].NodeName
+	})
+	return statuses
+}
+
+// NodeStatusFromJob returns the result of the capture on the node of the capture job, whose pod can be nil when it
+// is not created yet or has been removed.
+func NodeStatusFromJob(job *batchv1.Job, pod *corev1.Pod) retinav1alpha1.CaptureNodeStatus {
+	status := retinav1alpha1.CaptureNodeStatus{}
+	if terminated := captureContainerTerminatedState(pod); terminated != nil && terminated.Message != "" {
+		if err := json.Unmarshal([]byte(terminated.Message), &status); err != nil {
+			// The termination message is the last logs of the capture container when it failed without a result.
+			status = retinav1alpha1.CaptureNodeStatus{Error: truncateResultError(terminated.Message)}
+		}
+	}
+
+	status.JobName = job.Name
+	if nodeName := jobNodeName(job); nodeName != "" {
+		status.NodeName = nodeName
+	}
+
+	completed, failed, failedMessage := false, false, ""
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		if c.Type == batchv1.JobComplete {
+			completed = true
+		}
+		if c.Type == batchv1.JobFailed {
+			failed, failedMessage = true, c.Message
+		}
+	}
+	switch {
+	case failed:
+		status.Phase = retinav1alpha1.CaptureNodeFailed
+		if status.Error != "" {
+			break
+		}
+		if terminated := captureContainerTerminatedState(pod); terminated != nil && terminated.Reason != "" {
+			status.Error = fmt.Sprintf("capture container terminated with reason %s and exit code %d", terminated.Reason, terminated.ExitCode)
+		} else {
+			status.Error = failedMessage
+		}
+	case completed:
+		status.Phase = retinav1alpha1.CaptureNodeSucceeded
+	case captureContainerTerminatedState(pod) != nil && status.Phase != "":
+		// The job is not updated yet, and the phase reported by the capture workload is kept.
+	case pod != nil && pod.Status.Phase != corev1.PodPending:
+		status.Phase = retinav1alpha1.CaptureNodeRunning
+	default:
+		status.Phase = retinav1alpha1.CaptureNodePending
+	}
+	return status
+}
+
+func captureContainerTerminatedState(pod *corev1.Pod) *corev1.ContainerStateTerminated {
+	if pod == nil {
+		return nil
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == captureConstants.CaptureContainername {
+			return cs.State.Terminated
+		}
+	}
+	return nil
+}
+
+func jobNodeName(job *batchv1.Job) string {
+	for _, c := range job.Spec.Template.Spec.Containers {
+		for _, env := range c.Env {
+			if env.Name == captureConstants.NodeHostNameEnvKey {
+				return env.Value
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,238 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+)
+
+func TestWriteResult(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "termination-log")
+	result := retinav1alpha1.CaptureNodeStatus{
+		PacketsCaptured: 10,
+		BytesCaptured:   1000,
+		Artifacts:       []retinav1alpha1.CaptureArtifact{{Location: "HostPath", URI: "/mnt/capture/cap.tar.gz"}},
+	}
+	require.NoError(t, writeResult(path, result, "node1", nil))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	got := retinav1alpha1.CaptureNodeStatus{}
+	require.NoError(t, json.Unmarshal(data, &got))
+	result.NodeName = "node1"
+	result.Phase = retinav1alpha1.CaptureNodeSucceeded
+	assert.Equal(t, result, got)
+
+	// The error is truncated to fit in the termination message.
+	require.NoError(t, writeResult(path, result, "node1", errors.New(strings.Repeat("x", 5000))))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Less(t, len(data), 4096)
+	got = retinav1alpha1.CaptureNodeStatus{}
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, retinav1alpha1.CaptureNodeFailed, got.Phase)
+	assert.Len(t, got.Error, maxResultErrorLength+3)
+}
+
+func TestCountCapturedPackets(t *testing.T) {
+	dir := t.TempDir()
+	ci := func(length int) gopacket.CaptureInfo {
+		return gopacket.CaptureInfo{Timestamp: time.Now(), CaptureLength: length, Length: length}
+	}
+
+	pcapFile, err := os.Create(filepath.Join(dir, "cap.pcap"))
+	require.NoError(t, err)
+	pcapWriter := pcapgo.NewWriter(pcapFile)
+	require.NoError(t, pcapWriter.WriteFileHeader(65535, layers.LinkTypeEthernet))
+	require.NoError(t, pcapWriter.WritePacket(ci(60), make([]byte, 60)))
+	require.NoError(t, pcapWriter.WritePacket(ci(100), make([]byte, 100)))
+	require.NoError(t, pcapFile.Close())
+
+	ngFile, err := os.Create(filepath.Join(dir, "cap.pcapng"))
+	require.NoError(t, err)
+	ngWriter, err := pcapgo.NewNgWriter(ngFile, layers.LinkTypeEthernet)
+	require.NoError(t, err)
+	require.NoError(t, ngWriter.WritePacket(ci(40), make([]byte, 40)))
+	require.NoError(t, ngWriter.Flush())
+	require.NoError(t, ngFile.Close())
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "metadata"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "metadata", "ip-resources.txt"), []byte("1: lo: <LOOPBACK,UP,LOWER_UP>"), 0o600))
+
+	packets, bytes := countCapturedPackets(dir)
+	assert.Equal(t, int64(3), packets)
+	assert.Equal(t, int64(200), bytes)
+
+	packets, bytes = countCapturedPackets(filepath.Join(dir, "not-exist"))
+	assert.Zero(t, packets)
+	assert.Zero(t, bytes)
+}
+
+func captureJob(name, nodeName string, finished batchv1.JobConditionType, message string) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "capture", UID: types.UID(name)},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: captureConstants.CaptureContainername,
+						Env:  []corev1.EnvVar{{Name: captureConstants.NodeHostNameEnvKey, Value: nodeName}},
+					}},
+				},
+			},
+		},
+	}
+	if finished != "" {
+		job.Status.Conditions = []batchv1.JobCondition{{Type: finished, Status: corev1.ConditionTrue, Message: message}}
+	}
+	return job
+}
+
+func capturePod(job *batchv1.Job, phase corev1.PodPhase, terminated *corev1.ContainerStateTerminated) *corev1.Pod {
+	isController := true
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            job.Name + "-pod",
+			Namespace:       job.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Job", Name: job.Name, UID: job.UID, Controller: &isController}},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  captureConstants.CaptureContainername,
+				State: corev1.ContainerState{Terminated: terminated},
+			}},
+		},
+	}
+}
+
+func TestNodeStatusFromJob(t *testing.T) {
+	result := `{"nodeName":"node1","phase":"Succeeded","bytesCaptured":1000,"packetsCaptured":10,` +
+		`"artifacts":[{"location":"S3Upload","uri":"s3://bucket/retina/captures/cap.tar.gz"}]}`
+	failedResult := `{"nodeName":"node1","phase":"Failed","error":"location \"S3Upload\" output error: access denied"}`
+
+	tests := []struct {
+		name       string
+		job        *batchv1.Job
+		phase      corev1.PodPhase
+		terminated *corev1.ContainerStateTerminated
+		noPod      bool
+		want       retinav1alpha1.CaptureNodeStatus
+	}{
+		{
+			name:  "pod is not created",
+			job:   captureJob("job1", "node1", "", ""),
+			noPod: true,
+			want:  retinav1alpha1.CaptureNodeStatus{NodeName: "node1", JobName: "job1", Phase: retinav1alpha1.CaptureNodePending},
+		},
+		{
+			name:  "pod is running",
+			job:   captureJob("job1", "node1", "", ""),
+			phase: corev1.PodRunning,
+			want:  retinav1alpha1.CaptureNodeStatus{NodeName: "node1", JobName: "job1", Phase: retinav1alpha1.CaptureNodeRunning},
+		},
+		{
+			name:       "job completed with a result",
+			job:        captureJob("job1", "node1", batchv1.JobComplete, ""),
+			phase:      corev1.PodSucceeded,
+			terminated: &corev1.ContainerStateTerminated{Reason: "Completed", Message: result},
+			want: retinav1alpha1.CaptureNodeStatus{
+				NodeName:        "node1",
+				JobName:         "job1",
+				Phase:           retinav1alpha1.CaptureNodeSucceeded,
+				BytesCaptured:   1000,
+				PacketsCaptured: 10,
+				Artifacts:       []retinav1alpha1.CaptureArtifact{{Location: "S3Upload", URI: "s3://bucket/retina/captures/cap.tar.gz"}},
+			},
+		},
+		{
+			name:       "pod failed before the job is updated",
+			job:        captureJob("job1", "node1", "", ""),
+			phase:      corev1.PodFailed,
+			terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1, Message: failedResult},
+			want: retinav1alpha1.CaptureNodeStatus{
+				NodeName: "node1",
+				JobName:  "job1",
+				Phase:    retinav1alpha1.CaptureNodeFailed,
+				Error:    `location "S3Upload" output error: access denied`,
+			},
+		},
+		{
+			name:       "job failed with the logs of the pod",
+			job:        captureJob("job1", "node1", batchv1.JobFailed, "Job has reached the specified backoff limit"),
+			phase:      corev1.PodFailed,
+			terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 2, Message: "panic: runtime error\n"},
+			want:       retinav1alpha1.CaptureNodeStatus{NodeName: "node1", JobName: "job1", Phase: retinav1alpha1.CaptureNodeFailed, Error: "panic: runtime error"},
+		},
+		{
+			name:       "job failed without a termination message",
+			job:        captureJob("job1", "node1", batchv1.JobFailed, "Job has reached the specified backoff limit"),
+			phase:      corev1.PodFailed,
+			terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+			want: retinav1alpha1.CaptureNodeStatus{
+				NodeName: "node1",
+				JobName:  "job1",
+				Phase:    retinav1alpha1.CaptureNodeFailed,
+				Error:    "capture container terminated with reason OOMKilled and exit code 137",
+			},
+		},
+		{
+			name:  "job failed without pod",
+			job:   captureJob("job1", "node1", batchv1.JobFailed, "Job was active longer than specified deadline"),
+			noPod: true,
+			want: retinav1alpha1.CaptureNodeStatus{
+				NodeName: "node1",
+				JobName:  "job1",
+				Phase:    retinav1alpha1.CaptureNodeFailed,
+				Error:    "Job was active longer than specified deadline",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pod *corev1.Pod
+			if !tt.noPod {
+				pod = capturePod(tt.job, tt.phase, tt.terminated)
+			}
+			assert.Equal(t, tt.want, NodeStatusFromJob(tt.job, pod))
+		})
+	}
+}
+
+func TestNodeStatusesFromJobs(t *testing.T) {
+	job1 := captureJob("job1", "node2", "", "")
+	job2 := captureJob("job2", "node1", batchv1.JobComplete, "")
+	otherJob := captureJob("job3", "node3", "", "")
+	pods := []corev1.Pod{
+		*capturePod(job1, corev1.PodRunning, nil),
+		*capturePod(job2, corev1.PodSucceeded, &corev1.ContainerStateTerminated{Message: `{"packetsCaptured":5}`}),
+		*capturePod(otherJob, corev1.PodRunning, nil),
+	}
+
+	statuses := NodeStatusesFromJobs([]batchv1.Job{*job1, *job2}, pods)
+	assert.Equal(t, []retinav1alpha1.CaptureNodeStatus{
+		{NodeName: "node1", JobName: "job2", Phase: retinav1alpha1.CaptureNodeSucceeded, PacketsCaptured: 5},
+		{NodeName: "node2", JobName: "job1", Phase: retinav1alpha1.CaptureNodeRunning},
+	}, statuses)
+}
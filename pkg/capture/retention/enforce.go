@@ -0,0 +1,125 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/microsoft/retina/pkg/capture/file"
+	"github.com/microsoft/retina/pkg/capture/outputlocation"
+)
+
+// Names of the output locations the retention policies are enforced on, as named by the capture workload.
+const (
+	LocationHostPath   = "HostPath"
+	LocationS3Upload   = "S3Upload"
+	LocationBlobUpload = "BlobUpload"
+)
+
+// EnforceHostPath removes the capture files of the Capture exceeding the policy from the directory, or the capture
+// files of any Capture when the Capture name is empty, and returns the removed files.
+func EnforceHostPath(dir, captureName string, policy Policy, now time.Time) ([]Expired, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read capture files: %w", err)
+	}
+
+	files := []File{}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		f := File{Name: filepath.Join(dir, entry.Name())}
+		if captureName == "" {
+			timestamp, err := file.ParseCaptureFileTimestamp(entry.Name())
+			if err != nil {
+				continue
+			}
+			f.Time = timestamp.Time
+		} else {
+			captureFilename, err := file.ParseCaptureFilename(captureName, entry.Name())
+			if err != nil {
+				continue
+			}
+			f.Node, f.Time = captureFilename.NodeHostname, captureFilename.StartTimestamp.Time
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get capture file info: %w", err)
+		}
+		f.Size = info.Size()
+		files = append(files, f)
+	}
+
+	removed := []Expired{}
+	for _, e := range policy.Select(files, now) {
+		if err := os.Remove(e.Name); err != nil && !os.IsNotExist(err) {
+			return removed, fmt.Errorf("failed to remove capture file: %w", err)
+		}
+		removed = append(removed, e)
+	}
+	return removed, nil
+}
+
+// EnforceS3 removes the capture files of the Capture exceeding the policy from the path of the S3 bucket, and returns
+// the removed files.
+func EnforceS3(ctx context.Context, s3Client *s3.Client, bucket, s3Path, captureName string, policy Policy, now time.Time) ([]Expired, error) {
+	storedFiles, err := outputlocation.ListS3Files(ctx, s3Client, bucket, s3Path)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is wrapped by outputlocation
+	}
+
+	removed := []Expired{}
+	for _, e := range policy.Select(captureFiles(captureName, storedFiles), now) {
+		if err := outputlocation.DeleteS3File(ctx, s3Client, bucket, e.Name); err != nil {
+			return removed, err //nolint:wrapcheck // the error is wrapped by outputlocation
+		}
+		removed = append(removed, e)
+	}
+	return removed, nil
+}
+
+// EnforceBlob removes the capture files of the Capture exceeding the policy from the blob container of the SAS URL,
+// and returns the removed files.
+func EnforceBlob(ctx context.Context, containerSASURL, captureName string, policy Policy, now time.Time) ([]Expired, error) {
+	// The capture files are uploaded to the root of the container.
+	storedFiles, err := outputlocation.ListBlobs(ctx, containerSASURL, captureName+"-")
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is wrapped by outputlocation
+	}
+
+	removed := []Expired{}
+	for _, e := range policy.Select(captureFiles(captureName, storedFiles), now) {
+		if err := outputlocation.DeleteBlob(ctx, containerSASURL, e.Name); err != nil {
+			return removed, err //nolint:wrapcheck // the error is wrapped by outputlocation
+		}
+		removed = append(removed, e)
+	}
+	return removed, nil
+}
+
+// captureFiles returns the stored files that are capture files of the Capture.
+func captureFiles(captureName string, storedFiles []outputlocation.StoredFile) []File {
+	files := []File{}
+	for _, f := range storedFiles {
+		captureFilename, err := file.ParseCaptureFilename(captureName, path.Base(f.Key))
+		if err != nil {
+			continue
+		}
+		files = append(files, File{
+			Name: f.Key,
+			Node: captureFilename.NodeHostname,
+			Size: f.Size,
+			Time: captureFilename.StartTimestamp.Time,
+		})
+	}
+	return files
+}
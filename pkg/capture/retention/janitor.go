@@ -0,0 +1,156 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+const (
+	defaultJanitorHostPath = "/mnt/retina/captures"
+	defaultJanitorInterval = 10 * time.Minute
+
+	// EventReasonCaptureFilesRemoved is the reason of the events reporting the capture files removed by a retention
+	// policy.
+	EventReasonCaptureFilesRemoved = "CaptureFilesRemoved"
+	// EventReasonRetentionFailed is the reason of the events reporting a retention policy failed to be enforced.
+	EventReasonRetentionFailed = "CaptureRetentionFailed"
+)
+
+// Janitor enforces the retention policies of the capture files in the capture host path of the node: the policy of
+// the agent config on all the capture files, and the policies of the Captures on their own capture files.
+type Janitor struct {
+	l        *log.ZapLogger
+	hostPath string
+	policy   Policy
+	interval time.Duration
+	nodeName string
+
+	reader   client.Reader
+	recorder record.EventRecorder
+	metrics  *Metrics
+
+	now func() time.Time
+}
+
+// NewJanitor returns a janitor of the capture host path of the node, listing the Captures with the reader.
+func NewJanitor(l *log.ZapLogger, cfg config.CaptureRetention, nodeName string, reader client.Reader, recorder record.EventRecorder, metrics *Metrics) *Janitor {
+	hostPath := cfg.HostPath
+	if hostPath == "" {
+		hostPath = defaultJanitorHostPath
+	}
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultJanitorInterval
+	}
+
+	return &Janitor{
+		l:        l,
+		hostPath: filepath.Clean(hostPath),
+		policy: Policy{
+			MaxAge:   cfg.MaxAge,
+			MaxBytes: cfg.MaxSizeMB * 1024 * 1024,
+			MaxCount: cfg.MaxCount,
+		},
+		interval: interval,
+		nodeName: nodeName,
+		reader:   reader,
+		recorder: recorder,
+		metrics:  metrics,
+		now:      time.Now,
+	}
+}
+
+// Start enforces the retention policies periodically until the context is done.
+func (j *Janitor) Start(ctx context.Context) {
+	j.l.Info("Starting capture janitor", zap.String("hostPath", j.hostPath), zap.Duration("interval", j.interval))
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		j.run(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *Janitor) run(ctx context.Context) {
+	now := j.now()
+
+	captureList := &retinav1alpha1.CaptureList{}
+	if err := j.reader.List(ctx, captureList); err != nil {
+		j.l.Error("Failed to list Captures", zap.Error(err))
+	} else {
+		for i := range captureList.Items {
+			j.enforceCapture(&captureList.Items[i], now)
+		}
+	}
+
+	if !j.policy.Enabled() {
+		return
+	}
+	removed, err := EnforceHostPath(j.hostPath, "", j.policy, now)
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	node := &corev1.ObjectReference{Kind: "Node", Name: j.nodeName, UID: types.UID(j.nodeName)}
+	j.report(node, removed, err)
+}
+
+// enforceCapture enforces the retention policy of the Capture on its capture files in the capture host path.
+func (j *Janitor) enforceCapture(capture *retinav1alpha1.Capture, now time.Time) {
+	policy := PolicyFromCapture(capture)
+	hostPath := capture.Spec.OutputConfiguration.HostPath
+	if !policy.Enabled() || hostPath == nil || !j.mounted(*hostPath) {
+		return
+	}
+
+	removed, err := EnforceHostPath(*hostPath, capture.Name, policy, now)
+	// The Capture didn't run on the node.
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	j.report(capture, removed, err)
+}
+
+// mounted returns whether the directory is the capture host path, or below it, and is mounted in the agent.
+func (j *Janitor) mounted(dir string) bool {
+	rel, err := filepath.Rel(j.hostPath, filepath.Clean(dir))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (j *Janitor) report(object runtime.Object, removed []Expired, err error) {
+	j.metrics.Observe(LocationHostPath, removed)
+	if len(removed) != 0 {
+		names := make([]string, 0, len(removed))
+		for _, e := range removed {
+			names = append(names, filepath.Base(e.Name))
+		}
+		j.l.Info("Removed capture files", zap.String("node", j.nodeName), zap.Strings("files", names))
+		j.recorder.Eventf(object, corev1.EventTypeNormal, EventReasonCaptureFilesRemoved, "Removed %d capture files from HostPath of node %s: %s", len(removed), j.nodeName, strings.Join(names, ", "))
+	}
+	if err != nil {
+		j.l.Error("Failed to enforce capture retention", zap.Error(err))
+		j.recorder.Eventf(object, corev1.EventTypeWarning, EventReasonRetentionFailed, "Failed to enforce the retention policy on HostPath of node %s: %s", j.nodeName, err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package retention
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+func TestJanitor(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	scheme := runtime.NewScheme()
+	require.NoError(t, retinav1alpha1.AddToScheme(scheme))
+
+	dir := t.TempDir()
+	captureDir := filepath.Join(dir, "cap")
+	require.NoError(t, os.Mkdir(captureDir, 0o700))
+	files := map[string]string{
+		filepath.Join(captureDir, "cap-node1-20240101000000UTC.tar.gz"): "0",
+		filepath.Join(captureDir, "cap-node1-20240101010000UTC.tar.gz"): "1",
+		filepath.Join(captureDir, "cap-node1-20240101020000UTC.tar.gz"): "2",
+		filepath.Join(dir, "other-node1-20231231000000UTC.tar.gz"):      "3",
+		filepath.Join(dir, "other-node1-20240101020000UTC.tar.gz"):      "4",
+		filepath.Join(dir, "notes.txt"):                                 "5",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+
+	capture := func(name, hostPath string) *retinav1alpha1.Capture {
+		return &retinav1alpha1.Capture{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "capture"},
+			Spec: retinav1alpha1.CaptureSpec{
+				OutputConfiguration: retinav1alpha1.OutputConfiguration{
+					HostPath:  ptr.To(hostPath),
+					Retention: &retinav1alpha1.RetentionPolicy{MaxCount: ptr.To(int32(1))},
+				},
+			},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		capture("cap", captureDir),
+		// The capture files of Captures outside of the capture host path are not mounted in the agent.
+		capture("outside", "/var/captures"),
+	).Build()
+
+	recorder := record.NewFakeRecorder(10)
+	registry := prometheus.NewRegistry()
+	cfg := config.CaptureRetention{Enabled: true, HostPath: dir, MaxAge: 24 * time.Hour}
+	janitor := NewJanitor(log.Logger().Named("test"), cfg, "node1", c, recorder, NewMetrics(registry))
+	janitor.now = func() time.Time { return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+	janitor.run(context.Background())
+
+	kept := []string{}
+	require.NoError(t, filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			kept = append(kept, path)
+		}
+		return err
+	}))
+	sort.Strings(kept)
+	wantKept := []string{
+		filepath.Join(captureDir, "cap-node1-20240101020000UTC.tar.gz"),
+		filepath.Join(dir, "notes.txt"),
+		filepath.Join(dir, "other-node1-20240101020000UTC.tar.gz"),
+	}
+	assert.Equal(t, wantKept, kept)
+
+	close(recorder.Events)
+	events := []string{}
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	require.Len(t, events, 2)
+	assert.True(t, strings.HasPrefix(events[0], "Normal CaptureFilesRemoved Removed 2 capture files from HostPath of node node1"), events[0])
+	assert.True(t, strings.HasPrefix(events[1], "Normal CaptureFilesRemoved Removed 1 capture files from HostPath of node node1"), events[1])
+
+	assert.InDelta(t, 2, testutil.ToFloat64(janitor.metrics.removedFiles.WithLabelValues(LocationHostPath, ReasonMaxCount)), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(janitor.metrics.removedFiles.WithLabelValues(LocationHostPath, ReasonMaxAge)), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(janitor.metrics.removedBytes.WithLabelValues(LocationHostPath, ReasonMaxAge)), 0)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package retention
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/utils"
+)
+
+const (
+	removedFilesCounterName        = "capture_retention_removed_files"
+	removedFilesCounterDescription = "Number of capture files removed by the retention policies"
+	removedBytesCounterName        = "capture_retention_removed_bytes"
+	removedBytesCounterDescription = "Total size of the capture files removed by the retention policies"
+
+	locationLabel = "location"
+)
+
+// Metrics counts the capture files removed by the retention policies.
+type Metrics struct {
+	removedFiles *prometheus.CounterVec
+	removedBytes *prometheus.CounterVec
+}
+
+// NewMetrics registers the retention metrics in the registry.
+func NewMetrics(r prometheus.Registerer) *Metrics {
+	return &Metrics{
+		removedFiles: exporter.CreatePrometheusCounterVecForControlPlaneMetric(r, removedFilesCounterName, removedFilesCounterDescription, locationLabel, utils.Reason),
+		removedBytes: exporter.CreatePrometheusCounterVecForControlPlaneMetric(r, removedBytesCounterName, removedBytesCounterDescription, locationLabel, utils.Reason),
+	}
+}
+
+// Observe counts the files removed from the output location.
+func (m *Metrics) Observe(location string, removed []Expired) {
+	for _, e := range removed {
+		m.removedFiles.WithLabelValues(location, e.Reason).Inc()
+		m.removedBytes.WithLabelValues(location, e.Reason).Add(float64(e.Size))
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+// Package retention removes the capture files exceeding the retention policies from the output locations.
+package retention
+
+import (
+	"sort"
+	"time"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+)
+
+// Reasons a capture file is removed.
+const (
+	ReasonMaxAge   = "max_age"
+	ReasonMaxSize  = "max_size"
+	ReasonMaxCount = "max_count"
+)
+
+// Policy limits the capture files kept for each node in an output location. Zero limits are not enforced.
+type Policy struct {
+	MaxAge   time.Duration
+	MaxBytes int64
+	MaxCount int
+}
+
+// PolicyFromCapture returns the retention policy of the Capture.
+func PolicyFromCapture(capture *retinav1alpha1.Capture) Policy {
+	retention := capture.Spec.OutputConfiguration.Retention
+	if retention == nil {
+		return Policy{}
+	}
+
+	var policy Policy
+	if retention.MaxAge != nil {
+		policy.MaxAge = retention.MaxAge.Duration
+	}
+	if retention.MaxSize != nil {
+		policy.MaxBytes = retention.MaxSize.Value()
+	}
+	if retention.MaxCount != nil {
+		policy.MaxCount = int(*retention.MaxCount)
+	}
+	return policy
+}
+
+// Enabled returns whether the policy has a limit.
+func (p Policy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxBytes > 0 || p.MaxCount > 0
+}
+
+// File is a capture file in an output location.
+type File struct {
+	// Name identifies the file in its output location, such as its path or its object key.
+	Name string
+	Node string
+	Size int64
+	// Time is the start time of the capture of the file.
+	Time time.Time
+}
+
+// Expired is a capture file to remove, with the reason.
+type Expired struct {
+	File
+	Reason string
+}
+
+// Select returns the files exceeding the policy. The files of each node are kept from the latest one until a limit
+// is reached, and the older ones are removed.
+func (p Policy) Select(files []File, now time.Time) []Expired {
+	nodeFiles := map[string][]File{}
+	for _, f := range files {
+		nodeFiles[f.Node] = append(nodeFiles[f.Node], f)
+	}
+	nodes := make([]string, 0, len(nodeFiles))
+	for node := range nodeFiles {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	expired := []Expired{}
+	for _, node := range nodes {
+		files := nodeFiles[node]
+		sort.SliceStable(files, func(i, j int) bool { return files[i].Time.After(files[j].Time) })
+
+		var keptCount int
+		var keptBytes int64
+		var reason string
+		nodeExpired := []Expired{}
+		for _, f := range files {
+			// Once a file is removed, so are all the older files.
+			if reason == "" {
+				switch {
+				case p.MaxAge > 0 && now.Sub(f.Time) > p.MaxAge:
+					reason = ReasonMaxAge
+				case p.MaxCount > 0 && keptCount >= p.MaxCount:
+					reason = ReasonMaxCount
+				case p.MaxBytes > 0 && keptBytes+f.Size > p.MaxBytes:
+					reason = ReasonMaxSize
+				default:
+					keptCount++
+					keptBytes += f.Size
+					continue
+				}
+			}
+			nodeExpired = append(nodeExpired, Expired{File: f, Reason: reason})
+		}
+		// The oldest files first.
+		for i := len(nodeExpired) - 1; i >= 0; i-- {
+			expired = append(expired, nodeExpired[i])
+		}
+	}
+	return expired
+}
@@ -0,0 +1,102 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+)
+
+func TestPolicyFromCapture(t *testing.T) {
+	capture := &retinav1alpha1.Capture{}
+	if PolicyFromCapture(capture).Enabled() {
+		t.Errorf("PolicyFromCapture() of a Capture without retention should be disabled")
+	}
+
+	capture.Spec.OutputConfiguration.Retention = &retinav1alpha1.RetentionPolicy{
+		MaxAge:   &metav1.Duration{Duration: time.Hour},
+		MaxSize:  ptr.To(resource.MustParse("1Mi")),
+		MaxCount: ptr.To(int32(3)),
+	}
+	want := Policy{MaxAge: time.Hour, MaxBytes: 1024 * 1024, MaxCount: 3}
+	if diff := cmp.Diff(want, PolicyFromCapture(capture)); diff != "" {
+		t.Errorf("PolicyFromCapture() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestPolicySelect(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	file := func(name, node string, size int64, age time.Duration) File {
+		return File{Name: name, Node: node, Size: size, Time: now.Add(-age)}
+	}
+	files := []File{
+		file("a1", "a", 10, 1*time.Hour),
+		file("a3", "a", 10, 3*time.Hour),
+		file("a2", "a", 10, 2*time.Hour),
+		file("a4", "a", 10, 4*time.Hour),
+		file("b1", "b", 30, 1*time.Hour),
+		file("b2", "b", 30, 2*time.Hour),
+	}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   []Expired
+	}{
+		{
+			name:   "no limit",
+			policy: Policy{},
+			want:   []Expired{},
+		},
+		{
+			name:   "max age",
+			policy: Policy{MaxAge: 150 * time.Minute},
+			want: []Expired{
+				{File: files[3], Reason: ReasonMaxAge},
+				{File: files[1], Reason: ReasonMaxAge},
+			},
+		},
+		{
+			name:   "max count per node",
+			policy: Policy{MaxCount: 1},
+			want: []Expired{
+				{File: files[3], Reason: ReasonMaxCount},
+				{File: files[1], Reason: ReasonMaxCount},
+				{File: files[2], Reason: ReasonMaxCount},
+				{File: files[5], Reason: ReasonMaxCount},
+			},
+		},
+		{
+			name:   "max size per node",
+			policy: Policy{MaxBytes: 35},
+			want: []Expired{
+				{File: files[3], Reason: ReasonMaxSize},
+				{File: files[5], Reason: ReasonMaxSize},
+			},
+		},
+		{
+			name:   "older files removed with the first exceeding file",
+			policy: Policy{MaxAge: 210 * time.Minute, MaxCount: 2},
+			want: []Expired{
+				{File: files[3], Reason: ReasonMaxCount},
+				{File: files[1], Reason: ReasonMaxCount},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.Select(files, now)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Select() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
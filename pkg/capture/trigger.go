@@ -0,0 +1,181 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+
+	"github.com/microsoft/retina/pkg/log"
+)
+
+// triggerCheckInterval is how often the triggers of a ring buffer capture are checked.
+var triggerCheckInterval = 5 * time.Second
+
+// trigger fires the snapshot of a ring buffer capture.
+type trigger interface {
+	// check returns the reason of the trigger when it fires.
+	check(ctx context.Context) (string, bool, error)
+}
+
+// watchTriggers checks the triggers until one of them fires, and sends its reason to the returned channel.
+func watchTriggers(ctx context.Context, l *log.ZapLogger, triggers []trigger) <-chan string {
+	fired := make(chan string, 1)
+	go func() {
+		ticker := time.NewTicker(triggerCheckInterval)
+		defer ticker.Stop()
+		for {
+			for _, t := range triggers {
+				reason, ok, err := t.check(ctx)
+				if err != nil {
+					// The trigger may be checked again, e.g. when the Retina agent restarts.
+					l.Warn("Failed to check capture trigger", zap.Error(err))
+					continue
+				}
+				if ok {
+					fired <- reason
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return fired
+}
+
+// annotationTrigger fires when the trigger annotation is set on the capture pod, read from the downward API file.
+type annotationTrigger struct {
+	path       string
+	annotation string
+}
+
+func (t *annotationTrigger) check(context.Context) (string, bool, error) {
+	f, err := os.Open(t.path)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to open pod annotations: %w", err)
+	}
+	defer f.Close()
+
+	// The downward API writes one annotation per line as key="quoted value".
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok || key != t.annotation {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		if value == "" {
+			return "", false, nil
+		}
+		return fmt.Sprintf("annotation %s=%s", t.annotation, value), true, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", false, fmt.Errorf("failed to read pod annotations: %w", err)
+	}
+	return "", false, nil
+}
+
+// metricTrigger fires when the value of a gauge, or the increase of a counter since the first check, scraped from
+// the Retina agent is greater than the threshold.
+type metricTrigger struct {
+	url       string
+	name      string
+	labels    map[string]string
+	threshold float64
+	client    *http.Client
+
+	baseline *float64
+}
+
+func (t *metricTrigger) check(ctx context.Context) (string, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.url, http.NoBody)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create metrics request: %w", err)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to scrape metrics: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", false, fmt.Errorf("failed to scrape metrics: %s", resp.Status)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	value, counter, err := t.value(families[t.name])
+	if err != nil {
+		return "", false, err
+	}
+	if counter {
+		if t.baseline == nil {
+			baseline := value
+			t.baseline = &baseline
+		}
+		value -= *t.baseline
+	}
+	if value <= t.threshold {
+		return "", false, nil
+	}
+	return fmt.Sprintf("metric %s=%g is greater than %g", t.name, value, t.threshold), true, nil
+}
+
+// value sums the series of the metric family matching the labels, and returns whether the metric is a counter.
+// A missing metric family has no series yet.
+func (t *metricTrigger) value(family *dto.MetricFamily) (float64, bool, error) {
+	if family == nil {
+		return 0, true, nil
+	}
+
+	var sum float64
+	for _, m := range family.GetMetric() {
+		if !matchLabels(m.GetLabel(), t.labels) {
+			continue
+		}
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			sum += m.GetCounter().GetValue()
+		case dto.MetricType_GAUGE:
+			sum += m.GetGauge().GetValue()
+		case dto.MetricType_UNTYPED:
+			sum += m.GetUntyped().GetValue()
+		default:
+			return 0, false, fmt.Errorf("metric %s of type %s is not supported as a trigger", t.name, family.GetType())
+		}
+	}
+	return sum, family.GetType() == dto.MetricType_COUNTER, nil
+}
+
+func matchLabels(pairs []*dto.LabelPair, labels map[string]string) bool {
+	matched := 0
+	for _, pair := range pairs {
+		if value, ok := labels[pair.GetName()]; ok {
+			if value != pair.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+	return matched == len(labels)
+}
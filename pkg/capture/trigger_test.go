@@ -0,0 +1,124 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+func TestAnnotationTrigger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), captureConstants.CapturePodInfoAnnotationsFile)
+	trigger := &annotationTrigger{path: path, annotation: captureConstants.CaptureTriggerAnnotation}
+
+	_, fired, err := trigger.check(context.Background())
+	require.Error(t, err, "the annotations file does not exist yet")
+	assert.False(t, fired)
+
+	require.NoError(t, os.WriteFile(path, []byte("kubernetes.io/config.seen=\"2024-03-20T01:36:00Z\"\n"), 0o600))
+	_, fired, err = trigger.check(context.Background())
+	require.NoError(t, err)
+	assert.False(t, fired)
+
+	require.NoError(t, os.WriteFile(path, []byte("kubernetes.io/config.seen=\"2024-03-20T01:36:00Z\"\nretina.sh/capture-trigger=\"high latency\"\n"), 0o600))
+	reason, fired, err := trigger.check(context.Background())
+	require.NoError(t, err)
+	assert.True(t, fired)
+	assert.Equal(t, "annotation retina.sh/capture-trigger=high latency", reason)
+}
+
+func TestMetricTrigger(t *testing.T) {
+	drops := 10
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, `# HELP networkobservability_drop_count Total dropped packets
+# TYPE networkobservability_drop_count counter
+networkobservability_drop_count{direction="ingress",reason="IPTABLE_RULE_DROP"} %d
+networkobservability_drop_count{direction="egress",reason="IPTABLE_RULE_DROP"} 1000
+# HELP networkobservability_tcp_state Number of active TCP connections by state
+# TYPE networkobservability_tcp_state gauge
+networkobservability_tcp_state{state="ESTABLISHED"} 42
+networkobservability_tcp_state{state="TIME_WAIT"} 8
+`, drops)
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	counter := &metricTrigger{
+		url:       server.URL,
+		name:      "networkobservability_drop_count",
+		labels:    map[string]string{"direction": "ingress"},
+		threshold: 5,
+		client:    server.Client(),
+	}
+	// The increase of a counter is compared to the threshold.
+	_, fired, err := counter.check(ctx)
+	require.NoError(t, err)
+	assert.False(t, fired)
+	drops = 15
+	_, fired, err = counter.check(ctx)
+	require.NoError(t, err)
+	assert.False(t, fired)
+	drops = 16
+	reason, fired, err := counter.check(ctx)
+	require.NoError(t, err)
+	assert.True(t, fired)
+	assert.Equal(t, "metric networkobservability_drop_count=6 is greater than 5", reason)
+
+	gauge := &metricTrigger{url: server.URL, name: "networkobservability_tcp_state", threshold: 49, client: server.Client()}
+	_, fired, err = gauge.check(ctx)
+	require.NoError(t, err)
+	assert.True(t, fired)
+
+	missing := &metricTrigger{url: server.URL, name: "networkobservability_dns_request_count", threshold: 0, client: server.Client()}
+	_, fired, err = missing.check(ctx)
+	require.NoError(t, err)
+	assert.False(t, fired)
+
+	unreachable := &metricTrigger{url: "http://127.0.0.1:0/metrics", name: "networkobservability_drop_count", client: server.Client()}
+	_, _, err = unreachable.check(ctx)
+	require.Error(t, err)
+}
+
+type fakeTrigger struct {
+	checks int
+	fireAt int
+}
+
+func (t *fakeTrigger) check(context.Context) (string, bool, error) {
+	t.checks++
+	if t.checks < t.fireAt {
+		return "", false, fmt.Errorf("check %d failed", t.checks)
+	}
+	return "fake", true, nil
+}
+
+func TestWatchTriggers(t *testing.T) {
+	interval := triggerCheckInterval
+	triggerCheckInterval = time.Millisecond
+	defer func() { triggerCheckInterval = interval }()
+
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	fired := watchTriggers(ctx, log.Logger().Named("test"), []trigger{&fakeTrigger{fireAt: 3}})
+	select {
+	case reason := <-fired:
+		assert.Equal(t, "fake", reason)
+	case <-ctx.Done():
+		t.Fatal("trigger did not fire")
+	}
+}
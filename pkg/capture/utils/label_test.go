@@ -32,3 +32,10 @@ func TestGetContainerLabelsFromCaptureName(t *testing.T) {
 	assert.Equal(t, labels[label.AppLabel], captureConstants.CaptureAppname)
 	assert.Equal(t, labels[label.CaptureNameLabel], captureName)
 }
+
+func TestGetPruneJobLabelsFromCaptureName(t *testing.T) {
+	captureName := "test"
+	labels := GetPruneJobLabelsFromCaptureName(captureName)
+	assert.Equal(t, labels[label.AppLabel], captureConstants.CapturePruneAppname)
+	assert.Equal(t, labels[label.CaptureNameLabel], captureName)
+}
@@ -3,9 +3,15 @@
 package client
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
+
+	"github.com/microsoft/retina/pkg/api"
+	"github.com/pkg/errors"
 )
 
 // Retina API
@@ -26,24 +32,73 @@ func NewRetinaClient(endpoint string) *Retina {
 	}
 }
 
-func (c *Retina) StartTrace(operationID, filter string) error {
+// StartTrace starts a network trace on the agent. An empty operationID lets the agent generate one,
+// a zero duration uses the agent default.
+func (c *Retina) StartTrace(operationID, filter string, duration time.Duration) (*api.Trace, error) {
+	op := &api.TraceOperation{
+		Operation:   api.OperationStart,
+		OperationID: operationID,
+		Filter:      filter,
+	}
+	if duration > 0 {
+		op.Duration = duration.String()
+	}
+	body, err := json.Marshal(op)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal trace operation")
+	}
+
 	startTraceURL := fmt.Sprintf(startTrace, c.RetinaEndpoint)
-	response, err := c.Client.Post(startTraceURL, "application/json", nil)
+	response, err := c.Client.Post(startTraceURL, "application/json", bytes.NewReader(body))
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer response.Body.Close()
 
-	response.Body.Close()
-	return nil
+	return decodeTrace(response)
 }
 
-func (c *Retina) GetTrace(operationID string) error {
+// GetTrace returns the status and the flows collected so far of a network trace.
+func (c *Retina) GetTrace(operationID string) (*api.Trace, error) {
 	getTraceURL := fmt.Sprintf(trace, c.RetinaEndpoint, operationID)
 	response, err := c.Client.Get(getTraceURL)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer response.Body.Close()
+
+	return decodeTrace(response)
+}
 
-	response.Body.Close()
-	return nil
+// StopTrace stops a running network trace, the flows collected so far are kept.
+func (c *Retina) StopTrace(operationID string) (*api.Trace, error) {
+	stopTraceURL := fmt.Sprintf(trace, c.RetinaEndpoint, operationID)
+	response, err := c.Client.Post(stopTraceURL, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return decodeTrace(response)
+}
+
+func decodeTrace(response *http.Response) (*api.Trace, error) {
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		apiErr := &api.Error{}
+		if err := json.Unmarshal(body, apiErr); err != nil || apiErr.Message == "" {
+			return nil, fmt.Errorf("unexpected status %s: %s", response.Status, string(body))
+		}
+		return nil, errors.Wrapf(apiErr, "unexpected status %s", response.Status)
+	}
+
+	t := &api.Trace{}
+	if err := json.Unmarshal(body, t); err != nil {
+		return nil, errors.Wrap(err, "failed to decode trace")
+	}
+	return t, nil
 }
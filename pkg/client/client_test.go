@@ -0,0 +1,99 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/microsoft/retina/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartTrace(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/trace", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		op := &api.TraceOperation{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(op))
+		assert.Equal(t, api.OperationStart, op.Operation)
+		assert.Equal(t, "op1", op.OperationID)
+		assert.Equal(t, "ip=10.0.0.1", op.Filter)
+		assert.Equal(t, "10s", op.Duration)
+
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(&api.Trace{
+			Operation:   op.Operation,
+			OperationID: op.OperationID,
+			Filter:      op.Filter,
+			Result:      api.TraceRunning,
+		})
+	}))
+	defer ts.Close()
+
+	c := NewRetinaClient(ts.URL)
+	tr, err := c.StartTrace("op1", "ip=10.0.0.1", 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "op1", tr.OperationID)
+	assert.Equal(t, api.TraceRunning, tr.Result)
+}
+
+func TestGetTrace(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Path != "/trace/op1" {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(&api.Error{Code: http.StatusNotFound, Message: "trace operation not found"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&api.Trace{
+			Operation:   api.OperationStart,
+			OperationID: "op1",
+			Result:      api.TraceCompleted,
+			Flows:       []json.RawMessage{json.RawMessage(`{"IP":{"source":"10.0.0.1"}}`)},
+		})
+	}))
+	defer ts.Close()
+
+	c := NewRetinaClient(ts.URL)
+	tr, err := c.GetTrace("op1")
+	require.NoError(t, err)
+	assert.Equal(t, api.TraceCompleted, tr.Result)
+	require.Len(t, tr.Flows, 1)
+	assert.JSONEq(t, `{"IP":{"source":"10.0.0.1"}}`, string(tr.Flows[0]))
+
+	_, err = c.GetTrace("op2")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "trace operation not found")
+}
+
+func TestStopTrace(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/trace/op1", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(&api.Trace{Operation: api.OperationStop, OperationID: "op1", Result: api.TraceStopped})
+	}))
+	defer ts.Close()
+
+	tr, err := NewRetinaClient(ts.URL).StopTrace("op1")
+	require.NoError(t, err)
+	assert.Equal(t, api.TraceStopped, tr.Result)
+}
+
+func TestDecodeTraceUnexpectedBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("bad gateway"))
+	}))
+	defer ts.Close()
+
+	_, err := NewRetinaClient(ts.URL).GetTrace("op1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bad gateway")
+}
@@ -13,7 +13,9 @@ func NewRetinaNode(name string, ip net.IP) *RetinaNode {
 
 func (n *RetinaNode) DeepCopy() interface{} {
 	newN := &RetinaNode{
-		name: n.name,
+		name:   n.name,
+		zone:   n.zone,
+		region: n.region,
 	}
 
 	if n.ip != nil {
@@ -31,3 +33,21 @@ func (n *RetinaNode) IPString() string {
 func (n *RetinaNode) Name() string {
 	return n.name
 }
+
+func (n *RetinaNode) IP() net.IP {
+	return n.ip
+}
+
+// SetTopology sets the zone and region of the node.
+func (n *RetinaNode) SetTopology(zone, region string) {
+	n.zone = zone
+	n.region = region
+}
+
+func (n *RetinaNode) Zone() string {
+	return n.zone
+}
+
+func (n *RetinaNode) Region() string {
+	return n.region
+}
@@ -21,4 +21,6 @@ const (
 	PubSubFilterRule pubsub.PubSubTopic = "filterrule"
 	// PubSubAPIServer topic
 	PubSubAPIServer pubsub.PubSubTopic = "apiserver"
+	// PubSubLinks topic
+	PubSubLinks pubsub.PubSubTopic = "links"
 )
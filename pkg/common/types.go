@@ -16,6 +16,15 @@ const (
 	// default value for annotations
 	RetinaPodAnnotation      = "retina.sh"
 	RetinaPodAnnotationValue = "observe"
+
+	// Labels of the node endpoints, following the reserved identities of Cilium.
+	ReservedHostLabel       = "reserved:host"
+	ReservedRemoteNodeLabel = "reserved:remote-node"
+	// NodeNameLabel is the label with the name of the node of a node endpoint.
+	NodeNameLabel = "kubernetes.io/hostname"
+	// Reserved identities of the node endpoints.
+	ReservedHostIdentity       uint32 = 1
+	ReservedRemoteNodeIdentity uint32 = 6
 )
 
 // Important note: any changes to these structs must be reflected in the DeepCopy() method.
@@ -203,6 +212,9 @@ type OwnerReference struct {
 type RetinaNode struct {
 	name string
 	ip   net.IP
+	// zone and region are the topology labels of the node, empty if not set.
+	zone   string
+	region string
 }
 
 type APIServerObject struct {
@@ -248,3 +248,20 @@ func TestRetinaEndpointCommonFromPod(t *testing.T) {
 		})
 	}
 }
+
+func TestRetinaNodeDeepCopy(t *testing.T) {
+	n := NewRetinaNode("node1", net.ParseIP("10.224.0.4"))
+	n.SetTopology("eastus-1", "eastus")
+
+	c, ok := n.DeepCopy().(*RetinaNode)
+	if !ok {
+		t.Fatalf("DeepCopy returned %T", n.DeepCopy())
+	}
+	if c.Name() != "node1" || c.IPString() != "10.224.0.4" || c.Zone() != "eastus-1" || c.Region() != "eastus" {
+		t.Fatalf("unexpected copy %+v", c)
+	}
+	c.IP()[0] = 192
+	if n.IPString() != "10.224.0.4" {
+		t.Fatalf("copy shares the IP of the node")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -23,8 +24,17 @@ const (
 	High
 )
 
+const (
+	// DropReasonModeKprobe reports the drops of netfilter and the failures of TCP connect and accept from kprobes.
+	DropReasonModeKprobe = "kprobe"
+	// DropReasonModeTracepoint reports the packets freed by the kernel as dropped from the skb:kfree_skb tracepoint,
+	// with the drop reason of the kernel, and the failures of TCP connect and accept from kprobes.
+	DropReasonModeTracepoint = "tracepoint"
+)
+
 var (
 	ErrorTelemetryIntervalTooSmall = fmt.Errorf("telemetryInterval smaller than %v is not allowed", MinTelemetryInterval)
+	ErrorInvalidDropReasonMode     = fmt.Errorf("dropReasonMode must be %q or %q", DropReasonModeKprobe, DropReasonModeTracepoint)
 	DefaultTelemetryInterval       = 15 * time.Minute
 )
 
@@ -58,23 +68,83 @@ type Server struct {
 	Port int    `yaml:"port"`
 }
 
+// OtelExporter configures pushing metrics, and optionally flows, to an OTLP receiver.
+type OtelExporter struct {
+	Enabled bool `yaml:"enabled"`
+	// Endpoint is host:port of the receiver, or a URL for the http protocol.
+	Endpoint string `yaml:"endpoint"`
+	// Protocol is either grpc or http.
+	Protocol string            `yaml:"protocol"`
+	Insecure bool              `yaml:"insecure"`
+	Headers  map[string]string `yaml:"headers"`
+	// Interval at which metrics are gathered and pushed.
+	Interval time.Duration `yaml:"interval"`
+	// ExportFlows emits the enriched flows as OTLP log records.
+	ExportFlows bool `yaml:"exportFlows"`
+}
+
+// FlowLogExporter configures writing the enriched flows to rotating files on the node.
+type FlowLogExporter struct {
+	Enabled bool `yaml:"enabled"`
+	// Directory the flow files are written to.
+	Directory string `yaml:"directory"`
+	// Format is either json, for Hubble compatible JSON lines, or protobuf for length-delimited records.
+	Format string `yaml:"format"`
+	// MaxFileSizeMB is the size at which the current file is rotated.
+	MaxFileSizeMB int `yaml:"maxFileSizeMB"`
+	// RotationInterval rotates the current file periodically, regardless of its size. Disabled if zero.
+	RotationInterval time.Duration `yaml:"rotationInterval"`
+	// Compress gzips the rotated files.
+	Compress bool `yaml:"compress"`
+	// MaxBackups is the number of rotated files to retain, all are retained if zero.
+	MaxBackups int `yaml:"maxBackups"`
+	// MaxAgeDays is the number of days to retain rotated files for, they are not removed based on age if zero.
+	MaxAgeDays int `yaml:"maxAgeDays"`
+}
+
+// CaptureRetention configures the removal of the capture files written to the host path of the node.
+type CaptureRetention struct {
+	Enabled bool `yaml:"enabled"`
+	// HostPath is the directory of the capture files. The retention policies of the Captures writing to it, or below
+	// it, are enforced as well.
+	HostPath string `yaml:"hostPath"`
+	// MaxAge is the age after which the capture files are removed, they are not removed based on age if zero.
+	MaxAge time.Duration `yaml:"maxAge"`
+	// MaxSizeMB is the total size of the capture files above which the oldest ones are removed. Disabled if zero.
+	MaxSizeMB int64 `yaml:"maxSizeMB"`
+	// MaxCount is the number of capture files above which the oldest ones are removed. Disabled if zero.
+	MaxCount int `yaml:"maxCount"`
+	// Interval at which the retention policies are enforced.
+	Interval time.Duration `yaml:"interval"`
+}
+
 type Config struct {
 	APIServer       Server        `yaml:"apiServer"`
 	LogLevel        string        `yaml:"logLevel"`
 	EnabledPlugin   []string      `yaml:"enabledPlugin"`
 	MetricsInterval time.Duration `yaml:"metricsInterval"`
 	// Deprecated: Use only MetricsInterval instead in the go code.
-	MetricsIntervalDuration  time.Duration `yaml:"metricsIntervalDuration"`
-	EnableTelemetry          bool          `yaml:"enableTelemetry"`
-	EnableRetinaEndpoint     bool          `yaml:"enableRetinaEndpoint"`
-	EnablePodLevel           bool          `yaml:"enablePodLevel"`
-	EnableConntrackMetrics   bool          `yaml:"enableConntrackMetrics"`
-	RemoteContext            bool          `yaml:"remoteContext"`
-	EnableAnnotations        bool          `yaml:"enableAnnotations"`
-	BypassLookupIPOfInterest bool          `yaml:"bypassLookupIPOfInterest"`
-	DataAggregationLevel     Level         `yaml:"dataAggregationLevel"`
-	MonitorSockPath          string        `yaml:"monitorSockPath"`
-	TelemetryInterval        time.Duration `yaml:"telemetryInterval"`
+	MetricsIntervalDuration  time.Duration    `yaml:"metricsIntervalDuration"`
+	EnableTelemetry          bool             `yaml:"enableTelemetry"`
+	EnableRetinaEndpoint     bool             `yaml:"enableRetinaEndpoint"`
+	EnablePodLevel           bool             `yaml:"enablePodLevel"`
+	EnableConntrackMetrics   bool             `yaml:"enableConntrackMetrics"`
+	EnableHTTPParser         bool             `yaml:"enableHTTPParser"`
+	RemoteContext            bool             `yaml:"remoteContext"`
+	EnableAnnotations        bool             `yaml:"enableAnnotations"`
+	EnableTraces             bool             `yaml:"enableTraces"`
+	BypassLookupIPOfInterest bool             `yaml:"bypassLookupIPOfInterest"`
+	DropReasonMode           string           `yaml:"dropReasonMode"`
+	DataAggregationLevel     Level            `yaml:"dataAggregationLevel"`
+	MonitorSockPath          string           `yaml:"monitorSockPath"`
+	TelemetryInterval        time.Duration    `yaml:"telemetryInterval"`
+	OtelExporter             OtelExporter     `yaml:"otelExporter"`
+	FlowLogExporter          FlowLogExporter  `yaml:"flowLogExporter"`
+	CaptureRetention         CaptureRetention `yaml:"captureRetention"`
+
+	// PacketParserInterfaceRegex matches the names of the links of the node that packetparser attaches to, in addition
+	// to the links of the default routes.
+	PacketParserInterfaceRegex string `yaml:"packetParserInterfaceRegex"`
 }
 
 func GetConfig(cfgFilename string) (*Config, error) {
@@ -123,6 +193,20 @@ func GetConfig(cfgFilename string) (*Config, error) {
 		return nil, ErrorTelemetryIntervalTooSmall
 	}
 
+	switch config.DropReasonMode {
+	case "":
+		config.DropReasonMode = DropReasonModeKprobe
+	case DropReasonModeKprobe, DropReasonModeTracepoint:
+	default:
+		return nil, ErrorInvalidDropReasonMode
+	}
+
+	if config.PacketParserInterfaceRegex != "" {
+		if _, err := regexp.Compile(config.PacketParserInterfaceRegex); err != nil {
+			return nil, fmt.Errorf("invalid packetParserInterfaceRegex: %w", err)
+		}
+	}
+
 	return &config, nil
 }
 
@@ -28,9 +28,31 @@ func TestGetConfig(t *testing.T) {
 		c.RemoteContext ||
 		c.EnableAnnotations ||
 		c.TelemetryInterval != 15*time.Minute ||
-		c.DataAggregationLevel != Low {
+		c.DataAggregationLevel != Low ||
+		c.DropReasonMode != DropReasonModeTracepoint ||
+		c.PacketParserInterfaceRegex != "^(bond|eth)[0-9]+$" {
 		t.Errorf("Expeted config should be same as ./testwith/config.yaml; instead got %+v", c)
 	}
+
+	assert.Equal(t, FlowLogExporter{
+		Enabled:          true,
+		Directory:        "/var/log/retina/flows",
+		Format:           "protobuf",
+		MaxFileSizeMB:    50,
+		RotationInterval: time.Hour,
+		Compress:         true,
+		MaxBackups:       3,
+		MaxAgeDays:       2,
+	}, c.FlowLogExporter)
+
+	assert.Equal(t, CaptureRetention{
+		Enabled:   true,
+		HostPath:  "/mnt/retina/captures",
+		MaxAge:    72 * time.Hour,
+		MaxSizeMB: 2048,
+		MaxCount:  50,
+		Interval:  5 * time.Minute,
+	}, c.CaptureRetention)
 }
 
 func TestGetConfig_SmallTelemetryInterval(t *testing.T) {
@@ -50,6 +72,12 @@ func TestGetConfig_DefaultTelemetryInterval(t *testing.T) {
 	}
 }
 
+func TestGetConfig_DefaultDropReasonMode(t *testing.T) {
+	c, err := GetConfig("./testwith/config-without-telemetry-interval.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, DropReasonModeKprobe, c.DropReasonMode)
+}
+
 func TestDecodeLevelHook(t *testing.T) {
 	tests := []struct {
 		input    interface{}
@@ -108,6 +108,7 @@ func (c *Cache) GetNodeByIP(ip string) *common.RetinaNode {
 
 // getObjByIPType returns the retina endpoint for the given IP.
 func (c *Cache) getObjByIPType(ip string, t objectType) interface{} {
+	ip = normalizeIP(ip)
 	switch t {
 	case TypeEndpoint:
 		podKey, ok := c.ipToEpKey[ip]
@@ -192,6 +193,45 @@ func (c *Cache) GetIPsByNamespace(ns string) []net.IP {
 	return ips
 }
 
+// GetEndpoints returns all retina endpoints in the cache, sorted by key.
+func (c *Cache) GetEndpoints() []*common.RetinaEndpoint {
+	c.RLock()
+	defer c.RUnlock()
+
+	eps := make([]*common.RetinaEndpoint, 0, len(c.epMap))
+	for _, ep := range c.epMap {
+		eps = append(eps, ep)
+	}
+	sort.Slice(eps, func(i, j int) bool { return eps[i].Key() < eps[j].Key() })
+	return eps
+}
+
+// GetSvcs returns all retina services in the cache, sorted by key.
+func (c *Cache) GetSvcs() []*common.RetinaSvc {
+	c.RLock()
+	defer c.RUnlock()
+
+	svcs := make([]*common.RetinaSvc, 0, len(c.svcMap))
+	for _, svc := range c.svcMap {
+		svcs = append(svcs, svc)
+	}
+	sort.Slice(svcs, func(i, j int) bool { return svcs[i].Key() < svcs[j].Key() })
+	return svcs
+}
+
+// GetNodes returns all retina nodes in the cache, sorted by name.
+func (c *Cache) GetNodes() []*common.RetinaNode {
+	c.RLock()
+	defer c.RUnlock()
+
+	nodes := make([]*common.RetinaNode, 0, len(c.nodeMap))
+	for _, node := range c.nodeMap {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name() < nodes[j].Name() })
+	return nodes
+}
+
 // UpdateRetinaEndpoint updates the cache with the given retina endpoint.
 func (c *Cache) UpdateRetinaEndpoint(ep *common.RetinaEndpoint) error {
 	c.Lock()
@@ -243,25 +283,30 @@ func (c *Cache) UpdateRetinaSvc(svc *common.RetinaSvc) error {
 // updateSvc updates the cache with the given retina service.
 func (c *Cache) updateSvc(svc *common.RetinaSvc) error {
 	// TODO check if a service already exists here
-	ip, err := svc.GetPrimaryIP()
-	if err != nil {
+	if _, err := svc.GetPrimaryIP(); err != nil {
 		c.l.Error("updateSvc: error getting primary IP for service", zap.String("service", svc.Key()), zap.Error(err))
 		return err
 	}
 
-	// delete if any existing object is using this IP
+	// dual-stack services have both an IPv4 and an IPv6 cluster IP.
+	ips := svc.IPs().GetIPs()
+	// delete if any existing object is using any IP
 	// send a delete event for the existing object
-	err = c.deleteByIP(ip, svc.Key())
-	if err != nil {
-		c.l.Error("updateSvc: error deleting existing object for IP",
-			zap.String("svc", svc.Key()),
-			zap.String("ip", ip),
-			zap.Error(err),
-		)
-		return err
+	for _, ip := range ips {
+		err := c.deleteByIP(ip, svc.Key())
+		if err != nil {
+			c.l.Error("updateSvc: error deleting existing object for IP",
+				zap.String("svc", svc.Key()),
+				zap.String("ip", ip),
+				zap.Error(err),
+			)
+			return err
+		}
 	}
 
-	c.ipToSvcKey[ip] = svc.Key()
+	for _, ip := range ips {
+		c.ipToSvcKey[ip] = svc.Key()
+	}
 	c.svcMap[svc.Key()] = svc
 
 	// notify pubsub
@@ -352,14 +397,15 @@ func (c *Cache) deleteSvc(svcKey string) error {
 		return fmt.Errorf("service not found in cache: %s", svcKey)
 	}
 
-	ip, err := svc.GetPrimaryIP()
-	if err != nil {
+	if _, err := svc.GetPrimaryIP(); err != nil {
 		c.l.Error("error getting primary IP for service", zap.String("service", svc.Key()), zap.Error(err))
 		return err
 	}
 
 	delete(c.svcMap, svcKey)
-	delete(c.ipToSvcKey, ip)
+	for _, ip := range svc.IPs().GetIPs() {
+		delete(c.ipToSvcKey, ip)
+	}
 
 	// notify pubsub
 	c.publish(EventTypeSvcDeleted, svc)
@@ -419,6 +465,15 @@ func (c *Cache) deleteByIP(ip, key string) error {
 	return nil
 }
 
+// normalizeIP returns the canonical string form of the given IP, so that
+// IPv6 addresses written in a different notation map to the same cache key.
+func normalizeIP(ip string) string {
+	if parsed := net.ParseIP(ip); parsed != nil {
+		return parsed.String()
+	}
+	return ip
+}
+
 func (c *Cache) publish(t EventType, obj common.PublishObj) {
 	if c.pubsub == nil {
 		c.l.Warn("pubsub not initialized, skipping publish", zap.String("event", t.String()))
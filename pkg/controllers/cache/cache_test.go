@@ -365,3 +365,106 @@ func TestCachingNamespace(t *testing.T) {
 	namespaces = c.GetAnnotatedNamespaces()
 	assert.Equal(t, 0, len(namespaces))
 }
+
+func TestCacheDualStack(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	p := pubsub.NewMockPubSubInterface(ctrl)
+	p.EXPECT().Subscribe(common.PubSubAPIServer, gomock.Any()).Times(1)
+	p.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
+	c := New(p)
+
+	ep := common.NewRetinaEndpoint("pod1", "ns1", nil)
+	ep.SetIPs(&common.IPAddresses{
+		IPv4:       net.ParseIP("10.0.0.1"),
+		IPv6:       net.ParseIP("fd00::1"),
+		OtherIPv6s: []net.IP{net.ParseIP("fd00::2")},
+	})
+	assert.NoError(t, c.UpdateRetinaEndpoint(ep))
+
+	svc := common.NewRetinaSvc("svc1", "ns1", &common.IPAddresses{
+		IPv4: net.ParseIP("10.1.0.1"),
+		IPv6: net.ParseIP("fd01::a"),
+	}, nil, nil)
+	assert.NoError(t, c.UpdateRetinaSvc(svc))
+
+	tests := []struct {
+		name    string
+		ip      string
+		wantKey string
+	}{
+		{name: "pod IPv4", ip: "10.0.0.1", wantKey: ep.Key()},
+		{name: "pod primary IPv6", ip: "fd00::1", wantKey: ep.Key()},
+		{name: "pod secondary IPv6", ip: "fd00::2", wantKey: ep.Key()},
+		{name: "pod IPv6 expanded notation", ip: "fd00:0000:0000:0000:0000:0000:0000:0001", wantKey: ep.Key()},
+		{name: "pod IPv6 upper case", ip: "FD00::2", wantKey: ep.Key()},
+		{name: "service IPv4", ip: "10.1.0.1", wantKey: svc.Key()},
+		{name: "service IPv6", ip: "fd01::a", wantKey: svc.Key()},
+		{name: "unknown IPv6", ip: "fd00::3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := c.GetObjByIP(tt.ip)
+			if tt.wantKey == "" {
+				assert.Nil(t, obj)
+				return
+			}
+			switch o := obj.(type) {
+			case *common.RetinaEndpoint:
+				assert.Equal(t, tt.wantKey, o.Key())
+			case *common.RetinaSvc:
+				assert.Equal(t, tt.wantKey, o.Key())
+			default:
+				t.Fatalf("unexpected object %T for IP %s", obj, tt.ip)
+			}
+		})
+	}
+
+	assert.NoError(t, c.DeleteRetinaSvc(svc.Key()))
+	assert.Nil(t, c.GetSvcByIP("10.1.0.1"))
+	assert.Nil(t, c.GetSvcByIP("fd01::a"))
+
+	assert.NoError(t, c.DeleteRetinaEndpoint(ep.Key()))
+	assert.Nil(t, c.GetPodByIP("fd00::1"))
+	assert.Nil(t, c.GetPodByIP("fd00::2"))
+
+	time.Sleep(until)
+}
+
+func TestCacheList(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	p := pubsub.NewMockPubSubInterface(ctrl)
+	p.EXPECT().Subscribe(common.PubSubAPIServer, gomock.Any()).Times(1)
+	p.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
+	c := New(p)
+
+	assert.Empty(t, c.GetEndpoints())
+	assert.Empty(t, c.GetSvcs())
+	assert.Empty(t, c.GetNodes())
+
+	for _, name := range []string{"pod2", "pod1"} {
+		ip := net.IPv4(10, 0, 0, byte(len(c.GetEndpoints())+1))
+		assert.NoError(t, c.UpdateRetinaEndpoint(common.NewRetinaEndpoint(name, "ns1", common.NewIPAddress(ip, nil))))
+	}
+	assert.NoError(t, c.UpdateRetinaSvc(common.NewRetinaSvc("svc1", "ns1", common.NewIPAddress(net.IPv4(10, 1, 0, 1), nil), nil, nil)))
+	assert.NoError(t, c.UpdateRetinaNode(common.NewRetinaNode("node2", net.IPv4(10, 2, 0, 2))))
+	assert.NoError(t, c.UpdateRetinaNode(common.NewRetinaNode("node1", net.IPv4(10, 2, 0, 1))))
+
+	eps := c.GetEndpoints()
+	assert.Len(t, eps, 2)
+	assert.Equal(t, "ns1/pod1", eps[0].Key())
+	assert.Equal(t, "ns1/pod2", eps[1].Key())
+
+	svcs := c.GetSvcs()
+	assert.Len(t, svcs, 1)
+	assert.Equal(t, "ns1/svc1", svcs[0].Key())
+
+	nodes := c.GetNodes()
+	assert.Len(t, nodes, 2)
+	assert.Equal(t, "node1", nodes[0].Name())
+	assert.Equal(t, "10.2.0.1", nodes[0].IP().String())
+	assert.Equal(t, "node2", nodes[1].Name())
+}
@@ -126,6 +126,20 @@ func (mr *MockCacheInterfaceMockRecorder) GetAnnotatedNamespaces() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotatedNamespaces", reflect.TypeOf((*MockCacheInterface)(nil).GetAnnotatedNamespaces))
 }
 
+// GetEndpoints mocks base method.
+func (m *MockCacheInterface) GetEndpoints() []*common.RetinaEndpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpoints")
+	ret0, _ := ret[0].([]*common.RetinaEndpoint)
+	return ret0
+}
+
+// GetEndpoints indicates an expected call of GetEndpoints.
+func (mr *MockCacheInterfaceMockRecorder) GetEndpoints() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpoints", reflect.TypeOf((*MockCacheInterface)(nil).GetEndpoints))
+}
+
 // GetIPsByNamespace mocks base method.
 func (m *MockCacheInterface) GetIPsByNamespace(arg0 string) []net.IP {
 	m.ctrl.T.Helper()
@@ -154,6 +168,20 @@ func (mr *MockCacheInterfaceMockRecorder) GetNodeByIP(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeByIP", reflect.TypeOf((*MockCacheInterface)(nil).GetNodeByIP), arg0)
 }
 
+// GetNodes mocks base method.
+func (m *MockCacheInterface) GetNodes() []*common.RetinaNode {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodes")
+	ret0, _ := ret[0].([]*common.RetinaNode)
+	return ret0
+}
+
+// GetNodes indicates an expected call of GetNodes.
+func (mr *MockCacheInterfaceMockRecorder) GetNodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodes", reflect.TypeOf((*MockCacheInterface)(nil).GetNodes))
+}
+
 // GetObjByIP mocks base method.
 func (m *MockCacheInterface) GetObjByIP(arg0 string) any {
 	m.ctrl.T.Helper()
@@ -196,6 +224,20 @@ func (mr *MockCacheInterfaceMockRecorder) GetSvcByIP(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSvcByIP", reflect.TypeOf((*MockCacheInterface)(nil).GetSvcByIP), arg0)
 }
 
+// GetSvcs mocks base method.
+func (m *MockCacheInterface) GetSvcs() []*common.RetinaSvc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSvcs")
+	ret0, _ := ret[0].([]*common.RetinaSvc)
+	return ret0
+}
+
+// GetSvcs indicates an expected call of GetSvcs.
+func (mr *MockCacheInterfaceMockRecorder) GetSvcs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSvcs", reflect.TypeOf((*MockCacheInterface)(nil).GetSvcs))
+}
+
 // UpdateRetinaEndpoint mocks base method.
 func (m *MockCacheInterface) UpdateRetinaEndpoint(arg0 *common.RetinaEndpoint) error {
 	m.ctrl.T.Helper()
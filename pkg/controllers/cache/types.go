@@ -22,6 +22,12 @@ type CacheInterface interface {
 	GetIPsByNamespace(ns string) []net.IP
 	// GetAnnotatedNamespaces returns list of namespaces that are annotated with retina to observe.
 	GetAnnotatedNamespaces() []string
+	// GetEndpoints returns all retina endpoints in the cache.
+	GetEndpoints() []*common.RetinaEndpoint
+	// GetSvcs returns all retina services in the cache.
+	GetSvcs() []*common.RetinaSvc
+	// GetNodes returns all retina nodes in the cache.
+	GetNodes() []*common.RetinaNode
 
 	// UpdateRetinaEndpoint updates the retina endpoint in the cache.
 	UpdateRetinaEndpoint(ep *common.RetinaEndpoint) error
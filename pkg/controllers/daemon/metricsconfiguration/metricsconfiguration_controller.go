@@ -15,6 +15,7 @@ package metricsconfigurationcontroller
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"go.uber.org/zap"
@@ -61,11 +62,12 @@ func (r *MetricsConfigurationReconciler) Reconcile(ctx context.Context, req ctrl
 			// Object not found, it has probably been deleted
 			r.l.Info("deleted", zap.String("name", req.NamespacedName.String()))
 			r.Lock()
+			defer r.Unlock()
 			if _, ok := r.mcCache[req.NamespacedName.String()]; ok {
 				delete(r.mcCache, req.NamespacedName.String())
 				r.l.Info("deleted from cache", zap.String("name", req.NamespacedName.String()))
+				r.reconcileMetricsModule()
 			}
-			r.Unlock()
 			return ctrl.Result{}, nil
 		} else {
 			r.l.Info("error getting metricsconfiguration", zap.String("name", req.NamespacedName.String()))
@@ -76,31 +78,47 @@ func (r *MetricsConfigurationReconciler) Reconcile(ctx context.Context, req ctrl
 	r.l.Info("reconciled", zap.String("name", req.NamespacedName.String()))
 	r.Lock()
 	defer r.Unlock()
-	if len(r.mcCache) == 0 || r.mcCache[req.NamespacedName.String()] != nil {
-		if mcc.Status.State != retinav1alpha1.StateAccepted {
-			r.l.Info("ignoring this CRD as it is not configured and accepted by operator")
-		} else {
-			r.l.Info("adding to cache", zap.String("name", req.NamespacedName.String()))
-			currentMcc := r.mcCache[req.NamespacedName.String()]
-
-			if validations.CompareMetricsConfig(currentMcc, mcc) {
-				r.l.Info("no change in metrics configuration, skipping reconcile", zap.String("name", req.NamespacedName.String()))
-				return ctrl.Result{}, nil
-			}
-
-			err := r.metricsModule.Reconcile(&mcc.Spec)
-			if err != nil {
-				r.l.Info("error reconciling metrics configurations", zap.String("name", req.NamespacedName.String()))
-			}
-			r.mcCache[req.NamespacedName.String()] = mcc
+	currentMcc := r.mcCache[req.NamespacedName.String()]
+	if mcc.Status.State != retinav1alpha1.StateAccepted {
+		r.l.Info("ignoring this CRD as it is not configured and accepted by operator", zap.String("name", req.NamespacedName.String()))
+		if currentMcc != nil {
+			delete(r.mcCache, req.NamespacedName.String())
+			r.reconcileMetricsModule()
 		}
-	} else {
-		r.l.Error("Metrics Configuration is already configured on this cluster, cannot reconcile this new config.", zap.String("name", req.NamespacedName.String()))
+		return ctrl.Result{}, nil
+	}
+
+	if validations.CompareMetricsConfig(currentMcc, mcc) {
+		r.l.Info("no change in metrics configuration, skipping reconcile", zap.String("name", req.NamespacedName.String()))
+		return ctrl.Result{}, nil
 	}
 
+	r.l.Info("adding to cache", zap.String("name", req.NamespacedName.String()))
+	r.mcCache[req.NamespacedName.String()] = mcc
+	r.reconcileMetricsModule()
+
 	return ctrl.Result{}, nil
 }
 
+// reconcileMetricsModule reconciles the metrics module with the specs of all the cached MetricsConfigurations, ordered
+// by name so that their merge is the same on every node.
+func (r *MetricsConfigurationReconciler) reconcileMetricsModule() {
+	names := make([]string, 0, len(r.mcCache))
+	for name := range r.mcCache {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	specs := make([]*retinav1alpha1.MetricsSpec, 0, len(names))
+	for _, name := range names {
+		specs = append(specs, &r.mcCache[name].Spec)
+	}
+
+	if err := r.metricsModule.Reconcile(specs...); err != nil {
+		r.l.Error("error reconciling metrics configurations", zap.Strings("names", names), zap.Error(err))
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *MetricsConfigurationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -76,7 +76,22 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 	ips := retinaCommon.IPAddresses{}
-	ips.IPv4 = net.ParseIP(service.Spec.ClusterIP)
+	clusterIPs := service.Spec.ClusterIPs
+	if len(clusterIPs) == 0 {
+		clusterIPs = []string{service.Spec.ClusterIP}
+	}
+	// Dual-stack services carry one cluster IP per family.
+	for _, clusterIP := range clusterIPs {
+		ip := net.ParseIP(clusterIP)
+		switch {
+		case ip == nil:
+			continue
+		case ip.To4() != nil && ips.IPv4 == nil:
+			ips.IPv4 = ip
+		case ip.To4() == nil && ips.IPv6 == nil:
+			ips.IPv6 = ip
+		}
+	}
 	var lbIP net.IP
 	if service.Status.LoadBalancer.Ingress != nil && len(service.Status.LoadBalancer.Ingress) > 0 {
 		lbIP = net.ParseIP(service.Status.LoadBalancer.Ingress[0].IP)
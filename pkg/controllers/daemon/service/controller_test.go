@@ -1,11 +1,20 @@
 package service
 
 import (
+	"context"
 	"testing"
 
+	"github.com/microsoft/retina/pkg/controllers/cache"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/pubsub"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
@@ -131,3 +140,77 @@ func TestServiceFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileClusterIPs(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	tests := []struct {
+		name       string
+		clusterIP  string
+		clusterIPs []string
+		wantIPs    []string
+		notIPs     []string
+	}{
+		{
+			name:      "falls back to ClusterIP when ClusterIPs is empty",
+			clusterIP: "10.0.0.1",
+			wantIPs:   []string{"10.0.0.1"},
+		},
+		{
+			name:       "dual-stack",
+			clusterIP:  "10.0.0.1",
+			clusterIPs: []string{"10.0.0.1", "fd00::1"},
+			wantIPs:    []string{"10.0.0.1", "fd00::1"},
+		},
+		{
+			name:       "IPv6 only",
+			clusterIP:  "fd00::1",
+			clusterIPs: []string{"fd00::1"},
+			wantIPs:    []string{"fd00::1"},
+		},
+		{
+			name:       "first IP of each family wins",
+			clusterIP:  "10.0.0.1",
+			clusterIPs: []string{"10.0.0.1", "fd00::1", "10.0.0.2", "fd00::2"},
+			wantIPs:    []string{"10.0.0.1", "fd00::1"},
+			notIPs:     []string{"10.0.0.2", "fd00::2"},
+		},
+		{
+			name:       "unparsable entries are skipped",
+			clusterIP:  "10.0.0.1",
+			clusterIPs: []string{"not-an-ip", "10.0.0.1"},
+			wantIPs:    []string{"10.0.0.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "svc1",
+					Namespace: "ns1",
+				},
+				Spec: corev1.ServiceSpec{
+					ClusterIP:  tt.clusterIP,
+					ClusterIPs: tt.clusterIPs,
+				},
+			}
+			c := cache.New(pubsub.New())
+			r := New(fake.NewClientBuilder().WithObjects(svc).Build(), c)
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "svc1", Namespace: "ns1"},
+			})
+			require.NoError(t, err)
+
+			for _, ip := range tt.wantIPs {
+				got := c.GetSvcByIP(ip)
+				require.NotNil(t, got, "expected service for IP %s", ip)
+				assert.Equal(t, "ns1/svc1", got.Key())
+			}
+			for _, ip := range tt.notIPs {
+				assert.Nil(t, c.GetSvcByIP(ip), "unexpected service for IP %s", ip)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracesconfigurationcontroller
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/log"
+	tm "github.com/microsoft/retina/pkg/module/traces"
+)
+
+// TracesConfigurationReconciler reconciles a TracesConfiguration object
+type TracesConfigurationReconciler struct {
+	*sync.Mutex
+	client.Client
+	Scheme       *runtime.Scheme
+	tcCache      map[string]*retinav1alpha1.TracesConfiguration
+	tracesModule tm.ModuleInterface
+	l            *log.ZapLogger
+}
+
+func New(client client.Client, scheme *runtime.Scheme, tracesModule tm.ModuleInterface) *TracesConfigurationReconciler {
+	return &TracesConfigurationReconciler{
+		Mutex:        &sync.Mutex{},
+		l:            log.Logger().Named(string("tracesconfiguration-controller")),
+		Client:       client,
+		Scheme:       scheme,
+		tcCache:      make(map[string]*retinav1alpha1.TracesConfiguration),
+		tracesModule: tracesModule,
+	}
+}
+
+//+kubebuilder:rbac:groups=retina.sh,resources=tracesconfigurations,verbs=get;list;watch
+
+func (r *TracesConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	tc := &retinav1alpha1.TracesConfiguration{}
+	if err := r.Client.Get(ctx, req.NamespacedName, tc); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Object not found, it has probably been deleted
+			r.l.Info("deleted", zap.String("name", req.NamespacedName.String()))
+			r.Lock()
+			defer r.Unlock()
+			if _, ok := r.tcCache[req.NamespacedName.String()]; ok {
+				delete(r.tcCache, req.NamespacedName.String())
+				r.l.Info("deleted from cache", zap.String("name", req.NamespacedName.String()))
+				if err := r.tracesModule.Reconcile(nil); err != nil {
+					r.l.Error("error removing traces configuration", zap.String("name", req.NamespacedName.String()), zap.Error(err))
+				}
+			}
+			return ctrl.Result{}, nil
+		}
+		r.l.Info("error getting tracesconfiguration", zap.String("name", req.NamespacedName.String()))
+		return ctrl.Result{}, err
+	}
+
+	r.l.Info("reconciled", zap.String("name", req.NamespacedName.String()))
+	r.Lock()
+	defer r.Unlock()
+	if len(r.tcCache) > 0 && r.tcCache[req.NamespacedName.String()] == nil {
+		r.l.Error("Traces Configuration is already configured on this cluster, cannot reconcile this new config.", zap.String("name", req.NamespacedName.String()))
+		return ctrl.Result{}, nil
+	}
+
+	if tc.Status == nil || tc.Status.State != retinav1alpha1.StateAccepted {
+		r.l.Info("ignoring this CRD as it is not configured and accepted by operator")
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.tracesModule.Reconcile(tc.Spec); err != nil {
+		r.l.Error("error reconciling traces configuration", zap.String("name", req.NamespacedName.String()), zap.Error(err))
+		return ctrl.Result{}, nil
+	}
+	r.tcCache[req.NamespacedName.String()] = tc
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TracesConfigurationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&retinav1alpha1.TracesConfiguration{}).
+		Complete(r)
+}
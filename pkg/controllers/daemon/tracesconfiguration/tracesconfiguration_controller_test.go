@@ -0,0 +1,95 @@
+/*
+Copyright 2023.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracesconfigurationcontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/log"
+	tm "github.com/microsoft/retina/pkg/module/traces"
+)
+
+var fakescheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(fakescheme))
+	utilruntime.Must(retinav1alpha1.AddToScheme(fakescheme))
+}
+
+func newTracesConfiguration(name, state string) *retinav1alpha1.TracesConfiguration {
+	return &retinav1alpha1.TracesConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: &retinav1alpha1.TracesSpec{
+			TraceConfiguration: []*retinav1alpha1.TraceConfiguration{
+				{TraceCaptureLevel: retinav1alpha1.AllPacketsCapture},
+			},
+		},
+		Status: &retinav1alpha1.TracesStatus{State: state},
+	}
+}
+
+func TestTracesConfigurationReconciler_Reconcile(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	accepted := newTracesConfiguration("accepted", retinav1alpha1.StateAccepted)
+	errored := newTracesConfiguration("errored", retinav1alpha1.StateErrored)
+	second := newTracesConfiguration("second", retinav1alpha1.StateAccepted)
+	c := fake.NewClientBuilder().WithScheme(fakescheme).WithObjects(accepted, errored, second).Build()
+
+	m := tm.NewMockModuleInterface(mockCtrl)
+	r := New(c, fakescheme, m)
+
+	// Configurations that were not accepted by the operator are ignored.
+	reconcile(t, r, "errored")
+	assert.Empty(t, r.tcCache)
+
+	m.EXPECT().Reconcile(gomock.Any()).DoAndReturn(func(spec *retinav1alpha1.TracesSpec) error {
+		assert.Equal(t, accepted.Spec, spec)
+		return nil
+	}).Times(1)
+	reconcile(t, r, "accepted")
+	assert.Len(t, r.tcCache, 1)
+
+	// Only one configuration is reconciled per cluster.
+	reconcile(t, r, "second")
+	assert.Len(t, r.tcCache, 1)
+
+	// Deleting the accepted configuration removes all the trace rules.
+	require.NoError(t, c.Delete(context.TODO(), accepted))
+	m.EXPECT().Reconcile(nil).Return(nil).Times(1)
+	reconcile(t, r, "accepted")
+	assert.Empty(t, r.tcCache)
+}
+
+func reconcile(t *testing.T, r *TracesConfigurationReconciler, name string) {
+	t.Helper()
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+}
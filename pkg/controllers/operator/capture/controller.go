@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -61,6 +62,12 @@ type CaptureReconciler struct {
 	captureToPodTranslator *pkgcapture.CaptureToPodTranslator
 
 	managedStorageAccountManager *managedOutputLocation.StorageAccountManager
+
+	// recorder records the events of the Captures, and is nil until the reconciler is set up with a manager.
+	recorder record.EventRecorder
+
+	// clock returns the current time to schedule the runs of scheduled Captures, and defaults to time.Now.
+	clock func() time.Time
 }
 
 func NewCaptureReconciler(c client.Client, scheme *runtime.Scheme, kubeClient kubernetes.Interface, captureConfig config.CaptureConfig) (*CaptureReconciler, error) {
@@ -91,8 +98,11 @@ func NewCaptureReconciler(c client.Client, scheme *runtime.Scheme, kubeClient ku
 //+kubebuilder:rbac:groups=batch,resources=jobs/status,verbs=get
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list
-//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list
+//+kubebuilder:rbac:groups="",resources=services,verbs=get;list
+//+kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -176,6 +186,7 @@ func (cr *CaptureReconciler) updateCaptureStatusFromJobs(ctx context.Context, ca
 	capture.Status.Active = int32(len(activeJobs))
 	capture.Status.Failed = int32(len(failedJobs))
 	capture.Status.Succeeded = int32(len(successfulJobs))
+	cr.updateCaptureNodeStatuses(ctx, capture, captureJobs)
 	// Once we detect jobs are in failed state, we'll update the status of the Capture to error, meanwhile we keep
 	// updating the status of the Capture to inProgress if there are still active jobs.
 	if len(failedJobs) != 0 {
@@ -218,6 +229,17 @@ func (cr *CaptureReconciler) updateCaptureStatusFromJobs(ctx context.Context, ca
 	return cr.updateStatus(ctx, capture)
 }
 
+// updateCaptureNodeStatuses sets the results of the capture on the nodes of the jobs, which are reported by the capture
+// pods. The previous results are kept when the capture pods cannot be listed.
+func (cr *CaptureReconciler) updateCaptureNodeStatuses(ctx context.Context, capture *retinav1alpha1.Capture, captureJobs []batchv1.Job) {
+	capturePodList := &corev1.PodList{}
+	if err := cr.Client.List(ctx, capturePodList, client.InNamespace(capture.Namespace), client.MatchingLabels(captureUtils.GetContainerLabelsFromCaptureName(capture.Name))); err != nil {
+		cr.logger.Error("Failed to list Capture pods", zap.Error(err), zap.String("Capture", capture.Namespace+"/"+capture.Name))
+		return
+	}
+	capture.Status.Nodes = pkgcapture.NodeStatusesFromJobs(captureJobs, capturePodList.Items)
+}
+
 func (cr *CaptureReconciler) createJobsFromCapture(ctx context.Context, capture *retinav1alpha1.Capture) (ctrl.Result, error) {
 	captureRef := types.NamespacedName{
 		Namespace: capture.Namespace,
@@ -291,6 +313,10 @@ func (cr *CaptureReconciler) handleUpdate(ctx context.Context, capture *retinav1
 		Name:      capture.Name,
 	}
 
+	if capture.Spec.Schedule != "" {
+		return cr.handleScheduled(ctx, capture)
+	}
+
 	// create resources if not found
 	captureJobList := &batchv1.JobList{}
 	if err := apiretry.Do(
@@ -304,7 +330,16 @@ func (cr *CaptureReconciler) handleUpdate(ctx context.Context, capture *retinav1
 
 	// Once the jobs are created, we'll update the status of the Capture according to the status of the jobs.
 	if len(captureJobList.Items) != 0 {
-		return cr.updateCaptureStatusFromJobs(ctx, capture, captureJobList.Items)
+		if err := cr.propagateTriggerAnnotation(ctx, capture); err != nil {
+			cr.logger.Error("Failed to propagate Capture trigger annotation", zap.Error(err), zap.String("Capture", captureRef.String()))
+			return ctrl.Result{}, fmt.Errorf("failed to propagate Capture trigger annotation: %w", err)
+		}
+		result, err := cr.updateCaptureStatusFromJobs(ctx, capture, captureJobList.Items)
+		if err != nil || !meta.IsStatusConditionTrue(capture.Status.Conditions, string(retinav1alpha1.CaptureComplete)) {
+			return result, err
+		}
+		// The capture files are all uploaded once the Capture is complete.
+		return ctrl.Result{RequeueAfter: cr.enforceRetention(ctx, capture)}, nil
 	}
 
 	// create SAS URL and then secret for the Capture if managed storage account is enabled.
@@ -351,6 +386,40 @@ func (cr *CaptureReconciler) handleUpdate(ctx context.Context, capture *retinav1
 	return cr.createJobsFromCapture(ctx, capture)
 }
 
+// propagateTriggerAnnotation sets the trigger annotation of a ring buffer Capture on its running capture pods, which
+// read their annotations from a downward API volume.
+func (cr *CaptureReconciler) propagateTriggerAnnotation(ctx context.Context, capture *retinav1alpha1.Capture) error {
+	ringBuffer := capture.Spec.CaptureConfiguration.CaptureOption.RingBuffer
+	if ringBuffer == nil || !ringBuffer.Triggers.Annotation {
+		return nil
+	}
+	trigger := capture.Annotations[captureConstants.CaptureTriggerAnnotation]
+	if trigger == "" {
+		return nil
+	}
+
+	capturePodList := &corev1.PodList{}
+	if err := cr.Client.List(ctx, capturePodList, client.InNamespace(capture.Namespace), client.MatchingLabels(captureUtils.GetContainerLabelsFromCaptureName(capture.Name))); err != nil {
+		return fmt.Errorf("failed to list Capture pods: %w", err)
+	}
+	for i := range capturePodList.Items {
+		pod := &capturePodList.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.Annotations[captureConstants.CaptureTriggerAnnotation] == trigger {
+			continue
+		}
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[captureConstants.CaptureTriggerAnnotation] = trigger
+		if err := cr.Client.Patch(ctx, pod, patch); err != nil {
+			return fmt.Errorf("failed to patch Capture pod %s: %w", pod.Name, err)
+		}
+		cr.logger.Info("Capture trigger annotation is set on the Capture pod", zap.String("Capture pod", pod.Name), zap.String("trigger", trigger))
+	}
+	return nil
+}
+
 func (cr *CaptureReconciler) managedStorageAccountEnabled() bool {
 	return cr.managedStorageAccountManager != nil
 }
@@ -431,6 +500,7 @@ func getSecretFromCapture(capture *retinav1alpha1.Capture, sasURL string) corev1
 
 // SetupWithManager sets up the controller with the Manager.
 func (cr *CaptureReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	cr.recorder = mgr.GetEventRecorderFor("capture-controller")
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&retinav1alpha1.Capture{}).
 		Owns(&batchv1.Job{}). // Once the job owned by capture is created /deleted/updated, the capture will be reconciled.
@@ -462,6 +532,14 @@ func (cr *CaptureReconciler) updateStatus(ctx context.Context, capture *retinav1
 	return ctrl.Result{}, nil
 }
 
+// event records an event of the Capture.
+func (cr *CaptureReconciler) event(capture *retinav1alpha1.Capture, eventType, reason, messageFmt string, args ...interface{}) {
+	if cr.recorder == nil {
+		return
+	}
+	cr.recorder.Eventf(capture, eventType, reason, messageFmt, args...)
+}
+
 func managedSecretName(captureName string) string {
 	return fmt.Sprintf("managed-%s", captureName)
 }
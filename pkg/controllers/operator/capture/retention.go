@@ -0,0 +1,95 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	"github.com/microsoft/retina/pkg/capture/retention"
+)
+
+// retentionCheckInterval is how often the retention policy of a Capture is enforced on its object storage output
+// locations. The capture files on the host path of the nodes are removed by the agents.
+const retentionCheckInterval = time.Hour
+
+var retentionMetrics = retention.NewMetrics(crmetrics.Registry)
+
+// enforceRetention removes the capture files of the Capture exceeding its retention policy from the S3 and blob
+// output locations, and returns when to enforce the policy again, which is zero when there is nothing to enforce.
+// Failures are reported as events of the Capture and retried on the next check.
+func (cr *CaptureReconciler) enforceRetention(ctx context.Context, capture *retinav1alpha1.Capture) time.Duration {
+	policy := retention.PolicyFromCapture(capture)
+	outputConfiguration := capture.Spec.OutputConfiguration
+	if !policy.Enabled() || (outputConfiguration.S3Upload == nil && outputConfiguration.BlobUpload == nil) {
+		return 0
+	}
+
+	now := cr.now()
+	if s3Upload := outputConfiguration.S3Upload; s3Upload != nil {
+		removed, err := cr.enforceS3Retention(ctx, capture, s3Upload, policy, now)
+		cr.reportRetention(capture, retention.LocationS3Upload, removed, err)
+	}
+	if blobUpload := outputConfiguration.BlobUpload; blobUpload != nil {
+		removed, err := cr.enforceBlobRetention(ctx, capture, *blobUpload, policy, now)
+		cr.reportRetention(capture, retention.LocationBlobUpload, removed, err)
+	}
+	return retentionCheckInterval
+}
+
+func (cr *CaptureReconciler) enforceS3Retention(ctx context.Context, capture *retinav1alpha1.Capture, s3Upload *retinav1alpha1.S3Upload, policy retention.Policy, now time.Time) ([]retention.Expired, error) {
+	s3Client, err := cr.newS3Client(ctx, capture.Namespace, s3Upload)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := retention.EnforceS3(ctx, s3Client, s3Upload.Bucket, s3Upload.Path, capture.Name, policy, now)
+	if err != nil {
+		return removed, fmt.Errorf("failed to enforce retention on S3: %w", err)
+	}
+	return removed, nil
+}
+
+func (cr *CaptureReconciler) enforceBlobRetention(ctx context.Context, capture *retinav1alpha1.Capture, secretName string, policy retention.Policy, now time.Time) ([]retention.Expired, error) {
+	secret := &corev1.Secret{}
+	if err := cr.Client.Get(ctx, types.NamespacedName{Namespace: capture.Namespace, Name: secretName}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get blob upload secret: %w", err)
+	}
+	sasURL := string(secret.Data[captureConstants.CaptureOutputLocationBlobUploadSecretKey])
+	removed, err := retention.EnforceBlob(ctx, sasURL, capture.Name, policy, now)
+	if err != nil {
+		return removed, fmt.Errorf("failed to enforce retention on blob storage: %w", err)
+	}
+	return removed, nil
+}
+
+func (cr *CaptureReconciler) reportRetention(capture *retinav1alpha1.Capture, location string, removed []retention.Expired, err error) {
+	captureRef := types.NamespacedName{
+		Namespace: capture.Namespace,
+		Name:      capture.Name,
+	}
+
+	retentionMetrics.Observe(location, removed)
+	if len(removed) != 0 {
+		names := make([]string, 0, len(removed))
+		for _, e := range removed {
+			names = append(names, path.Base(e.Name))
+		}
+		cr.logger.Info("Capture files are removed by the retention policy", zap.String("Capture", captureRef.String()), zap.String("location", location), zap.Strings("files", names))
+		cr.event(capture, corev1.EventTypeNormal, retention.EventReasonCaptureFilesRemoved, "Removed %d capture files from %s: %s", len(removed), location, strings.Join(names, ", "))
+	}
+	if err != nil {
+		cr.logger.Error("Failed to enforce Capture retention", zap.Error(err), zap.String("Capture", captureRef.String()), zap.String("location", location))
+		cr.event(capture, corev1.EventTypeWarning, retention.EventReasonRetentionFailed, "Failed to enforce the retention policy on %s: %s", location, err)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+)
+
+// newS3Stub serves the listing and the deletion of the objects of a bucket.
+func newS3Stub(t *testing.T, objects map[string]bool) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/bucket"), "/")
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			prefix := r.URL.Query().Get("prefix")
+			var b strings.Builder
+			b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><ListBucketResult><Name>bucket</Name><IsTruncated>false</IsTruncated>`)
+			for k := range objects {
+				if strings.HasPrefix(k, prefix) {
+					fmt.Fprintf(&b, "<Contents><Key>%s</Key><Size>10</Size></Contents>", k)
+				}
+			}
+			b.WriteString("</ListBucketResult>")
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = io.WriteString(w, b.String())
+		case r.Method == http.MethodDelete && objects[key]:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestEnforceRetention(t *testing.T) {
+	objects := map[string]bool{
+		"retina/cap-node1-20240101000000UTC.tar.gz":   true,
+		"retina/cap-node1-20240101010000UTC.tar.gz":   true,
+		"retina/cap-node2-20240101000000UTC.tar.gz":   true,
+		"retina/other-node1-20231201000000UTC.tar.gz": true,
+	}
+	srv := newS3Stub(t, objects)
+
+	capture := scheduledCapture("")
+	capture.Spec.OutputConfiguration = retinav1alpha1.OutputConfiguration{
+		S3Upload:  &retinav1alpha1.S3Upload{Endpoint: srv.URL, Bucket: "bucket", Path: "retina", SecretName: "s3"},
+		Retention: &retinav1alpha1.RetentionPolicy{MaxCount: ptr.To(int32(1))},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "capture"},
+		Data: map[string][]byte{
+			captureConstants.CaptureOutputLocationS3UploadAccessKeyID:     []byte("akid"),
+			captureConstants.CaptureOutputLocationS3UploadSecretAccessKey: []byte("secret"),
+		},
+	}
+	cr, _ := newScheduleReconciler(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), secret)
+	recorder := record.NewFakeRecorder(10)
+	cr.recorder = recorder
+
+	assert.Equal(t, retentionCheckInterval, cr.enforceRetention(context.Background(), capture))
+	kept := []string{}
+	for k := range objects {
+		kept = append(kept, k)
+	}
+	sort.Strings(kept)
+	wantKept := []string{
+		"retina/cap-node1-20240101010000UTC.tar.gz",
+		"retina/cap-node2-20240101000000UTC.tar.gz",
+		"retina/other-node1-20231201000000UTC.tar.gz",
+	}
+	assert.Equal(t, wantKept, kept)
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal CaptureFilesRemoved Removed 1 capture files from S3Upload: cap-node1-20240101000000UTC.tar.gz", <-recorder.Events)
+
+	// A missing secret is reported as a warning, and retried on the next check.
+	capture.Spec.OutputConfiguration.S3Upload.SecretName = "missing"
+	assert.Equal(t, retentionCheckInterval, cr.enforceRetention(context.Background(), capture))
+	require.Len(t, recorder.Events, 1)
+	assert.True(t, strings.HasPrefix(<-recorder.Events, "Warning CaptureRetentionFailed Failed to enforce the retention policy on S3Upload"))
+
+	// Nothing is enforced without a retention policy.
+	capture.Spec.OutputConfiguration.Retention = nil
+	assert.Zero(t, cr.enforceRetention(context.Background(), capture))
+	assert.Empty(t, recorder.Events)
+}
@@ -0,0 +1,413 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	pkgcapture "github.com/microsoft/retina/pkg/capture"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	"github.com/microsoft/retina/pkg/capture/outputlocation"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
+	"github.com/microsoft/retina/pkg/common/apiretry"
+	"github.com/microsoft/retina/pkg/label"
+)
+
+const (
+	// defaultCaptureHistoryLimit is the number of runs of a scheduled Capture kept when no history limit is set.
+	defaultCaptureHistoryLimit = 3
+
+	captureErrorReasonInvalidSchedule = "InvalidSchedule"
+	captureErrorReasonPruneRunFailed  = "PruneRunFailed"
+
+	captureScheduledReason  = "Scheduled"
+	captureScheduledMessage = "The next run is scheduled at %s"
+)
+
+// handleScheduled creates the jobs of a run of a scheduled Capture on each tick of its schedule, records the runs in
+// the status from the status of their jobs, and prunes the runs beyond the history limit.
+func (cr *CaptureReconciler) handleScheduled(ctx context.Context, capture *retinav1alpha1.Capture) (ctrl.Result, error) {
+	captureRef := types.NamespacedName{
+		Namespace: capture.Namespace,
+		Name:      capture.Name,
+	}
+
+	schedule, err := cron.ParseStandard(capture.Spec.Schedule)
+	if err != nil {
+		cr.logger.Error("Failed to parse Capture schedule", zap.Error(err), zap.String("Capture", captureRef.String()))
+		meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+			Type:    string(retinav1alpha1.CaptureError),
+			Status:  metav1.ConditionTrue,
+			Reason:  captureErrorReasonInvalidSchedule,
+			Message: fmt.Sprintf("Invalid schedule %q: %s", capture.Spec.Schedule, err),
+		})
+		return cr.updateStatus(ctx, capture)
+	}
+
+	captureJobList := &batchv1.JobList{}
+	if err := apiretry.Do(
+		func() error {
+			return cr.Client.List(ctx, captureJobList, client.InNamespace(capture.Namespace), client.MatchingLabels(captureUtils.GetJobLabelsFromCaptureName(capture.Name)))
+		},
+	); err != nil {
+		cr.logger.Error("Failed to list Capture jobs", zap.Error(err), zap.String("Capture", captureRef.String()))
+		return ctrl.Result{}, fmt.Errorf("failed to list Capture jobs: %w", err)
+	}
+	runJobs := map[string][]batchv1.Job{}
+	for _, job := range captureJobList.Items {
+		runJobs[job.Labels[label.CaptureRunLabel]] = append(runJobs[job.Labels[label.CaptureRunLabel]], job)
+	}
+	updateCaptureRunsFromJobs(capture, runJobs)
+
+	now := cr.now()
+	lastScheduleTime := capture.CreationTimestamp.Time
+	if capture.Status.LastScheduleTime != nil {
+		lastScheduleTime = capture.Status.LastScheduleTime.Time
+	}
+	dueTime, nextTime := scheduleTimes(schedule, lastScheduleTime, now)
+	if !dueTime.IsZero() {
+		capture.Status.LastScheduleTime = &metav1.Time{Time: dueTime}
+		if latestRun := latestCaptureRun(capture); latestRun != nil && latestRun.CompletionTime == nil {
+			cr.logger.Info("Skip the scheduled Capture run as the previous run is in progress", zap.String("Capture", captureRef.String()), zap.String("run", latestRun.Name))
+		} else if err := cr.createCaptureRun(ctx, capture, dueTime); err != nil {
+			cr.logger.Error("Failed to create Capture run", zap.Error(err), zap.String("Capture", captureRef.String()))
+		}
+	}
+
+	if err := cr.pruneCaptureRuns(ctx, capture, runJobs); err != nil {
+		cr.logger.Error("Failed to prune Capture runs", zap.Error(err), zap.String("Capture", captureRef.String()))
+		meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+			Type:    string(retinav1alpha1.CaptureError),
+			Status:  metav1.ConditionTrue,
+			Reason:  captureErrorReasonPruneRunFailed,
+			Message: err.Error(),
+		})
+	}
+
+	updateScheduledCaptureStatus(capture, nextTime)
+	if latestRun := latestCaptureRun(capture); latestRun != nil {
+		cr.updateCaptureNodeStatuses(ctx, capture, runJobs[latestRun.Name])
+	}
+	if _, err := cr.updateStatus(ctx, capture); err != nil {
+		return ctrl.Result{}, err
+	}
+	requeueAfter := nextTime.Sub(now)
+	if retentionAfter := cr.enforceRetention(ctx, capture); retentionAfter > 0 && retentionAfter < requeueAfter {
+		requeueAfter = retentionAfter
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// scheduleTimes returns the latest time the schedule was due since the last schedule time, which is zero when the
+// schedule was not due, and the next time the schedule is due.
+func scheduleTimes(schedule cron.Schedule, lastScheduleTime, now time.Time) (time.Time, time.Time) {
+	var dueTime time.Time
+	nextTime := schedule.Next(lastScheduleTime)
+	for !nextTime.After(now) {
+		dueTime = nextTime
+		nextTime = schedule.Next(nextTime)
+	}
+	return dueTime, nextTime
+}
+
+// createCaptureRun creates the jobs of a new run of the scheduled Capture and records the run in the status, or sets
+// the error condition of the Capture.
+func (cr *CaptureReconciler) createCaptureRun(ctx context.Context, capture *retinav1alpha1.Capture, scheduleTime time.Time) error {
+	captureRef := types.NamespacedName{
+		Namespace: capture.Namespace,
+		Name:      capture.Name,
+	}
+
+	jobs, err := cr.captureToPodTranslator.TranslateCaptureToJobs(ctx, capture)
+	if err != nil {
+		cr.logger.Error("Failed to translate Capture to jobs", zap.Error(err), zap.String("Capture", captureRef.String()))
+		var errorReason string
+		switch err.(type) {
+		case pkgcapture.CaptureJobNumExceedLimitError:
+			errorReason = captureErrorReasonExceedJobNumLimit
+		case pkgcapture.SecretNotFoundError:
+			errorReason = captureErrorReasonFindSecretFailed
+		default:
+			errorReason = captureErrorReasonOthers
+		}
+		meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+			Type:    string(retinav1alpha1.CaptureError),
+			Status:  metav1.ConditionTrue,
+			Reason:  errorReason,
+			Message: err.Error(),
+		})
+		return err
+	}
+
+	// All the jobs of a run share the start timestamp in the names of their capture files.
+	runName := jobEnv(jobs[0], captureConstants.CaptureStartTimestampEnvKey)
+	created := 0
+	defer func() {
+		// Record the run of the created jobs even if creating the other jobs failed, so that they are pruned.
+		if created != 0 {
+			capture.Status.Runs = append(capture.Status.Runs, retinav1alpha1.CaptureRun{
+				Name:         runName,
+				ScheduleTime: metav1.Time{Time: scheduleTime},
+				Active:       int32(created),
+			})
+		}
+	}()
+	for _, job := range jobs {
+		job.Labels[label.CaptureRunLabel] = runName
+		if err := controllerutil.SetControllerReference(capture, job, cr.scheme); err != nil {
+			return fmt.Errorf("failed to set owner of Capture job: %w", err)
+		}
+		if err := cr.Client.Create(ctx, job); err != nil {
+			cr.logger.Error("Failed to create Capture job", zap.Error(err), zap.String("Capture", captureRef.String()))
+			meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+				Type:    string(retinav1alpha1.CaptureError),
+				Status:  metav1.ConditionTrue,
+				Reason:  captureErrorReasonCreateJobFailed,
+				Message: fmt.Sprintf("Failed to create Capture job %s/%s", job.Name, job.Namespace),
+			})
+			return fmt.Errorf("failed to create Capture job: %w", err)
+		}
+		created++
+		cr.logger.Info("Capture job is created", zap.String("namespace", capture.Namespace), zap.String("Capture job", job.Name), zap.String("run", runName))
+	}
+
+	meta.RemoveStatusCondition(&capture.Status.Conditions, string(retinav1alpha1.CaptureError))
+	return nil
+}
+
+// updateCaptureRunsFromJobs updates the job counts and the completion time of the runs of the Capture.
+func updateCaptureRunsFromJobs(capture *retinav1alpha1.Capture, runJobs map[string][]batchv1.Job) {
+	for i := range capture.Status.Runs {
+		run := &capture.Status.Runs[i]
+		if run.CompletionTime != nil {
+			continue
+		}
+		jobs := runJobs[run.Name]
+
+		run.Active, run.Succeeded, run.Failed = 0, 0, 0
+		var completionTime *metav1.Time
+		for _, job := range jobs {
+			switch jobFinishedType(&job) {
+			case batchv1.JobComplete:
+				run.Succeeded++
+			case batchv1.JobFailed:
+				run.Failed++
+			default:
+				run.Active++
+			}
+			if job.Status.CompletionTime != nil && (completionTime == nil || job.Status.CompletionTime.After(completionTime.Time)) {
+				completionTime = job.Status.CompletionTime
+			}
+		}
+		// The run is also complete when its jobs are removed.
+		if run.Active == 0 {
+			if completionTime == nil {
+				completionTime = &metav1.Time{Time: time.Now()}
+			}
+			run.CompletionTime = completionTime.DeepCopy()
+		}
+	}
+}
+
+func jobFinishedType(job *batchv1.Job) batchv1.JobConditionType {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return c.Type
+		}
+	}
+	return ""
+}
+
+// updateScheduledCaptureStatus sets the job counts and the conditions of the Capture from its latest run.
+func updateScheduledCaptureStatus(capture *retinav1alpha1.Capture, nextTime time.Time) {
+	latestRun := latestCaptureRun(capture)
+	if latestRun == nil {
+		meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+			Type:    string(retinav1alpha1.CaptureComplete),
+			Status:  metav1.ConditionFalse,
+			Reason:  captureScheduledReason,
+			Message: fmt.Sprintf(captureScheduledMessage, nextTime.Format(time.RFC3339)),
+		})
+		return
+	}
+
+	capture.Status.Active = latestRun.Active
+	capture.Status.Succeeded = latestRun.Succeeded
+	capture.Status.Failed = latestRun.Failed
+	capture.Status.CompletionTime = latestRun.CompletionTime
+	if latestRun.Failed != 0 {
+		meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+			Type:    string(retinav1alpha1.CaptureError),
+			Status:  metav1.ConditionTrue,
+			Reason:  captureErrorReasonRunJobFailed,
+			Message: fmt.Sprintf(captureFailedJobFailedMessage, latestRun.Failed),
+		})
+	}
+	if latestRun.CompletionTime == nil {
+		total := latestRun.Active + latestRun.Succeeded + latestRun.Failed
+		meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+			Type:    string(retinav1alpha1.CaptureComplete),
+			Status:  metav1.ConditionFalse,
+			Reason:  captureInPogressReason,
+			Message: fmt.Sprintf(captureInPogressMessage, latestRun.Active, total),
+		})
+		return
+	}
+	// A scheduled Capture is never complete, and waits for its next run.
+	meta.SetStatusCondition(&capture.Status.Conditions, metav1.Condition{
+		Type:    string(retinav1alpha1.CaptureComplete),
+		Status:  metav1.ConditionFalse,
+		Reason:  captureScheduledReason,
+		Message: fmt.Sprintf(captureScheduledMessage, nextTime.Format(time.RFC3339)),
+	})
+}
+
+func latestCaptureRun(capture *retinav1alpha1.Capture) *retinav1alpha1.CaptureRun {
+	if len(capture.Status.Runs) == 0 {
+		return nil
+	}
+	return &capture.Status.Runs[len(capture.Status.Runs)-1]
+}
+
+// pruneCaptureRuns removes the finished runs beyond the history limit of the Capture, with their jobs and their
+// capture files in HostPath and S3.
+func (cr *CaptureReconciler) pruneCaptureRuns(ctx context.Context, capture *retinav1alpha1.Capture, runJobs map[string][]batchv1.Job) error {
+	historyLimit := defaultCaptureHistoryLimit
+	if capture.Spec.HistoryLimit != nil {
+		historyLimit = int(*capture.Spec.HistoryLimit)
+	}
+
+	for len(capture.Status.Runs) > historyLimit {
+		run := capture.Status.Runs[0]
+		if run.CompletionTime == nil {
+			return nil
+		}
+		if err := cr.pruneCaptureRun(ctx, capture, run.Name, runJobs[run.Name]); err != nil {
+			return fmt.Errorf("failed to prune Capture run %s: %w", run.Name, err)
+		}
+		capture.Status.Runs = capture.Status.Runs[1:]
+	}
+	return nil
+}
+
+func (cr *CaptureReconciler) pruneCaptureRun(ctx context.Context, capture *retinav1alpha1.Capture, runName string, jobs []batchv1.Job) error {
+	captureRef := types.NamespacedName{
+		Namespace: capture.Namespace,
+		Name:      capture.Name,
+	}
+
+	if hostPath := capture.Spec.OutputConfiguration.HostPath; hostPath != nil && *hostPath != "" && len(jobs) != 0 {
+		nodes := map[string]string{}
+		for i := range jobs {
+			nodeOS := "linux"
+			if command := jobs[i].Spec.Template.Spec.Containers[0].Command; len(command) != 0 && command[0] == captureConstants.CaptureContainerEntrypointWin {
+				nodeOS = "windows"
+			}
+			nodes[jobEnv(&jobs[i], captureConstants.NodeHostNameEnvKey)] = nodeOS
+		}
+		pruneJobs, err := cr.captureToPodTranslator.RenderPruneJobs(capture, nodes, []string{runName})
+		if err != nil {
+			return fmt.Errorf("failed to render prune jobs: %w", err)
+		}
+		for _, job := range pruneJobs {
+			if err := controllerutil.SetControllerReference(capture, job, cr.scheme); err != nil {
+				return fmt.Errorf("failed to set owner of prune job: %w", err)
+			}
+			if err := cr.Client.Create(ctx, job); err != nil {
+				return fmt.Errorf("failed to create prune job: %w", err)
+			}
+		}
+		cr.logger.Info("Capture prune jobs are created", zap.String("Capture", captureRef.String()), zap.String("run", runName), zap.Int("jobs", len(pruneJobs)))
+	}
+
+	if s3Upload := capture.Spec.OutputConfiguration.S3Upload; s3Upload != nil {
+		if err := cr.pruneS3(ctx, capture, s3Upload, runName); err != nil {
+			return err
+		}
+	}
+
+	deletePropagationBackground := metav1.DeletePropagationBackground
+	runLabels := captureUtils.GetJobLabelsFromCaptureName(capture.Name)
+	runLabels[label.CaptureRunLabel] = runName
+	if err := apiretry.Do(
+		func() error {
+			return cr.Client.DeleteAllOf(ctx, &batchv1.Job{}, client.InNamespace(capture.Namespace), &client.DeleteAllOfOptions{
+				ListOptions: client.ListOptions{
+					LabelSelector: labels.SelectorFromSet(runLabels),
+				},
+				DeleteOptions: client.DeleteOptions{
+					PropagationPolicy: &deletePropagationBackground,
+				},
+			})
+		},
+	); err != nil {
+		return fmt.Errorf("failed to delete Capture jobs: %w", err)
+	}
+	cr.logger.Info("Capture run is pruned", zap.String("Capture", captureRef.String()), zap.String("run", runName))
+	return nil
+}
+
+func (cr *CaptureReconciler) pruneS3(ctx context.Context, capture *retinav1alpha1.Capture, s3Upload *retinav1alpha1.S3Upload, runName string) error {
+	s3Client, err := cr.newS3Client(ctx, capture.Namespace, s3Upload)
+	if err != nil {
+		return err
+	}
+	removed, err := pkgcapture.PruneS3(ctx, s3Client, s3Upload.Bucket, s3Upload.Path, capture.Name, []string{runName})
+	if err != nil {
+		return fmt.Errorf("failed to prune S3 capture files: %w", err)
+	}
+	cr.logger.Info("Capture files are removed from S3", zap.String("Capture", capture.Name), zap.Strings("files", removed))
+	return nil
+}
+
+// newS3Client returns a client of the S3 upload output location with the access keys of its secret.
+func (cr *CaptureReconciler) newS3Client(ctx context.Context, namespace string, s3Upload *retinav1alpha1.S3Upload) (*s3.Client, error) {
+	var accessKeyID, secretAccessKey string
+	if s3Upload.SecretName != "" {
+		secret := &corev1.Secret{}
+		if err := cr.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: s3Upload.SecretName}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get S3 upload secret: %w", err)
+		}
+		accessKeyID = string(secret.Data[captureConstants.CaptureOutputLocationS3UploadAccessKeyID])
+		secretAccessKey = string(secret.Data[captureConstants.CaptureOutputLocationS3UploadSecretAccessKey])
+	}
+
+	s3Client, err := outputlocation.NewS3Client(ctx, s3Upload.Endpoint, s3Upload.Region, accessKeyID, secretAccessKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+	return s3Client, nil
+}
+
+func jobEnv(job *batchv1.Job, name string) string {
+	for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+		if env.Name == name {
+			return env.Value
+		}
+	}
+	return ""
+}
+
+func (cr *CaptureReconciler) now() time.Time {
+	if cr.clock != nil {
+		return cr.clock()
+	}
+	return time.Now()
+}
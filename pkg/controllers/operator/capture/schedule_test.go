@@ -0,0 +1,190 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	pkgcapture "github.com/microsoft/retina/pkg/capture"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/microsoft/retina/pkg/label"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+func scheduledCapture(schedule string) *retinav1alpha1.Capture {
+	return &retinav1alpha1.Capture{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "cap",
+			Namespace:         "capture",
+			CreationTimestamp: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)),
+		},
+		Spec: retinav1alpha1.CaptureSpec{
+			CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+				CaptureTarget: retinav1alpha1.CaptureTarget{
+					NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelHostname: "node1"}},
+				},
+				CaptureOption: retinav1alpha1.CaptureOption{Duration: &metav1.Duration{Duration: time.Minute}},
+			},
+			OutputConfiguration: retinav1alpha1.OutputConfiguration{HostPath: ptr.To("/tmp/capture")},
+			Schedule:            schedule,
+		},
+	}
+}
+
+func runJob(run string, finished batchv1.JobConditionType) *batchv1.Job {
+	jobLabels := captureUtils.GetJobLabelsFromCaptureName("cap")
+	jobLabels[label.CaptureRunLabel] = run
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "cap-" + run, Namespace: "capture", Labels: jobLabels},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:    captureConstants.CaptureContainername,
+						Command: []string{captureConstants.CaptureContainerEntrypoint},
+						Env: []corev1.EnvVar{
+							{Name: captureConstants.NodeHostNameEnvKey, Value: "node1"},
+							{Name: captureConstants.CaptureStartTimestampEnvKey, Value: run},
+						},
+					}},
+				},
+			},
+		},
+	}
+	if finished != "" {
+		job.Status.Conditions = []batchv1.JobCondition{{Type: finished, Status: corev1.ConditionTrue}}
+	}
+	return job
+}
+
+func newScheduleReconciler(t *testing.T, now time.Time, objs ...client.Object) (*CaptureReconciler, client.Client) {
+	t.Helper()
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, retinav1alpha1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&retinav1alpha1.Capture{}).WithObjects(objs...).Build()
+	kubeClient := fakeclientset.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{corev1.LabelHostname: "node1", corev1.LabelOSStable: "linux"}},
+	})
+	cr := &CaptureReconciler{
+		Client:                 c,
+		scheme:                 scheme,
+		logger:                 log.Logger().Named("test"),
+		captureToPodTranslator: pkgcapture.NewCaptureToPodTranslator(kubeClient, log.Logger().Named("test"), config.CaptureConfig{CaptureJobNumLimit: 10}),
+		clock:                  func() time.Time { return now },
+	}
+	return cr, c
+}
+
+func getCapture(t *testing.T, c client.Client) *retinav1alpha1.Capture {
+	t.Helper()
+	capture := &retinav1alpha1.Capture{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "capture", Name: "cap"}, capture))
+	return capture
+}
+
+func listJobs(t *testing.T, c client.Client, jobLabels map[string]string) []batchv1.Job {
+	t.Helper()
+	jobList := &batchv1.JobList{}
+	require.NoError(t, c.List(context.Background(), jobList, client.InNamespace("capture"), client.MatchingLabels(jobLabels)))
+	return jobList.Items
+}
+
+func TestHandleScheduledCreatesRun(t *testing.T) {
+	ctx := context.Background()
+	cr, c := newScheduleReconciler(t, time.Date(2024, 1, 1, 0, 5, 10, 0, time.UTC), scheduledCapture("*/5 * * * *"))
+
+	result, err := cr.handleScheduled(ctx, getCapture(t, c))
+	require.NoError(t, err)
+	assert.Equal(t, 4*time.Minute+50*time.Second, result.RequeueAfter)
+
+	jobs := listJobs(t, c, captureUtils.GetJobLabelsFromCaptureName("cap"))
+	require.Len(t, jobs, 1)
+	capture := getCapture(t, c)
+	require.Len(t, capture.Status.Runs, 1)
+	run := capture.Status.Runs[0]
+	assert.Equal(t, jobEnv(&jobs[0], captureConstants.CaptureStartTimestampEnvKey), run.Name)
+	assert.Equal(t, run.Name, jobs[0].Labels[label.CaptureRunLabel])
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC), run.ScheduleTime.UTC())
+	assert.Equal(t, int32(1), run.Active)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC), capture.Status.LastScheduleTime.UTC())
+	assert.Equal(t, captureInPogressReason, meta.FindStatusCondition(capture.Status.Conditions, string(retinav1alpha1.CaptureComplete)).Reason)
+
+	// The next tick is skipped while the run is in progress.
+	cr.clock = func() time.Time { return time.Date(2024, 1, 1, 0, 10, 5, 0, time.UTC) }
+	_, err = cr.handleScheduled(ctx, capture)
+	require.NoError(t, err)
+	assert.Len(t, listJobs(t, c, captureUtils.GetJobLabelsFromCaptureName("cap")), 1)
+	capture = getCapture(t, c)
+	assert.Len(t, capture.Status.Runs, 1)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 10, 0, 0, time.UTC), capture.Status.LastScheduleTime.UTC())
+}
+
+func TestHandleScheduledPrunesRuns(t *testing.T) {
+	ctx := context.Background()
+	capture := scheduledCapture("*/5 * * * *")
+	capture.Spec.HistoryLimit = ptr.To[int32](1)
+	capture.Status = retinav1alpha1.CaptureStatus{
+		LastScheduleTime: &metav1.Time{Time: time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)},
+		Runs: []retinav1alpha1.CaptureRun{
+			{Name: "20240101000000UTC", ScheduleTime: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), Active: 1},
+			{Name: "20240101000500UTC", ScheduleTime: metav1.NewTime(time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)), Active: 1},
+		},
+	}
+	cr, c := newScheduleReconciler(t, time.Date(2024, 1, 1, 0, 6, 0, 0, time.UTC),
+		capture, runJob("20240101000000UTC", batchv1.JobComplete), runJob("20240101000500UTC", batchv1.JobFailed))
+
+	result, err := cr.handleScheduled(ctx, getCapture(t, c))
+	require.NoError(t, err)
+	assert.Equal(t, 4*time.Minute, result.RequeueAfter)
+
+	capture = getCapture(t, c)
+	require.Len(t, capture.Status.Runs, 1)
+	assert.Equal(t, "20240101000500UTC", capture.Status.Runs[0].Name)
+	assert.Equal(t, int32(1), capture.Status.Runs[0].Failed)
+	assert.NotNil(t, capture.Status.Runs[0].CompletionTime)
+	assert.Equal(t, captureErrorReasonRunJobFailed, meta.FindStatusCondition(capture.Status.Conditions, string(retinav1alpha1.CaptureError)).Reason)
+	assert.Equal(t, captureScheduledReason, meta.FindStatusCondition(capture.Status.Conditions, string(retinav1alpha1.CaptureComplete)).Reason)
+
+	jobs := listJobs(t, c, captureUtils.GetJobLabelsFromCaptureName("cap"))
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "20240101000500UTC", jobs[0].Labels[label.CaptureRunLabel])
+
+	pruneJobs := listJobs(t, c, captureUtils.GetPruneJobLabelsFromCaptureName("cap"))
+	require.Len(t, pruneJobs, 1)
+	assert.Equal(t, "20240101000000UTC", jobEnv(&pruneJobs[0], captureConstants.CapturePruneTimestampsEnvKey))
+	assert.Equal(t, "node1", jobEnv(&pruneJobs[0], captureConstants.NodeHostNameEnvKey))
+}
+
+func TestHandleScheduledInvalidSchedule(t *testing.T) {
+	cr, c := newScheduleReconciler(t, time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC), scheduledCapture("every five minutes"))
+
+	_, err := cr.handleScheduled(context.Background(), getCapture(t, c))
+	require.NoError(t, err)
+	capture := getCapture(t, c)
+	assert.Equal(t, captureErrorReasonInvalidSchedule, meta.FindStatusCondition(capture.Status.Conditions, string(retinav1alpha1.CaptureError)).Reason)
+	assert.Empty(t, listJobs(t, c, captureUtils.GetJobLabelsFromCaptureName("cap")))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
+)
+
+func TestUpdateCaptureStatusFromJobsNodes(t *testing.T) {
+	ctx := context.Background()
+	capture := scheduledCapture("")
+
+	job1 := runJob("20240101000000UTC", batchv1.JobComplete)
+	job1.UID = types.UID("job1")
+	job2 := runJob("20240101000000UTC", batchv1.JobFailed)
+	job2.Name, job2.UID = "cap-node2", types.UID("job2")
+	job2.Spec.Template.Spec.Containers[0].Env[0].Value = "node2"
+
+	capturePod := func(job *batchv1.Job, message string) *corev1.Pod {
+		isController := true
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            job.Name + "-pod",
+				Namespace:       "capture",
+				Labels:          captureUtils.GetContainerLabelsFromCaptureName("cap"),
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: job.Name, UID: job.UID, Controller: &isController}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  captureConstants.CaptureContainername,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+				}},
+			},
+		}
+	}
+	cr, c := newScheduleReconciler(t, time.Now(), capture,
+		capturePod(job1, `{"nodeName":"node1","phase":"Succeeded","packetsCaptured":10,"bytesCaptured":1000,"artifacts":[{"location":"HostPath","uri":"/tmp/capture/cap-node1.tar.gz"}]}`),
+		capturePod(job2, `{"nodeName":"node2","phase":"Failed","error":"failed to start tcpdump"}`),
+	)
+
+	_, err := cr.updateCaptureStatusFromJobs(ctx, getCapture(t, c), []batchv1.Job{*job2, *job1})
+	require.NoError(t, err)
+
+	got := getCapture(t, c)
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, string(retinav1alpha1.CaptureError)))
+	assert.Equal(t, []retinav1alpha1.CaptureNodeStatus{
+		{
+			NodeName:        "node1",
+			JobName:         job1.Name,
+			Phase:           retinav1alpha1.CaptureNodeSucceeded,
+			BytesCaptured:   1000,
+			PacketsCaptured: 10,
+			Artifacts:       []retinav1alpha1.CaptureArtifact{{Location: "HostPath", URI: "/tmp/capture/cap-node1.tar.gz"}},
+		},
+		{NodeName: "node2", JobName: "cap-node2", Phase: retinav1alpha1.CaptureNodeFailed, Error: "failed to start tcpdump"},
+	}, got.Status.Nodes)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	captureConstants "github.com/microsoft/retina/pkg/capture/constants"
+	captureUtils "github.com/microsoft/retina/pkg/capture/utils"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+func TestPropagateTriggerAnnotation(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, retinav1alpha1.AddToScheme(scheme))
+
+	capturePod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "capture", Labels: captureUtils.GetContainerLabelsFromCaptureName("cap")},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	otherPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "capture"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+	capture := &retinav1alpha1.Capture{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cap",
+			Namespace:   "capture",
+			Annotations: map[string]string{captureConstants.CaptureTriggerAnnotation: "incident-42"},
+		},
+		Spec: retinav1alpha1.CaptureSpec{
+			CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+				CaptureOption: retinav1alpha1.CaptureOption{
+					RingBuffer: &retinav1alpha1.RingBuffer{Triggers: retinav1alpha1.CaptureTriggers{Annotation: true}},
+				},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		capturePod("cap-node1", corev1.PodRunning), capturePod("cap-node2", corev1.PodSucceeded), otherPod,
+	).Build()
+	cr := &CaptureReconciler{Client: c, scheme: scheme, logger: log.Logger().Named("test")}
+	require.NoError(t, cr.propagateTriggerAnnotation(context.Background(), capture))
+
+	wantAnnotations := map[string]string{"cap-node1": "incident-42", "cap-node2": "", "other": ""}
+	for name, want := range wantAnnotations {
+		pod := &corev1.Pod{}
+		require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "capture", Name: name}, pod))
+		assert.Equal(t, want, pod.Annotations[captureConstants.CaptureTriggerAnnotation], name)
+	}
+
+	// The annotation is not propagated when the annotation trigger is disabled.
+	capture.Annotations[captureConstants.CaptureTriggerAnnotation] = "incident-43"
+	capture.Spec.CaptureConfiguration.CaptureOption.RingBuffer.Triggers = retinav1alpha1.CaptureTriggers{Metric: &retinav1alpha1.MetricTrigger{Name: "networkobservability_drop_count"}}
+	require.NoError(t, cr.propagateTriggerAnnotation(context.Background(), capture))
+	pod := &corev1.Pod{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "capture", Name: "cap-node1"}, pod))
+	assert.Equal(t, "incident-42", pod.Annotations[captureConstants.CaptureTriggerAnnotation])
+}
@@ -16,10 +16,12 @@ package metricsconfigurationcontroller
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"go.uber.org/zap"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,9 +35,8 @@ import (
 type MetricsConfigurationReconciler struct {
 	*sync.Mutex
 	client.Client
-	Scheme  *runtime.Scheme
-	mcCache map[string]*retinav1alpha1.MetricsConfiguration
-	l       *log.ZapLogger
+	Scheme *runtime.Scheme
+	l      *log.ZapLogger
 }
 
 func init() {
@@ -46,11 +47,10 @@ func init() {
 
 func New(client client.Client, scheme *runtime.Scheme) *MetricsConfigurationReconciler {
 	return &MetricsConfigurationReconciler{
-		Mutex:   &sync.Mutex{},
-		l:       log.Logger().Named(string("metricsconfiguration-controller")),
-		Client:  client,
-		Scheme:  scheme,
-		mcCache: make(map[string]*retinav1alpha1.MetricsConfiguration),
+		Mutex:  &sync.Mutex{},
+		l:      log.Logger().Named(string("metricsconfiguration-controller")),
+		Client: client,
+		Scheme: scheme,
 	}
 }
 
@@ -58,63 +58,111 @@ func New(client client.Client, scheme *runtime.Scheme) *MetricsConfigurationReco
 //+kubebuilder:rbac:groups=operator.retina.sh,resources=metricsconfigurations/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=operator.retina.sh,resources=metricsconfigurations/finalizers,verbs=update
 
+// Reconcile validates all the MetricsConfigurations, as they are merged into the spec of the metrics module of the
+// agents, and updates the status of each with the merged spec in effect. A MetricsConfiguration conflicting with an
+// older accepted one is rejected, so that merging never changes the scope of the metrics of another one.
 func (r *MetricsConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	mcc := &retinav1alpha1.MetricsConfiguration{}
-	if err := r.Client.Get(ctx, req.NamespacedName, mcc); err != nil {
-		if apierrors.IsNotFound(err) {
-			// Object not found, it has probably been deleted
-			r.l.Info("deleted", zap.String("name", req.NamespacedName.String()))
-			r.Lock()
-			_, ok := r.mcCache[req.NamespacedName.String()]
-			if ok {
-				delete(r.mcCache, req.NamespacedName.String())
-				r.l.Info("deleted from cache", zap.String("name", req.NamespacedName.String()))
-			}
-			r.Unlock()
-			return ctrl.Result{}, nil
-		} else {
-			r.l.Info("error getting metricsconfiguration", zap.String("name", req.NamespacedName.String()))
-			return ctrl.Result{}, err
-		}
-	}
-
-	r.l.Info("reconciled", zap.String("name", req.NamespacedName.String()))
+	r.l.Info("reconciling", zap.String("name", req.NamespacedName.String()))
 	r.Lock()
 	defer r.Unlock()
-	if len(r.mcCache) == 0 || r.mcCache[req.NamespacedName.String()] != nil {
-		err := validate.MetricsCRD(mcc)
-		if err != nil {
-			r.l.Error("Error validating metrics configuration", zap.Error(err))
 
-			mcc.Status = retinav1alpha1.MetricsStatus{
+	mccList := &retinav1alpha1.MetricsConfigurationList{}
+	if err := r.Client.List(ctx, mccList); err != nil {
+		r.l.Error("Error listing metrics configurations", zap.Error(err))
+		return ctrl.Result{}, err
+	}
+	// The agents merge the accepted MetricsConfigurations ordered by name.
+	sort.Slice(mccList.Items, func(i, j int) bool { return mccList.Items[i].Name < mccList.Items[j].Name })
+	// The oldest MetricsConfigurations are accepted first, a new one cannot take over the metrics of an accepted one.
+	order := make([]int, len(mccList.Items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return mccList.Items[order[i]].CreationTimestamp.Before(&mccList.Items[order[j]].CreationTimestamp)
+	})
+
+	statuses := make([]retinav1alpha1.MetricsStatus, len(mccList.Items))
+	accepted := []int{}
+	for _, i := range order {
+		mcc := &mccList.Items[i]
+		if err := validate.MetricsCRD(mcc); err != nil {
+			r.l.Error("Error validating metrics configuration", zap.String("name", mcc.Name), zap.Error(err))
+			statuses[i] = retinav1alpha1.MetricsStatus{
 				State:  retinav1alpha1.StateErrored,
 				Reason: fmt.Sprintf("Validation of CRD failed with: %s", err.Error()),
 			}
-		} else {
-			r.l.Info("metrics configuration is valid", zap.String("crd Name", mcc.Name))
-			mcc.Status = retinav1alpha1.MetricsStatus{
-				State:  retinav1alpha1.StateAccepted,
-				Reason: "CRD is Accepted",
+			continue
+		}
+		if conflict := r.conflict(mccList.Items, accepted, mcc); conflict != "" {
+			statuses[i] = retinav1alpha1.MetricsStatus{
+				State:  retinav1alpha1.StateErrored,
+				Reason: conflict,
 			}
-			r.mcCache[req.NamespacedName.String()] = mcc
+			continue
 		}
-	} else {
-		r.l.Info("Metrics Conguration is already configured on this cluster, cannot reconcile this new config.")
+		accepted = append(accepted, i)
+	}
+	sort.Ints(accepted)
 
-		mcc.Status = retinav1alpha1.MetricsStatus{
-			State:  retinav1alpha1.StateErrored,
-			Reason: "Metrics Configuration is already configured on this cluster, cannot reconcile this new config.",
+	specs := make([]*retinav1alpha1.MetricsSpec, 0, len(accepted))
+	names := make([]string, 0, len(accepted))
+	for _, i := range accepted {
+		specs = append(specs, &mccList.Items[i].Spec)
+		names = append(names, mccList.Items[i].Name)
+	}
+	merged := validate.MergeMetricsSpecs(specs)
+	for _, i := range accepted {
+		mcc := &mccList.Items[i]
+		r.l.Info("metrics configuration is valid", zap.String("crd Name", mcc.Name))
+		statuses[i] = retinav1alpha1.MetricsStatus{
+			State:    retinav1alpha1.StateAccepted,
+			Reason:   "CRD is Accepted",
+			InEffect: merged.DeepCopy(),
+		}
+		if others := otherNames(names, mcc.Name); len(others) > 0 {
+			statuses[i].Reason = fmt.Sprintf("CRD is Accepted and merged with MetricsConfigurations %s", strings.Join(others, ", "))
 		}
 	}
 
-	if err := r.Client.Status().Update(ctx, mcc); err != nil {
-		r.l.Error("Error updating metrics configuration", zap.Error(err))
-		return ctrl.Result{}, err
+	for i := range mccList.Items {
+		mcc := &mccList.Items[i]
+		if apiequality.Semantic.DeepEqual(mcc.Status, statuses[i]) {
+			continue
+		}
+		mcc.Status = statuses[i]
+		if err := r.Client.Status().Update(ctx, mcc); err != nil {
+			r.l.Error("Error updating metrics configuration", zap.String("name", mcc.Name), zap.Error(err))
+			return ctrl.Result{}, err
+		}
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// conflict returns the reason of the rejection of the MetricsConfiguration if it conflicts with one of the accepted
+// MetricsConfigurations, or an empty string.
+func (r *MetricsConfigurationReconciler) conflict(items []retinav1alpha1.MetricsConfiguration, accepted []int, mcc *retinav1alpha1.MetricsConfiguration) string {
+	for _, i := range accepted {
+		if err := validate.MetricsSpecsConflict(&mcc.Spec, &items[i].Spec); err != nil {
+			r.l.Error("Metrics configuration conflicts with an accepted one", zap.String("name", mcc.Name),
+				zap.String("accepted", items[i].Name), zap.Error(err))
+			return fmt.Sprintf("CRD conflicts with MetricsConfiguration %s: %s", items[i].Name, err.Error())
+		}
+	}
+	return ""
+}
+
+func otherNames(names []string, name string) []string {
+	others := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != name {
+			others = append(others, n)
+		}
+	}
+	return others
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *MetricsConfigurationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -16,6 +16,7 @@ package metricsconfigurationcontroller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -125,3 +126,83 @@ func TestMetricsConfigurationReconciler_Reconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestMetricsConfigurationReconciler_ReconcileMultiple(t *testing.T) {
+	created := metav1.Now()
+	teamA := &retinav1alpha1.MetricsConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", CreationTimestamp: created},
+		Spec: retinav1alpha1.MetricsSpec{
+			ContextOptions: []retinav1alpha1.MetricsContextOptions{{MetricName: "drop_count", SourceLabels: []string{"ip"}}},
+			Namespaces:     retinav1alpha1.MetricsNamespaces{Include: []string{"team-a"}},
+		},
+	}
+	teamADNS := &retinav1alpha1.MetricsConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a-dns", CreationTimestamp: metav1.NewTime(created.Add(time.Minute))},
+		Spec: retinav1alpha1.MetricsSpec{
+			ContextOptions: []retinav1alpha1.MetricsContextOptions{{MetricName: "dns_request_count", SourceLabels: []string{"podname"}}},
+			Namespaces:     retinav1alpha1.MetricsNamespaces{Include: []string{"team-a"}},
+		},
+	}
+	// Older than team-a but sorted after it, the oldest MetricsConfiguration is accepted.
+	platform := &retinav1alpha1.MetricsConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform", CreationTimestamp: metav1.NewTime(created.Add(-time.Minute))},
+		Spec: retinav1alpha1.MetricsSpec{
+			ContextOptions: []retinav1alpha1.MetricsContextOptions{{MetricName: "forward_count", SourceLabels: []string{"podname"}}},
+			Namespaces:     retinav1alpha1.MetricsNamespaces{Exclude: []string{"kube-system"}},
+		},
+	}
+	invalid := &retinav1alpha1.MetricsConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+		Spec: retinav1alpha1.MetricsSpec{
+			ContextOptions: []retinav1alpha1.MetricsContextOptions{{MetricName: "not_a_metric"}},
+			Namespaces:     retinav1alpha1.MetricsNamespaces{Include: []string{"default"}},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(fakescheme).
+		WithObjects(teamA, teamADNS, platform, invalid).
+		WithStatusSubresource(&retinav1alpha1.MetricsConfiguration{}).
+		Build()
+
+	r := New(c, fakescheme)
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "team-a"}})
+	require.NoError(t, err)
+
+	got := &retinav1alpha1.MetricsConfiguration{}
+	require.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(platform), got))
+	require.Equal(t, retinav1alpha1.StateAccepted, got.Status.State)
+	require.Equal(t, "CRD is Accepted", got.Status.Reason)
+	require.Equal(t, platform.Spec, *got.Status.InEffect)
+
+	for _, mcc := range []*retinav1alpha1.MetricsConfiguration{teamA, teamADNS} {
+		require.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(mcc), got))
+		require.Equal(t, retinav1alpha1.StateErrored, got.Status.State)
+		require.Equal(t, "CRD conflicts with MetricsConfiguration platform: the namespaces differ", got.Status.Reason)
+		require.Nil(t, got.Status.InEffect)
+	}
+
+	require.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(invalid), got))
+	require.Equal(t, retinav1alpha1.StateErrored, got.Status.State)
+	require.Nil(t, got.Status.InEffect)
+
+	// Once platform is deleted, the MetricsConfigurations of team-a are merged.
+	require.NoError(t, c.Delete(context.TODO(), platform))
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "platform"}})
+	require.NoError(t, err)
+	merged := retinav1alpha1.MetricsSpec{
+		ContextOptions: []retinav1alpha1.MetricsContextOptions{
+			{MetricName: "drop_count", SourceLabels: []string{"ip"}},
+			{MetricName: "dns_request_count", SourceLabels: []string{"podname"}},
+		},
+		Namespaces: retinav1alpha1.MetricsNamespaces{Include: []string{"team-a"}},
+	}
+	require.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(teamA), got))
+	require.Equal(t, retinav1alpha1.StateAccepted, got.Status.State)
+	require.Equal(t, "CRD is Accepted and merged with MetricsConfigurations team-a-dns", got.Status.Reason)
+	require.Equal(t, merged, *got.Status.InEffect)
+
+	require.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(teamADNS), got))
+	require.Equal(t, retinav1alpha1.StateAccepted, got.Status.State)
+	require.Equal(t, "CRD is Accepted and merged with MetricsConfigurations team-a", got.Status.Reason)
+	require.Equal(t, merged, *got.Status.InEffect)
+}
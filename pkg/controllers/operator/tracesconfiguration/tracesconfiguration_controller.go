@@ -15,34 +15,104 @@ package tracesconfigurationcontroller
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	validate "github.com/microsoft/retina/crd/api/v1alpha1/validations"
+	"github.com/microsoft/retina/pkg/log"
 )
 
 // TracesConfigurationReconciler reconciles a TracesConfiguration object
 type TracesConfigurationReconciler struct {
+	*sync.Mutex
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme  *runtime.Scheme
+	tcCache map[string]*retinav1alpha1.TracesConfiguration
+	l       *log.ZapLogger
 }
 
-//+kubebuilder:rbac:groups=operator.retina.sh,resources=tracesconfiguration,verbs=get;list;watch;create;update;patch;delete
-//+kubebuilder:rbac:groups=operator.retina.sh,resources=tracesconfiguration/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups=operator.retina.sh,resources=tracesconfiguration/finalizers,verbs=update
+func New(client client.Client, scheme *runtime.Scheme) *TracesConfigurationReconciler {
+	return &TracesConfigurationReconciler{
+		Mutex:   &sync.Mutex{},
+		l:       log.Logger().Named(string("tracesconfiguration-controller")),
+		Client:  client,
+		Scheme:  scheme,
+		tcCache: make(map[string]*retinav1alpha1.TracesConfiguration),
+	}
+}
+
+//+kubebuilder:rbac:groups=retina.sh,resources=tracesconfigurations,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=retina.sh,resources=tracesconfigurations/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=retina.sh,resources=tracesconfigurations/finalizers,verbs=update
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.14.1/pkg/reconcile
+// Reconcile validates the TracesConfiguration and reports the result in its status.
+// Only one TracesConfiguration is accepted per cluster, the agents only act on accepted ones.
 func (r *TracesConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	tc := &retinav1alpha1.TracesConfiguration{}
+	if err := r.Client.Get(ctx, req.NamespacedName, tc); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Object not found, it has probably been deleted
+			r.l.Info("deleted", zap.String("name", req.NamespacedName.String()))
+			r.Lock()
+			if _, ok := r.tcCache[req.NamespacedName.String()]; ok {
+				delete(r.tcCache, req.NamespacedName.String())
+				r.l.Info("deleted from cache", zap.String("name", req.NamespacedName.String()))
+			}
+			r.Unlock()
+			return ctrl.Result{}, nil
+		}
+		r.l.Info("error getting tracesconfiguration", zap.String("name", req.NamespacedName.String()))
+		return ctrl.Result{}, err
+	}
+
+	r.l.Info("reconciled", zap.String("name", req.NamespacedName.String()))
+	r.Lock()
+	defer r.Unlock()
+
+	var lastKnownSpec *retinav1alpha1.TracesSpec
+	if tc.Status != nil {
+		lastKnownSpec = tc.Status.LastKnownSpec
+	}
+
+	if len(r.tcCache) == 0 || r.tcCache[req.NamespacedName.String()] != nil {
+		if err := validate.TracesCRD(tc); err != nil {
+			r.l.Error("Error validating traces configuration", zap.Error(err))
+
+			tc.Status = &retinav1alpha1.TracesStatus{
+				State:         retinav1alpha1.StateErrored,
+				Reason:        fmt.Sprintf("Validation of CRD failed with: %s", err.Error()),
+				LastKnownSpec: lastKnownSpec,
+			}
+		} else {
+			r.l.Info("traces configuration is valid", zap.String("crd Name", tc.Name))
+			tc.Status = &retinav1alpha1.TracesStatus{
+				State:         retinav1alpha1.StateAccepted,
+				Reason:        "CRD is Accepted",
+				LastKnownSpec: tc.Spec.DeepCopy(),
+			}
+			r.tcCache[req.NamespacedName.String()] = tc
+		}
+	} else {
+		r.l.Info("Traces Configuration is already configured on this cluster, cannot reconcile this new config.")
+
+		tc.Status = &retinav1alpha1.TracesStatus{
+			State:         retinav1alpha1.StateErrored,
+			Reason:        "Traces Configuration is already configured on this cluster, cannot reconcile this new config.",
+			LastKnownSpec: lastKnownSpec,
+		}
+	}
 
-	// TODO: implement the reconcile logic
+	if err := r.Client.Status().Update(ctx, tc); err != nil {
+		r.l.Error("Error updating traces configuration", zap.Error(err))
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
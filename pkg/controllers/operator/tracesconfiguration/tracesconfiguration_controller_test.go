@@ -0,0 +1,173 @@
+/*
+Copyright 2023.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracesconfigurationcontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+var fakescheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(fakescheme))
+	utilruntime.Must(retinav1alpha1.AddToScheme(fakescheme))
+}
+
+func validSpec() *retinav1alpha1.TracesSpec {
+	return &retinav1alpha1.TracesSpec{
+		TraceConfiguration: []*retinav1alpha1.TraceConfiguration{
+			{
+				TraceCaptureLevel: retinav1alpha1.AllPacketsCapture,
+				TraceTargets: []*retinav1alpha1.TraceTargets{
+					{
+						Source: &retinav1alpha1.TraceTarget{
+							IPBlock: retinav1alpha1.IPBlock{CIDR: "10.0.0.0/24"},
+						},
+						TracePoints: retinav1alpha1.TracePoints{retinav1alpha1.NodeToPod},
+					},
+				},
+			},
+		},
+		TraceOutputConfiguration: &retinav1alpha1.TraceOutputConfiguration{
+			TraceOutputDestination: retinav1alpha1.TraceOutputStdout,
+		},
+	}
+}
+
+func TestTracesConfigurationReconciler_Reconcile(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		existing      []client.Object
+		requests      []string
+		wantState     map[string]string
+		wantLastKnown map[string]bool
+	}{
+		{
+			name: "valid configuration is accepted",
+			existing: []client.Object{
+				&retinav1alpha1.TracesConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "trace1"},
+					Spec:       validSpec(),
+				},
+			},
+			requests:      []string{"trace1"},
+			wantState:     map[string]string{"trace1": retinav1alpha1.StateAccepted},
+			wantLastKnown: map[string]bool{"trace1": true},
+		},
+		{
+			name: "invalid configuration is errored",
+			existing: []client.Object{
+				&retinav1alpha1.TracesConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "trace1"},
+					Spec:       &retinav1alpha1.TracesSpec{},
+				},
+			},
+			requests:      []string{"trace1"},
+			wantState:     map[string]string{"trace1": retinav1alpha1.StateErrored},
+			wantLastKnown: map[string]bool{"trace1": false},
+		},
+		{
+			name: "second configuration is errored",
+			existing: []client.Object{
+				&retinav1alpha1.TracesConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "trace1"},
+					Spec:       validSpec(),
+				},
+				&retinav1alpha1.TracesConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "trace2"},
+					Spec:       validSpec(),
+				},
+			},
+			requests: []string{"trace1", "trace2"},
+			wantState: map[string]string{
+				"trace1": retinav1alpha1.StateAccepted,
+				"trace2": retinav1alpha1.StateErrored,
+			},
+			wantLastKnown: map[string]bool{"trace1": true, "trace2": false},
+		},
+		{
+			name:      "deleted configuration",
+			requests:  []string{"trace1"},
+			wantState: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(fakescheme).
+				WithObjects(tt.existing...).
+				WithStatusSubresource(&retinav1alpha1.TracesConfiguration{}).
+				Build()
+			r := New(c, fakescheme)
+
+			for _, name := range tt.requests {
+				_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+				require.NoError(t, err)
+			}
+
+			for name, state := range tt.wantState {
+				tc := &retinav1alpha1.TracesConfiguration{}
+				require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: name}, tc))
+				require.NotNil(t, tc.Status)
+				assert.Equal(t, state, tc.Status.State, tc.Status.Reason)
+				assert.Equal(t, tt.wantLastKnown[name], tc.Status.LastKnownSpec != nil)
+			}
+		})
+	}
+}
+
+func TestTracesConfigurationReconciler_Delete(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	tc := &retinav1alpha1.TracesConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "trace1"},
+		Spec:       validSpec(),
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(fakescheme).
+		WithObjects(tc).
+		WithStatusSubresource(&retinav1alpha1.TracesConfiguration{}).
+		Build()
+	r := New(c, fakescheme)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "trace1"}}
+	_, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	require.Len(t, r.tcCache, 1)
+
+	require.NoError(t, c.Delete(context.TODO(), tc))
+	_, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Empty(t, r.tcCache)
+}
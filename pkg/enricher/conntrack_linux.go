@@ -0,0 +1,311 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package enricher
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	defaultConntrackRetryInterval = 5 * time.Second
+	// ipctnlMsgCtNew is the type of the conntrack messages of new connections.
+	ipctnlMsgCtNew = 0
+)
+
+var errUnexpectedConntrackMessage = errors.New("unexpected conntrack message")
+
+type natKey struct {
+	proto      uint8
+	clientIP   string
+	clientPort uint32
+	dstIP      string
+	dstPort    uint32
+}
+
+// conntrackEvents is the socket receiving the conntrack events.
+type conntrackEvents interface {
+	Receive() ([]syscall.NetlinkMessage, *unix.SockaddrNetlink, error)
+	Close()
+}
+
+// ConntrackNATResolver resolves the connections to services from the netfilter conntrack table,
+// where kube-proxy translates the service VIPs to their backends.
+// The table is dumped once, then kept up to date from the conntrack events of new and destroyed connections.
+// Only the connections to service VIPs are kept.
+type ConntrackNATResolver struct {
+	sync.RWMutex
+	l *log.ZapLogger
+	// retryInterval is the delay before subscribing again when the events fail.
+	retryInterval time.Duration
+	// isVIP returns true for the IPs of services.
+	isVIP     func(ip string) bool
+	listFlows func(family netlink.InetFamily) ([]*netlink.ConntrackFlow, error)
+	subscribe func() (conntrackEvents, error)
+	entries   map[natKey]NATEntry
+}
+
+// NewConntrackNATResolver returns a resolver keeping the connections to the IPs for which isVIP returns true.
+func NewConntrackNATResolver(isVIP func(ip string) bool) *ConntrackNATResolver {
+	return &ConntrackNATResolver{
+		l:             log.Logger().Named("conntrack-nat-resolver"),
+		retryInterval: defaultConntrackRetryInterval,
+		isVIP:         isVIP,
+		listFlows: func(family netlink.InetFamily) ([]*netlink.ConntrackFlow, error) {
+			return netlink.ConntrackTableList(netlink.ConntrackTable, family)
+		},
+		subscribe: func() (conntrackEvents, error) {
+			return nl.Subscribe(unix.NETLINK_NETFILTER, unix.NFNLGRP_CONNTRACK_NEW, unix.NFNLGRP_CONNTRACK_DESTROY)
+		},
+		entries: make(map[natKey]NATEntry),
+	}
+}
+
+// Run keeps the NAT entries up to date until the context is done.
+func (r *ConntrackNATResolver) Run(ctx context.Context) {
+	for {
+		if err := r.watch(ctx); err != nil && ctx.Err() == nil {
+			r.l.Warn("failed to watch conntrack events", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.retryInterval):
+		}
+	}
+}
+
+// watch subscribes to the conntrack events, dumps the table and applies the events until the context is done.
+// Events lost while the socket buffer is full make it return an error, so that the table is dumped again.
+func (r *ConntrackNATResolver) watch(ctx context.Context) error {
+	events, err := r.subscribe()
+	if err != nil {
+		return errors.Wrap(err, "failed to subscribe to conntrack events")
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		// Unblocks Receive.
+		events.Close()
+	}()
+
+	// The table is dumped after subscribing so that no connection is missed in between.
+	if err = r.refresh(); err != nil {
+		return err
+	}
+	for {
+		msgs, _, recvErr := events.Receive()
+		if recvErr != nil {
+			return errors.Wrap(recvErr, "failed to receive conntrack events")
+		}
+		for i := range msgs {
+			if err = r.handleMessage(&msgs[i]); err != nil {
+				r.l.Debug("failed to handle conntrack event", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (r *ConntrackNATResolver) refresh() error {
+	entries := make(map[natKey]NATEntry)
+	for _, family := range []netlink.InetFamily{unix.AF_INET, unix.AF_INET6} {
+		flows, err := r.listFlows(family)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list conntrack table of family %d", family)
+		}
+		for _, f := range flows {
+			r.add(entries, f)
+		}
+	}
+
+	r.Lock()
+	r.entries = entries
+	r.Unlock()
+	return nil
+}
+
+// handleMessage adds the new connections and removes the destroyed ones.
+func (r *ConntrackNATResolver) handleMessage(msg *syscall.NetlinkMessage) error {
+	if msg.Header.Type>>8 != unix.NFNL_SUBSYS_CTNETLINK {
+		return errors.Wrapf(errUnexpectedConntrackMessage, "type %d", msg.Header.Type)
+	}
+	f, err := parseConntrackFlow(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	switch msg.Header.Type & 0xff {
+	case ipctnlMsgCtNew:
+		r.add(r.entries, f)
+	case nl.IPCTNL_MSG_CT_DELETE:
+		for _, k := range natKeys(f) {
+			delete(r.entries, k)
+		}
+	default:
+		return errors.Wrapf(errUnexpectedConntrackMessage, "type %d", msg.Header.Type)
+	}
+	return nil
+}
+
+// add indexes the connection by its original destination, the VIP,
+// and by its translated destination, the backend replying to the client.
+func (r *ConntrackNATResolver) add(entries map[natKey]NATEntry, f *netlink.ConntrackFlow) {
+	orig, reply := f.Forward, f.Reverse
+	if orig.DstIP == nil || reply.SrcIP == nil || orig.DstIP.Equal(reply.SrcIP) {
+		// Not translated.
+		return
+	}
+	vip := orig.DstIP.String()
+	if !r.isVIP(vip) {
+		return
+	}
+	entry := NATEntry{VIP: vip, Backend: reply.SrcIP.String()}
+	for _, k := range natKeys(f) {
+		entries[k] = entry
+	}
+}
+
+// natKeys returns the keys of the original and of the translated destination of the connection.
+func natKeys(f *netlink.ConntrackFlow) []natKey {
+	orig, reply := f.Forward, f.Reverse
+	if orig.DstIP == nil || reply.SrcIP == nil {
+		return nil
+	}
+	return []natKey{
+		{
+			proto:      orig.Protocol,
+			clientIP:   orig.SrcIP.String(),
+			clientPort: uint32(orig.SrcPort),
+			dstIP:      orig.DstIP.String(),
+			dstPort:    uint32(orig.DstPort),
+		},
+		{
+			proto:      orig.Protocol,
+			clientIP:   orig.SrcIP.String(),
+			clientPort: uint32(orig.SrcPort),
+			dstIP:      reply.SrcIP.String(),
+			dstPort:    uint32(reply.SrcPort),
+		},
+	}
+}
+
+// parseConntrackFlow parses the tuples of a conntrack message, after its nfgenmsg header.
+// netlink only exports the parsing of the table dumps.
+func parseConntrackFlow(data []byte) (*netlink.ConntrackFlow, error) {
+	if len(data) < nl.SizeofNfgenmsg {
+		return nil, errors.Wrap(errUnexpectedConntrackMessage, "message too short")
+	}
+	attrs, err := nl.ParseRouteAttr(data[nl.SizeofNfgenmsg:])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse conntrack attributes")
+	}
+	var orig, reply conntrackTuple
+	for _, attr := range attrs {
+		switch attr.Attr.Type & nl.NLA_TYPE_MASK {
+		case nl.CTA_TUPLE_ORIG:
+			err = orig.parse(attr.Value)
+		case nl.CTA_TUPLE_REPLY:
+			err = reply.parse(attr.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f := &netlink.ConntrackFlow{FamilyType: data[0]}
+	f.Forward.Protocol, f.Forward.SrcIP, f.Forward.DstIP = orig.proto, orig.srcIP, orig.dstIP
+	f.Forward.SrcPort, f.Forward.DstPort = orig.srcPort, orig.dstPort
+	f.Reverse.Protocol, f.Reverse.SrcIP, f.Reverse.DstIP = reply.proto, reply.srcIP, reply.dstIP
+	f.Reverse.SrcPort, f.Reverse.DstPort = reply.srcPort, reply.dstPort
+	return f, nil
+}
+
+// conntrackTuple is a direction of a conntrack message.
+type conntrackTuple struct {
+	proto   uint8
+	srcIP   net.IP
+	dstIP   net.IP
+	srcPort uint16
+	dstPort uint16
+}
+
+func (tuple *conntrackTuple) parse(data []byte) error {
+	attrs, err := nl.ParseRouteAttr(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse conntrack tuple")
+	}
+	for _, attr := range attrs {
+		nested, nestedErr := nl.ParseRouteAttr(attr.Value)
+		if nestedErr != nil {
+			return errors.Wrap(nestedErr, "failed to parse conntrack tuple")
+		}
+		switch attr.Attr.Type & nl.NLA_TYPE_MASK {
+		case nl.CTA_TUPLE_IP:
+			for _, ip := range nested {
+				switch ip.Attr.Type & nl.NLA_TYPE_MASK {
+				case nl.CTA_IP_V4_SRC, nl.CTA_IP_V6_SRC:
+					tuple.srcIP = net.IP(ip.Value)
+				case nl.CTA_IP_V4_DST, nl.CTA_IP_V6_DST:
+					tuple.dstIP = net.IP(ip.Value)
+				}
+			}
+		case nl.CTA_TUPLE_PROTO:
+			for _, proto := range nested {
+				switch proto.Attr.Type & nl.NLA_TYPE_MASK {
+				case nl.CTA_PROTO_NUM:
+					if len(proto.Value) > 0 {
+						tuple.proto = proto.Value[0]
+					}
+				case nl.CTA_PROTO_SRC_PORT:
+					if len(proto.Value) >= 2 {
+						tuple.srcPort = binary.BigEndian.Uint16(proto.Value)
+					}
+				case nl.CTA_PROTO_DST_PORT:
+					if len(proto.Value) >= 2 {
+						tuple.dstPort = binary.BigEndian.Uint16(proto.Value)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *ConntrackNATResolver) Resolve(proto uint8, clientIP string, clientPort uint32, dstIP string, dstPort uint32) (NATEntry, bool) {
+	r.RLock()
+	defer r.RUnlock()
+	entry, ok := r.entries[natKey{
+		proto:      proto,
+		clientIP:   normalizeIP(clientIP),
+		clientPort: clientPort,
+		dstIP:      normalizeIP(dstIP),
+		dstPort:    dstPort,
+	}]
+	return entry, ok
+}
+
+// normalizeIP returns the canonical form of the IP, as net.IP.String does for the conntrack entries.
+func normalizeIP(ip string) string {
+	if parsed := net.ParseIP(ip); parsed != nil {
+		return parsed.String()
+	}
+	return ip
+}
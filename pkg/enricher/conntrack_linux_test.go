@@ -0,0 +1,215 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package enricher
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+func conntrackFlow(proto uint8, client string, clientPort uint16, dst string, dstPort uint16, replySrc string, replySrcPort uint16) *netlink.ConntrackFlow {
+	f := &netlink.ConntrackFlow{}
+	f.Forward.Protocol = proto
+	f.Forward.SrcIP = net.ParseIP(client)
+	f.Forward.SrcPort = clientPort
+	f.Forward.DstIP = net.ParseIP(dst)
+	f.Forward.DstPort = dstPort
+	f.Reverse.Protocol = proto
+	f.Reverse.SrcIP = net.ParseIP(replySrc)
+	f.Reverse.SrcPort = replySrcPort
+	f.Reverse.DstIP = net.ParseIP(client)
+	f.Reverse.DstPort = clientPort
+	return f
+}
+
+func TestConntrackNATResolver(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	vips := map[string]bool{"10.96.0.10": true, "fd00:96::a": true}
+	r := NewConntrackNATResolver(func(ip string) bool { return vips[ip] })
+	r.listFlows = func(family netlink.InetFamily) ([]*netlink.ConntrackFlow, error) {
+		if family == unix.AF_INET6 {
+			return []*netlink.ConntrackFlow{
+				conntrackFlow(unix.IPPROTO_TCP, "fd00::1", 40000, "fd00:96::a", 80, "fd00::5", 8080),
+			}, nil
+		}
+		return []*netlink.ConntrackFlow{
+			// Connection to a service VIP.
+			conntrackFlow(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.96.0.10", 80, "10.0.0.5", 8080),
+			// Connection to a pod, not translated.
+			conntrackFlow(unix.IPPROTO_TCP, "10.0.0.1", 40001, "10.0.0.5", 8080, "10.0.0.5", 8080),
+			// Connection translated to an IP that is not a service.
+			conntrackFlow(unix.IPPROTO_UDP, "10.0.0.1", 40002, "10.0.0.99", 53, "10.0.0.6", 53),
+		}, nil
+	}
+	require.NoError(t, r.refresh())
+
+	want := NATEntry{VIP: "10.96.0.10", Backend: "10.0.0.5"}
+	entry, ok := r.Resolve(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.96.0.10", 80)
+	require.True(t, ok)
+	assert.Equal(t, want, entry)
+
+	// The translated connection resolves to the same entry.
+	entry, ok = r.Resolve(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.0.0.5", 8080)
+	require.True(t, ok)
+	assert.Equal(t, want, entry)
+
+	// IPv6 addresses are compared in their canonical form.
+	entry, ok = r.Resolve(unix.IPPROTO_TCP, "fd00:0::1", 40000, "fd00:96:0::a", 80)
+	require.True(t, ok)
+	assert.Equal(t, NATEntry{VIP: "fd00:96::a", Backend: "fd00::5"}, entry)
+
+	for _, tc := range []struct {
+		proto      uint8
+		client     string
+		clientPort uint32
+		dst        string
+		dstPort    uint32
+	}{
+		{unix.IPPROTO_UDP, "10.0.0.1", 40000, "10.96.0.10", 80},
+		{unix.IPPROTO_TCP, "10.0.0.1", 40001, "10.96.0.10", 80},
+		{unix.IPPROTO_TCP, "10.0.0.1", 40001, "10.0.0.5", 8080},
+		{unix.IPPROTO_UDP, "10.0.0.1", 40002, "10.0.0.99", 53},
+	} {
+		_, ok = r.Resolve(tc.proto, tc.client, tc.clientPort, tc.dst, tc.dstPort)
+		assert.False(t, ok, "%+v", tc)
+	}
+
+	// A failed refresh keeps the previous entries.
+	r.listFlows = func(netlink.InetFamily) ([]*netlink.ConntrackFlow, error) {
+		return nil, errors.New("netlink error")
+	}
+	require.Error(t, r.refresh())
+	_, ok = r.Resolve(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.96.0.10", 80)
+	assert.True(t, ok)
+
+	// Closed connections are removed on refresh.
+	r.listFlows = func(netlink.InetFamily) ([]*netlink.ConntrackFlow, error) {
+		return nil, nil
+	}
+	require.NoError(t, r.refresh())
+	_, ok = r.Resolve(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.96.0.10", 80)
+	assert.False(t, ok)
+}
+
+func conntrackTupleAttr(attrType int, proto uint8, src string, srcPort uint16, dst string, dstPort uint16) *nl.RtAttr {
+	srcIP, dstIP := net.ParseIP(src), net.ParseIP(dst)
+	srcType, dstType := nl.CTA_IP_V6_SRC, nl.CTA_IP_V6_DST
+	if srcIP.To4() != nil {
+		srcIP, dstIP = srcIP.To4(), dstIP.To4()
+		srcType, dstType = nl.CTA_IP_V4_SRC, nl.CTA_IP_V4_DST
+	}
+	ports := make([]byte, 4)
+	binary.BigEndian.PutUint16(ports, srcPort)
+	binary.BigEndian.PutUint16(ports[2:], dstPort)
+
+	tuple := nl.NewRtAttr(attrType|int(nl.NLA_F_NESTED), nil)
+	ip := tuple.AddRtAttr(nl.CTA_TUPLE_IP|int(nl.NLA_F_NESTED), nil)
+	ip.AddRtAttr(srcType, srcIP)
+	ip.AddRtAttr(dstType, dstIP)
+	l4 := tuple.AddRtAttr(nl.CTA_TUPLE_PROTO|int(nl.NLA_F_NESTED), nil)
+	l4.AddRtAttr(nl.CTA_PROTO_NUM, []byte{proto})
+	l4.AddRtAttr(nl.CTA_PROTO_SRC_PORT, ports[:2])
+	l4.AddRtAttr(nl.CTA_PROTO_DST_PORT, ports[2:])
+	return tuple
+}
+
+// conntrackEvent returns the message of a conntrack event, as sent by the kernel.
+func conntrackEvent(msgType uint16, proto uint8, client string, clientPort uint16, dst string, dstPort uint16, replySrc string, replySrcPort uint16) syscall.NetlinkMessage {
+	data := []byte{unix.AF_INET, nl.NFNETLINK_V0, 0, 0}
+	data = append(data, conntrackTupleAttr(nl.CTA_TUPLE_ORIG, proto, client, clientPort, dst, dstPort).Serialize()...)
+	data = append(data, conntrackTupleAttr(nl.CTA_TUPLE_REPLY, proto, replySrc, replySrcPort, client, clientPort).Serialize()...)
+	// Attributes other than the tuples are ignored.
+	data = append(data, nl.NewRtAttr(nl.CTA_MARK, []byte{0, 0, 0, 1}).Serialize()...)
+	return syscall.NetlinkMessage{
+		Header: syscall.NlMsghdr{Type: unix.NFNL_SUBSYS_CTNETLINK<<8 | msgType},
+		Data:   data,
+	}
+}
+
+type fakeConntrackEvents struct {
+	batches [][]syscall.NetlinkMessage
+	closed  chan struct{}
+}
+
+func (f *fakeConntrackEvents) Receive() ([]syscall.NetlinkMessage, *unix.SockaddrNetlink, error) {
+	if len(f.batches) == 0 {
+		return nil, nil, unix.ENOBUFS
+	}
+	msgs := f.batches[0]
+	f.batches = f.batches[1:]
+	return msgs, nil, nil
+}
+
+func (f *fakeConntrackEvents) Close() {
+	close(f.closed)
+}
+
+func TestConntrackNATResolverEvents(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	vips := map[string]bool{"10.96.0.10": true, "fd00:96::a": true}
+	r := NewConntrackNATResolver(func(ip string) bool { return vips[ip] })
+	r.listFlows = func(family netlink.InetFamily) ([]*netlink.ConntrackFlow, error) {
+		if family == unix.AF_INET6 {
+			return nil, nil
+		}
+		return []*netlink.ConntrackFlow{
+			conntrackFlow(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.96.0.10", 80, "10.0.0.5", 8080),
+		}, nil
+	}
+	events := &fakeConntrackEvents{
+		batches: [][]syscall.NetlinkMessage{
+			{
+				// The connection from the dump is closed.
+				conntrackEvent(nl.IPCTNL_MSG_CT_DELETE, unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.96.0.10", 80, "10.0.0.5", 8080),
+				// New connections to a service VIP.
+				conntrackEvent(0, unix.IPPROTO_UDP, "10.0.0.2", 40001, "10.96.0.10", 53, "10.0.0.6", 53),
+				conntrackEvent(0, unix.IPPROTO_TCP, "fd00::1", 40002, "fd00:96::a", 80, "fd00::5", 8080),
+				// New connection to a pod, not translated.
+				conntrackEvent(0, unix.IPPROTO_TCP, "10.0.0.3", 40003, "10.0.0.5", 8080, "10.0.0.5", 8080),
+				// Messages of other subsystems are skipped.
+				{Header: syscall.NlMsghdr{Type: 2 << 8}, Data: []byte{unix.AF_INET, 0, 0, 0}},
+			},
+		},
+		closed: make(chan struct{}),
+	}
+	r.subscribe = func() (conntrackEvents, error) { return events, nil }
+
+	// The watch ends when events are lost, so that the table is dumped again.
+	require.ErrorIs(t, r.watch(context.Background()), unix.ENOBUFS)
+	<-events.closed
+
+	_, ok := r.Resolve(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.96.0.10", 80)
+	assert.False(t, ok)
+	_, ok = r.Resolve(unix.IPPROTO_TCP, "10.0.0.1", 40000, "10.0.0.5", 8080)
+	assert.False(t, ok)
+
+	entry, ok := r.Resolve(unix.IPPROTO_UDP, "10.0.0.2", 40001, "10.96.0.10", 53)
+	require.True(t, ok)
+	assert.Equal(t, NATEntry{VIP: "10.96.0.10", Backend: "10.0.0.6"}, entry)
+	entry, ok = r.Resolve(unix.IPPROTO_UDP, "10.0.0.2", 40001, "10.0.0.6", 53)
+	require.True(t, ok)
+	assert.Equal(t, NATEntry{VIP: "10.96.0.10", Backend: "10.0.0.6"}, entry)
+
+	entry, ok = r.Resolve(unix.IPPROTO_TCP, "fd00::1", 40002, "fd00:96::a", 80)
+	require.True(t, ok)
+	assert.Equal(t, NATEntry{VIP: "fd00:96::a", Backend: "fd00::5"}, entry)
+
+	_, ok = r.Resolve(unix.IPPROTO_TCP, "10.0.0.3", 40003, "10.0.0.5", 8080)
+	assert.False(t, ok)
+}
@@ -5,6 +5,8 @@ package enricher
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"reflect"
 	"sync"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/microsoft/retina/pkg/controllers/cache"
 	"github.com/microsoft/retina/pkg/log"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 )
 
 var (
@@ -38,6 +41,12 @@ type Enricher struct {
 	Reader *container.RingReader
 
 	outputRing *container.Ring
+
+	// nat translates the service VIPs to their backends, nil if not available.
+	nat NATResolver
+
+	// nodeName is the name of the node running the enricher, identifying the host endpoint.
+	nodeName string
 }
 
 func New(ctx context.Context, cache cache.CacheInterface) *Enricher {
@@ -50,6 +59,7 @@ func New(ctx context.Context, cache cache.CacheInterface) *Enricher {
 			inputRing:  ir,
 			Reader:     container.NewRingReader(ir, ir.OldestWrite()),
 			outputRing: container.NewRing(container.Capacity1023),
+			nodeName:   os.Getenv(nodeNameEnvKey),
 		}
 		initialized = true
 	})
@@ -57,6 +67,11 @@ func New(ctx context.Context, cache cache.CacheInterface) *Enricher {
 	return e
 }
 
+// SetNATResolver sets the resolver translating the service VIPs of the flows to their backends.
+func (e *Enricher) SetNATResolver(nat NATResolver) {
+	e.nat = nat
+}
+
 func Instance() *Enricher {
 	return e
 }
@@ -106,7 +121,7 @@ func (e *Enricher) enrich(ev *v1.Event) {
 	// 0: IPVersion_IP_NOT_USED
 	// 1: IPVersion_IPv4
 	// 2: IPVersion_IPv6
-	if flow.IP.IpVersion > 1 {
+	if flow.IP.IpVersion > 2 {
 		e.l.Error("IP version is not supported", zap.Any("IPVersion", flow.IP.IpVersion))
 		return
 	}
@@ -117,6 +132,7 @@ func (e *Enricher) enrich(ev *v1.Event) {
 	srcObj := e.cache.GetObjByIP(flow.IP.Source)
 	if srcObj != nil {
 		flow.Source = e.getEndpoint(srcObj)
+		flow.SourceService = e.getService(srcObj)
 	}
 
 	if flow.IP.Destination == "" {
@@ -127,6 +143,11 @@ func (e *Enricher) enrich(ev *v1.Event) {
 	dstObj := e.cache.GetObjByIP(flow.IP.Destination)
 	if dstObj != nil {
 		flow.Destination = e.getEndpoint(dstObj)
+		flow.DestinationService = e.getService(dstObj)
+	}
+
+	if e.nat != nil {
+		e.translateServices(flow)
 	}
 
 	ev.Event = flow
@@ -155,15 +176,103 @@ func (e *Enricher) getEndpoint(obj interface{}) *flow.Endpoint {
 		}
 
 	case *common.RetinaSvc:
-		// todo
+		// Services are set in the service fields of the flow, the endpoint is the backend.
 		return nil
 
+	case *common.RetinaNode:
+		return e.getNodeEndpoint(o)
+
 	default:
 		e.l.Debug("received unknown type from cache", zap.Any("obj", obj), zap.Any("type", reflect.TypeOf(obj)))
 		return nil
 	}
 }
 
+// getNodeEndpoint returns the endpoint of a node IP, used by the kubelet, the host-network pods
+// and the NodePorts. The node running the enricher is the host, the other nodes are remote nodes.
+func (e *Enricher) getNodeEndpoint(n *common.RetinaNode) *flow.Endpoint {
+	ep := &flow.Endpoint{
+		Identity: common.ReservedRemoteNodeIdentity,
+		Labels:   []string{common.ReservedRemoteNodeLabel},
+	}
+	if e.nodeName != "" && n.Name() == e.nodeName {
+		ep.Identity = common.ReservedHostIdentity
+		ep.Labels = []string{common.ReservedHostLabel}
+	}
+	ep.Labels = append(ep.Labels, fmt.Sprintf("%s=%s", common.NodeNameLabel, n.Name()))
+	if n.Zone() != "" {
+		ep.Labels = append(ep.Labels, fmt.Sprintf("%s=%s", corev1.LabelTopologyZone, n.Zone()))
+	}
+	if n.Region() != "" {
+		ep.Labels = append(ep.Labels, fmt.Sprintf("%s=%s", corev1.LabelTopologyRegion, n.Region()))
+	}
+	return ep
+}
+
+func (e *Enricher) getService(obj interface{}) *flow.Service {
+	svc, ok := obj.(*common.RetinaSvc)
+	if !ok || svc == nil {
+		return nil
+	}
+	return &flow.Service{
+		Name:      svc.Name(),
+		Namespace: svc.Namespace(),
+	}
+}
+
+// translateServices uses the NAT entries of the connections to services to set
+// the backend pod of the flows sent to a service VIP, and the service of the flows
+// already translated to the backend. Replies are handled the same way on the source side.
+func (e *Enricher) translateServices(f *flow.Flow) {
+	proto, srcPort, dstPort, ok := l4Tuple(f)
+	if !ok {
+		return
+	}
+	src, dst := f.GetIP().GetSource(), f.GetIP().GetDestination()
+
+	// Request from the client, src, to the service.
+	if entry, found := e.nat.Resolve(proto, src, srcPort, dst, dstPort); found {
+		if f.DestinationService != nil {
+			if f.Destination == nil {
+				f.Destination = e.getPodEndpoint(entry.Backend)
+			}
+		} else {
+			f.DestinationService = e.getService(e.cache.GetSvcByIP(entry.VIP))
+		}
+		return
+	}
+
+	// Reply from the service to the client, dst.
+	if entry, found := e.nat.Resolve(proto, dst, dstPort, src, srcPort); found {
+		if f.SourceService != nil {
+			if f.Source == nil {
+				f.Source = e.getPodEndpoint(entry.Backend)
+			}
+		} else {
+			f.SourceService = e.getService(e.cache.GetSvcByIP(entry.VIP))
+		}
+	}
+}
+
+func (e *Enricher) getPodEndpoint(ip string) *flow.Endpoint {
+	ep := e.cache.GetPodByIP(ip)
+	if ep == nil {
+		return nil
+	}
+	return e.getEndpoint(ep)
+}
+
+// l4Tuple returns the protocol number and the ports of the flow, false if it is neither TCP nor UDP.
+func l4Tuple(f *flow.Flow) (proto uint8, srcPort, dstPort uint32, ok bool) {
+	if tcp := f.GetL4().GetTCP(); tcp != nil {
+		return ipProtoTCP, tcp.GetSourcePort(), tcp.GetDestinationPort(), true
+	}
+	if udp := f.GetL4().GetUDP(); udp != nil {
+		return ipProtoUDP, udp.GetSourcePort(), udp.GetDestinationPort(), true
+	}
+	return 0, 0, 0, false
+}
+
 func (e *Enricher) getWorkloads(ownerRefs []*common.OwnerReference) []*flow.Workload {
 	if ownerRefs == nil {
 		return nil
@@ -5,6 +5,7 @@ package enricher
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"sync"
 	"testing"
@@ -157,3 +158,424 @@ func addEvent(e *Enricher, sourceIP, destIP string) {
 	time.Sleep(100 * time.Millisecond)
 	e.Write(ev)
 }
+
+func TestEnricherDualStack(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	// the enricher is a singleton, reset it so this test gets its own rings and cache.
+	once = sync.Once{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := cache.New(pubsub.New())
+
+	pod1 := common.NewRetinaEndpoint("pod1", "ns1", nil)
+	pod1.SetIPs(&common.IPAddresses{
+		IPv4: net.ParseIP("10.0.0.1"),
+		IPv6: net.ParseIP("fd00::1"),
+	})
+	require.NoError(t, c.UpdateRetinaEndpoint(pod1))
+
+	pod2 := common.NewRetinaEndpoint("pod2", "ns2", nil)
+	pod2.SetIPs(&common.IPAddresses{
+		IPv6:       net.ParseIP("fd00::2"),
+		OtherIPv4s: []net.IP{net.ParseIP("10.0.0.2")},
+		OtherIPv6s: []net.IP{net.ParseIP("fd00::22")},
+	})
+	require.NoError(t, c.UpdateRetinaEndpoint(pod2))
+
+	tests := []struct {
+		name      string
+		ipVersion flow.IPVersion
+		src       string
+		dst       string
+		wantSrc   string
+		wantDst   string
+	}{
+		{
+			name:      "IPv4 to IPv4",
+			ipVersion: flow.IPVersion_IPv4,
+			src:       "10.0.0.1",
+			dst:       "10.0.0.2",
+			wantSrc:   "pod1",
+			wantDst:   "pod2",
+		},
+		{
+			name:      "IPv6 to IPv6",
+			ipVersion: flow.IPVersion_IPv6,
+			src:       "fd00::1",
+			dst:       "fd00::2",
+			wantSrc:   "pod1",
+			wantDst:   "pod2",
+		},
+		{
+			name:      "IPv6 to secondary IPv6",
+			ipVersion: flow.IPVersion_IPv6,
+			src:       "fd00::1",
+			dst:       "fd00::22",
+			wantSrc:   "pod1",
+			wantDst:   "pod2",
+		},
+		{
+			name:      "IPv6 to unknown IPv6",
+			ipVersion: flow.IPVersion_IPv6,
+			src:       "fd00::2",
+			dst:       "fd00::99",
+			wantSrc:   "pod2",
+		},
+	}
+
+	e := New(ctx, c)
+	oreader := e.ExportReader()
+	e.Run()
+
+	for _, tt := range tests {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event: &flow.Flow{
+				IP: &flow.IP{
+					IpVersion:   tt.ipVersion,
+					Source:      tt.src,
+					Destination: tt.dst,
+				},
+			},
+		})
+	}
+	// by design per ring, the last written item is not readable,
+	// so push two more events through the input and output rings.
+	for i := 0; i < 2; i++ {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event: &flow.Flow{
+				IP: &flow.IP{
+					IpVersion:   flow.IPVersion_IPv6,
+					Source:      "fd00::100",
+					Destination: "fd00::101",
+				},
+			},
+		})
+	}
+
+	readCtx, readCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer readCancel()
+	for _, tt := range tests {
+		ev := oreader.NextFollow(readCtx)
+		require.NotNil(t, ev, "missing enriched event for %s", tt.name)
+		f := ev.Event.(*flow.Flow)
+		assert.Equal(t, tt.src, f.GetIP().GetSource(), tt.name)
+		assert.Equal(t, tt.wantSrc, f.GetSource().GetPodName(), tt.name)
+		assert.Equal(t, tt.wantDst, f.GetDestination().GetPodName(), tt.name)
+	}
+}
+
+// fakeNATResolver resolves the connections of its entries, keyed by client IP and port and destination IP and port.
+type fakeNATResolver map[string]NATEntry
+
+func (f fakeNATResolver) Resolve(_ uint8, clientIP string, clientPort uint32, dstIP string, dstPort uint32) (NATEntry, bool) {
+	entry, ok := f[fmt.Sprintf("%s:%d-%s:%d", clientIP, clientPort, dstIP, dstPort)]
+	return entry, ok
+}
+
+func TestEnricherServices(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	// the enricher is a singleton, reset it so this test gets its own rings and cache.
+	once = sync.Once{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := cache.New(pubsub.New())
+
+	client := common.NewRetinaEndpoint("client", "ns1", nil)
+	client.SetIPs(&common.IPAddresses{IPv4: net.ParseIP("10.0.0.1")})
+	require.NoError(t, c.UpdateRetinaEndpoint(client))
+
+	backend := common.NewRetinaEndpoint("backend", "ns2", nil)
+	backend.SetIPs(&common.IPAddresses{IPv4: net.ParseIP("10.0.0.5")})
+	require.NoError(t, c.UpdateRetinaEndpoint(backend))
+
+	svc := common.NewRetinaSvc("svc", "ns2", &common.IPAddresses{IPv4: net.ParseIP("10.96.0.10")}, nil, nil)
+	require.NoError(t, c.UpdateRetinaSvc(svc))
+
+	entry := NATEntry{VIP: "10.96.0.10", Backend: "10.0.0.5"}
+	e := New(ctx, c)
+	e.SetNATResolver(fakeNATResolver{
+		"10.0.0.1:40000-10.96.0.10:80": entry,
+		"10.0.0.1:40000-10.0.0.5:8080": entry,
+	})
+	oreader := e.ExportReader()
+	e.Run()
+
+	tests := []struct {
+		name       string
+		src        string
+		srcPort    uint32
+		dst        string
+		dstPort    uint32
+		wantSrc    string
+		wantSrcSvc string
+		wantDst    string
+		wantDstSvc string
+	}{
+		{
+			name:       "request to the service VIP",
+			src:        "10.0.0.1",
+			srcPort:    40000,
+			dst:        "10.96.0.10",
+			dstPort:    80,
+			wantSrc:    "client",
+			wantDst:    "backend",
+			wantDstSvc: "svc",
+		},
+		{
+			name:       "request translated to the backend",
+			src:        "10.0.0.1",
+			srcPort:    40000,
+			dst:        "10.0.0.5",
+			dstPort:    8080,
+			wantSrc:    "client",
+			wantDst:    "backend",
+			wantDstSvc: "svc",
+		},
+		{
+			name:       "reply from the service VIP",
+			src:        "10.96.0.10",
+			srcPort:    80,
+			dst:        "10.0.0.1",
+			dstPort:    40000,
+			wantSrc:    "backend",
+			wantSrcSvc: "svc",
+			wantDst:    "client",
+		},
+		{
+			name:       "request to the service VIP without NAT entry",
+			src:        "10.0.0.1",
+			srcPort:    40001,
+			dst:        "10.96.0.10",
+			dstPort:    80,
+			wantSrc:    "client",
+			wantDstSvc: "svc",
+		},
+		{
+			name:    "request to a pod",
+			src:     "10.0.0.1",
+			srcPort: 40001,
+			dst:     "10.0.0.5",
+			dstPort: 8080,
+			wantSrc: "client",
+			wantDst: "backend",
+		},
+	}
+
+	for _, tt := range tests {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event: &flow.Flow{
+				IP: &flow.IP{IpVersion: flow.IPVersion_IPv4, Source: tt.src, Destination: tt.dst},
+				L4: &flow.Layer4{Protocol: &flow.Layer4_TCP{TCP: &flow.TCP{SourcePort: tt.srcPort, DestinationPort: tt.dstPort}}},
+			},
+		})
+	}
+	// by design per ring, the last written item is not readable,
+	// so push two more events through the input and output rings.
+	for i := 0; i < 2; i++ {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event:     &flow.Flow{IP: &flow.IP{IpVersion: flow.IPVersion_IPv4, Source: "10.0.0.100", Destination: "10.0.0.101"}},
+		})
+	}
+
+	readCtx, readCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer readCancel()
+	for _, tt := range tests {
+		ev := oreader.NextFollow(readCtx)
+		require.NotNil(t, ev, "missing enriched event for %s", tt.name)
+		f := ev.Event.(*flow.Flow)
+		assert.Equal(t, tt.wantSrc, f.GetSource().GetPodName(), tt.name)
+		assert.Equal(t, tt.wantDst, f.GetDestination().GetPodName(), tt.name)
+		assert.Equal(t, tt.wantSrcSvc, f.GetSourceService().GetName(), tt.name)
+		assert.Equal(t, tt.wantDstSvc, f.GetDestinationService().GetName(), tt.name)
+		if tt.wantDstSvc != "" {
+			assert.Equal(t, "ns2", f.GetDestinationService().GetNamespace(), tt.name)
+		}
+	}
+}
+
+func TestEnricherDualStackServices(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	// the enricher is a singleton, reset it so this test gets its own rings and cache.
+	once = sync.Once{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := cache.New(pubsub.New())
+
+	client := common.NewRetinaEndpoint("client", "ns1", nil)
+	client.SetIPs(&common.IPAddresses{
+		IPv4: net.ParseIP("10.0.0.1"),
+		IPv6: net.ParseIP("fd00::1"),
+	})
+	require.NoError(t, c.UpdateRetinaEndpoint(client))
+
+	// dual-stack services have both an IPv4 and an IPv6 cluster IP.
+	svc := common.NewRetinaSvc("svc", "ns2", &common.IPAddresses{
+		IPv4: net.ParseIP("10.96.0.10"),
+		IPv6: net.ParseIP("fd00:96::a"),
+	}, nil, nil)
+	require.NoError(t, c.UpdateRetinaSvc(svc))
+
+	tests := []struct {
+		name      string
+		ipVersion flow.IPVersion
+		src       string
+		dst       string
+		wantSrc   string
+		wantSvc   string
+	}{
+		{
+			name:      "IPv4 to the IPv4 cluster IP",
+			ipVersion: flow.IPVersion_IPv4,
+			src:       "10.0.0.1",
+			dst:       "10.96.0.10",
+			wantSrc:   "client",
+			wantSvc:   "svc",
+		},
+		{
+			name:      "IPv6 to the IPv6 cluster IP",
+			ipVersion: flow.IPVersion_IPv6,
+			src:       "fd00::1",
+			dst:       "fd00:96::a",
+			wantSrc:   "client",
+			wantSvc:   "svc",
+		},
+		{
+			name:      "IPv6 to the non canonical IPv6 cluster IP",
+			ipVersion: flow.IPVersion_IPv6,
+			src:       "fd00::1",
+			dst:       "fd00:96:0:0::a",
+			wantSrc:   "client",
+			wantSvc:   "svc",
+		},
+		{
+			name:      "IPv6 to an unknown IPv6",
+			ipVersion: flow.IPVersion_IPv6,
+			src:       "fd00::1",
+			dst:       "fd00:96::b",
+			wantSrc:   "client",
+		},
+	}
+
+	e := New(ctx, c)
+	oreader := e.ExportReader()
+	e.Run()
+
+	for _, tt := range tests {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event: &flow.Flow{
+				IP: &flow.IP{
+					IpVersion:   tt.ipVersion,
+					Source:      tt.src,
+					Destination: tt.dst,
+				},
+			},
+		})
+	}
+	// by design per ring, the last written item is not readable,
+	// so push two more events through the input and output rings.
+	for i := 0; i < 2; i++ {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event: &flow.Flow{
+				IP: &flow.IP{
+					IpVersion:   flow.IPVersion_IPv6,
+					Source:      "fd00::100",
+					Destination: "fd00::101",
+				},
+			},
+		})
+	}
+
+	readCtx, readCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer readCancel()
+	for _, tt := range tests {
+		ev := oreader.NextFollow(readCtx)
+		require.NotNil(t, ev, "missing enriched event for %s", tt.name)
+		f := ev.Event.(*flow.Flow)
+		assert.Equal(t, tt.dst, f.GetIP().GetDestination(), tt.name)
+		assert.Equal(t, tt.wantSrc, f.GetSource().GetPodName(), tt.name)
+		assert.Equal(t, tt.wantSvc, f.GetDestinationService().GetName(), tt.name)
+		// the service is not a pod, without NAT information there is no destination endpoint.
+		assert.Nil(t, f.GetDestination(), tt.name)
+	}
+}
+
+func TestEnricherNodes(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	t.Setenv(nodeNameEnvKey, "node1")
+
+	// the enricher is a singleton, reset it so this test gets its own rings and cache.
+	once = sync.Once{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := cache.New(pubsub.New())
+
+	pod := common.NewRetinaEndpoint("pod1", "ns1", nil)
+	pod.SetIPs(&common.IPAddresses{IPv4: net.ParseIP("10.0.0.1")})
+	require.NoError(t, c.UpdateRetinaEndpoint(pod))
+
+	host := common.NewRetinaNode("node1", net.ParseIP("10.224.0.4"))
+	host.SetTopology("eastus-1", "eastus")
+	require.NoError(t, c.UpdateRetinaNode(host))
+	remote := common.NewRetinaNode("node2", net.ParseIP("10.224.0.5"))
+	require.NoError(t, c.UpdateRetinaNode(remote))
+
+	e := New(ctx, c)
+	oreader := e.ExportReader()
+	e.Run()
+
+	// kubelet probing the pod, and the pod reaching a host-network pod on another node.
+	flows := [][2]string{
+		{"10.224.0.4", "10.0.0.1"},
+		{"10.0.0.1", "10.224.0.5"},
+	}
+	for _, ips := range flows {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event:     &flow.Flow{IP: &flow.IP{IpVersion: flow.IPVersion_IPv4, Source: ips[0], Destination: ips[1]}},
+		})
+	}
+	// by design per ring, the last written item is not readable,
+	// so push two more events through the input and output rings.
+	for i := 0; i < 2; i++ {
+		e.Write(&v1.Event{
+			Timestamp: timestamppb.Now(),
+			Event:     &flow.Flow{IP: &flow.IP{IpVersion: flow.IPVersion_IPv4, Source: "10.0.0.100", Destination: "10.0.0.101"}},
+		})
+	}
+
+	readCtx, readCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer readCancel()
+
+	ev := oreader.NextFollow(readCtx)
+	require.NotNil(t, ev)
+	f := ev.Event.(*flow.Flow)
+	assert.Equal(t, common.ReservedHostIdentity, f.GetSource().GetIdentity())
+	assert.Equal(t, []string{
+		common.ReservedHostLabel,
+		"kubernetes.io/hostname=node1",
+		"topology.kubernetes.io/zone=eastus-1",
+		"topology.kubernetes.io/region=eastus",
+	}, f.GetSource().GetLabels())
+	assert.Empty(t, f.GetSource().GetPodName())
+	assert.Equal(t, "pod1", f.GetDestination().GetPodName())
+
+	ev = oreader.NextFollow(readCtx)
+	require.NotNil(t, ev)
+	f = ev.Event.(*flow.Flow)
+	assert.Equal(t, "pod1", f.GetSource().GetPodName())
+	assert.Equal(t, common.ReservedRemoteNodeIdentity, f.GetDestination().GetIdentity())
+	assert.Equal(t, []string{common.ReservedRemoteNodeLabel, "kubernetes.io/hostname=node2"}, f.GetDestination().GetLabels())
+}
@@ -14,3 +14,23 @@ type EnricherInterface interface {
 	Write(ev *v1.Event)
 	ExportReader() *container.RingReader
 }
+
+const (
+	nodeNameEnvKey = "NODE_NAME"
+
+	ipProtoTCP uint8 = 6
+	ipProtoUDP uint8 = 17
+)
+
+// NATEntry is a connection to a service VIP translated to one of the service backends.
+type NATEntry struct {
+	VIP     string
+	Backend string
+}
+
+// NATResolver resolves the connections to services from the NAT state of the node.
+type NATResolver interface {
+	// Resolve returns the NAT entry of the connection from the client to dst,
+	// where dst is either the service VIP or the backend the connection was translated to.
+	Resolve(proto uint8, clientIP string, clientPort uint32, dstIP string, dstPort uint32) (NATEntry, bool)
+}
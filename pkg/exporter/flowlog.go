@@ -0,0 +1,207 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	FlowLogFormatJSON     = "json"
+	FlowLogFormatProtobuf = "protobuf"
+
+	defaultFlowLogDirectory     = "/var/log/retina/flows"
+	defaultFlowLogMaxFileSizeMB = 100
+	flowLogFileName             = "flows"
+
+	// flowLogBufferSize is the amount of encoded flows buffered before they are written.
+	// Buffers are always written whole so that a record is never split across two files.
+	flowLogBufferSize    = 64 * 1024
+	flowLogFlushInterval = time.Second
+)
+
+var (
+	ErrInvalidFlowLogFormat = errors.New("invalid flow log format, must be json or protobuf")
+	ErrFlowReaderRequired   = errors.New("flow log exporter requires enablePodLevel")
+)
+
+// flowEncoder appends the encoded flow response to the buffer.
+type flowEncoder func(buf *bytes.Buffer, res *observer.GetFlowsResponse) error
+
+// FlowLogExporter writes the enriched flows to rotating files on the node.
+// Flows are written as observer.GetFlowsResponse, like the Hubble exporter, so that
+// the files can be read with the Hubble tooling.
+type FlowLogExporter struct {
+	l                *log.ZapLogger
+	nodeName         string
+	flowReader       FlowReaderFunc
+	encode           flowEncoder
+	file             *lumberjack.Logger
+	rotationInterval time.Duration
+	flushInterval    time.Duration
+}
+
+// NewFlowLogExporter returns an exporter writing the flows of flowReader to the directory of the config.
+func NewFlowLogExporter(l *log.ZapLogger, cfg config.FlowLogExporter, flowReader FlowReaderFunc) (*FlowLogExporter, error) {
+	if flowReader == nil {
+		return nil, ErrFlowReaderRequired
+	}
+
+	var (
+		encode flowEncoder
+		ext    string
+	)
+	switch strings.ToLower(cfg.Format) {
+	case "", FlowLogFormatJSON:
+		encode, ext = encodeFlowJSON, ".json"
+	case FlowLogFormatProtobuf:
+		encode, ext = encodeFlowProtobuf, ".pb"
+	default:
+		return nil, errors.Wrapf(ErrInvalidFlowLogFormat, "got %q", cfg.Format)
+	}
+
+	dir := cfg.Directory
+	if dir == "" {
+		dir = defaultFlowLogDirectory
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gomnd // directory permissions
+		return nil, errors.Wrapf(err, "failed to create flow log directory %s", dir)
+	}
+
+	maxSize := cfg.MaxFileSizeMB
+	if maxSize <= 0 {
+		maxSize = defaultFlowLogMaxFileSizeMB
+	}
+
+	return &FlowLogExporter{
+		l:          l,
+		nodeName:   os.Getenv(nodeNameEnvKey),
+		flowReader: flowReader,
+		encode:     encode,
+		file: &lumberjack.Logger{
+			Filename:   filepath.Join(dir, flowLogFileName+ext),
+			MaxSize:    maxSize, // megabytes
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays, // days
+			Compress:   cfg.Compress,
+		},
+		rotationInterval: cfg.RotationInterval,
+		flushInterval:    flowLogFlushInterval,
+	}, nil
+}
+
+// Start writes the flows until the context is done.
+func (e *FlowLogExporter) Start(ctx context.Context) {
+	e.l.Info("Starting flow log exporter", zap.String("file", e.file.Filename), zap.Duration("rotationInterval", e.rotationInterval))
+
+	reader := e.flowReader()
+	defer func() {
+		if err := reader.Close(); err != nil {
+			e.l.Error("failed to close flow reader", zap.Error(err))
+		}
+	}()
+
+	flows := make(chan *flow.Flow, 1)
+	go func() {
+		defer close(flows)
+		for {
+			ev := reader.NextFollow(ctx)
+			if ev == nil {
+				return
+			}
+			if f, ok := ev.Event.(*flow.Flow); ok {
+				flows <- f
+			}
+		}
+	}()
+
+	flushTicker := time.NewTicker(e.flushInterval)
+	defer flushTicker.Stop()
+
+	// A nil channel never fires, leaving only size based rotation.
+	var rotate <-chan time.Time
+	if e.rotationInterval > 0 {
+		rotationTicker := time.NewTicker(e.rotationInterval)
+		defer rotationTicker.Stop()
+		rotate = rotationTicker.C
+	}
+
+	var buf bytes.Buffer
+	flush := func() {
+		if buf.Len() == 0 {
+			return
+		}
+		if _, err := e.file.Write(buf.Bytes()); err != nil {
+			e.l.Error("failed to write flows", zap.Error(err))
+		}
+		buf.Reset()
+	}
+
+	for {
+		select {
+		case f, ok := <-flows:
+			if !ok {
+				flush()
+				if err := e.file.Close(); err != nil {
+					e.l.Error("failed to close flow log file", zap.Error(err))
+				}
+				return
+			}
+			if err := e.encode(&buf, e.flowsResponse(f)); err != nil {
+				e.l.Error("failed to encode flow", zap.Error(err))
+				continue
+			}
+			if buf.Len() >= flowLogBufferSize {
+				flush()
+			}
+		case <-flushTicker.C:
+			flush()
+		case <-rotate:
+			flush()
+			if err := e.file.Rotate(); err != nil {
+				e.l.Error("failed to rotate flow log file", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (e *FlowLogExporter) flowsResponse(f *flow.Flow) *observer.GetFlowsResponse {
+	return &observer.GetFlowsResponse{
+		ResponseTypes: &observer.GetFlowsResponse_Flow{Flow: f},
+		NodeName:      e.nodeName,
+		Time:          f.GetTime(),
+	}
+}
+
+// encodeFlowJSON writes the response as a line of JSON, as the Hubble exporter does.
+func encodeFlowJSON(buf *bytes.Buffer, res *observer.GetFlowsResponse) error {
+	b, err := res.MarshalJSON()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal flow")
+	}
+	buf.Write(b)
+	buf.WriteByte('\n')
+	return nil
+}
+
+// encodeFlowProtobuf writes the response prefixed by its varint encoded size.
+func encodeFlowProtobuf(buf *bytes.Buffer, res *observer.GetFlowsResponse) error {
+	if _, err := protodelim.MarshalTo(buf, res); err != nil {
+		return errors.Wrap(err, "failed to marshal flow")
+	}
+	return nil
+}
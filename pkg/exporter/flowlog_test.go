@@ -0,0 +1,192 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package exporter
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/api/v1/observer"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/container"
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// startFlowLogExporter starts the exporter on a new ring and returns a function writing a flow to the ring.
+func startFlowLogExporter(t *testing.T, cfg config.FlowLogExporter) (*FlowLogExporter, func(src string)) {
+	t.Helper()
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	ring := container.NewRing(container.Capacity63)
+	e, err := NewFlowLogExporter(log.Logger().Named("flowlog"), cfg, func() *container.RingReader {
+		return container.NewRingReader(ring, ring.OldestWrite())
+	})
+	require.NoError(t, err)
+	e.flushInterval = 20 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		e.Start(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	// Give the exporter time to open its reader before writing.
+	time.Sleep(100 * time.Millisecond)
+	return e, func(src string) {
+		ring.Write(&v1.Event{Event: &flow.Flow{
+			Time:    timestamppb.New(time.Unix(1700000000, 0)),
+			IP:      &flow.IP{Source: src, Destination: "10.0.0.2"},
+			Verdict: flow.Verdict_FORWARDED,
+		}})
+	}
+}
+
+func readJSONFlows(t *testing.T, path string) []*observer.GetFlowsResponse {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var res []*observer.GetFlowsResponse
+	for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		if line == "" {
+			continue
+		}
+		m := &observer.GetFlowsResponse{}
+		require.NoError(t, m.UnmarshalJSON([]byte(line)))
+		res = append(res, m)
+	}
+	return res
+}
+
+func readProtobufFlows(t *testing.T, r io.Reader) []*observer.GetFlowsResponse {
+	t.Helper()
+	br := bufio.NewReader(r)
+	var res []*observer.GetFlowsResponse
+	for {
+		m := &observer.GetFlowsResponse{}
+		err := protodelim.UnmarshalFrom(br, m)
+		if errors.Is(err, io.EOF) {
+			return res
+		}
+		require.NoError(t, err)
+		res = append(res, m)
+	}
+}
+
+func TestFlowLogExporterJSON(t *testing.T) {
+	t.Setenv(nodeNameEnvKey, "node1")
+	dir := t.TempDir()
+	_, writeFlow := startFlowLogExporter(t, config.FlowLogExporter{Directory: dir})
+
+	writeFlow("10.0.0.1")
+	writeFlow("10.0.0.3")
+	// The last write of the ring is not readable until the next one.
+	writeFlow("10.0.0.4")
+
+	path := filepath.Join(dir, "flows.json")
+	require.Eventually(t, func() bool { return len(readJSONFlows(t, path)) >= 2 }, 5*time.Second, 20*time.Millisecond)
+
+	flows := readJSONFlows(t, path)
+	assert.Equal(t, "10.0.0.1", flows[0].GetFlow().GetIP().GetSource())
+	assert.Equal(t, "10.0.0.3", flows[1].GetFlow().GetIP().GetSource())
+	assert.Equal(t, "node1", flows[0].GetNodeName())
+	assert.Equal(t, int64(1700000000), flows[0].GetTime().GetSeconds())
+
+	// Hubble writes the flows with the proto field names.
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"node_name":"node1"`)
+}
+
+func TestFlowLogExporterProtobufRotation(t *testing.T) {
+	dir := t.TempDir()
+	e, writeFlow := startFlowLogExporter(t, config.FlowLogExporter{
+		Directory:        dir,
+		Format:           "protobuf",
+		Compress:         true,
+		RotationInterval: 300 * time.Millisecond,
+	})
+	assert.Equal(t, filepath.Join(dir, "flows.pb"), e.file.Filename)
+
+	writeFlow("10.0.0.1")
+	writeFlow("10.0.0.3")
+
+	// The first flow is rotated into a compressed backup.
+	var backups []string
+	require.Eventually(t, func() bool {
+		backups, _ = filepath.Glob(filepath.Join(dir, "flows-*.pb.gz"))
+		return len(backups) > 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	var flows []*observer.GetFlowsResponse
+	for _, b := range backups {
+		f, err := os.Open(b)
+		require.NoError(t, err)
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		flows = append(flows, readProtobufFlows(t, gz)...)
+		f.Close()
+	}
+	require.NotEmpty(t, flows)
+	assert.Equal(t, "10.0.0.1", flows[0].GetFlow().GetIP().GetSource())
+}
+
+func TestFlowLogExporterRetention(t *testing.T) {
+	dir := t.TempDir()
+	e, writeFlow := startFlowLogExporter(t, config.FlowLogExporter{Directory: dir, MaxBackups: 1})
+
+	for _, src := range []string{"10.0.0.1", "10.0.0.3", "10.0.0.4"} {
+		// The second write makes the first one readable.
+		writeFlow(src)
+		writeFlow(src)
+		require.Eventually(t, func() bool {
+			fi, err := os.Stat(e.file.Filename)
+			return err == nil && fi.Size() > 0
+		}, 5*time.Second, 20*time.Millisecond)
+		// lumberjack names backups with a millisecond timestamp.
+		time.Sleep(5 * time.Millisecond)
+		require.NoError(t, e.file.Rotate())
+	}
+
+	require.Eventually(t, func() bool {
+		backups, _ := filepath.Glob(filepath.Join(dir, "flows-*.json"))
+		return len(backups) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestNewFlowLogExporterErrors(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	reader := func() *container.RingReader { return nil }
+
+	_, err = NewFlowLogExporter(log.Logger(), config.FlowLogExporter{Directory: t.TempDir()}, nil)
+	require.ErrorIs(t, err, ErrFlowReaderRequired)
+
+	_, err = NewFlowLogExporter(log.Logger(), config.FlowLogExporter{Directory: t.TempDir(), Format: "csv"}, reader)
+	require.ErrorIs(t, err, ErrInvalidFlowLogFormat)
+
+	e, err := NewFlowLogExporter(log.Logger(), config.FlowLogExporter{Directory: t.TempDir(), Format: "JSON"}, reader)
+	require.NoError(t, err)
+	assert.Equal(t, defaultFlowLogMaxFileSizeMB, e.file.MaxSize)
+}
@@ -2,118 +2,315 @@
 // Licensed under the MIT license.
 package exporter
 
-// import (
-// 	"context"
-// 	"time"
-
-// 	"github.com/microsoft/retina/pkg/config"
-// 	"github.com/microsoft/retina/pkg/log"
-// 	"github.com/microsoft/retina/pkg/plugin/api"
-// 	"go.opentelemetry.io/otel"
-// 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
-// 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
-// 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
-// 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
-// 	"go.opentelemetry.io/otel/metric/global"
-// 	"go.opentelemetry.io/otel/propagation"
-// 	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
-// 	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
-// 	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
-// 	"go.opentelemetry.io/otel/sdk/resource"
-// 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-// 	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
-// 	"go.uber.org/zap"
-// 	"google.golang.org/grpc"
-// )
-
-// type OtelAgent struct {
-// 	l                    *log.ZapLogger
-// 	agentAddress         string
-// 	collectPeriodSeconds time.Duration
-// }
-
-// func NewOtelAgent(l *log.ZapLogger, c *config.Config) *OtelAgent {
-// 	return &OtelAgent{
-// 		l:                    l,
-// 		agentAddress:         c.OtelAgent.AgentAddress,
-// 		collectPeriodSeconds: time.Duration(c.OtelAgent.CollectPeriodSeconds) * time.Second,
-// 	}
-// }
-
-// func (o *OtelAgent) Start(ctx context.Context) func() {
-// 	metricPusher := setUpMetricPusher(ctx, o.agentAddress, o.collectPeriodSeconds, o.l)
-// 	traceExp := setUpTracerExporter(ctx, o.agentAddress, o.l)
-
-// 	return func() {
-// 		cxt, cancel := context.WithTimeout(ctx, time.Second)
-// 		defer cancel()
-// 		// pushes any last exports to the receiver
-// 		if err := metricPusher.Stop(cxt); err != nil {
-// 			otel.Handle(err)
-// 		}
-// 		// shutdown will flush any remaining spans and shutdown the exporter
-// 		if err := traceExp.Shutdown(cxt); err != nil {
-// 			otel.Handle(err)
-// 		}
-// 	}
-// }
-
-// func setUpTracerExporter(ctx context.Context, addr string, l *log.ZapLogger) *otlptrace.Exporter {
-// 	traceClient := otlptracegrpc.NewClient(
-// 		otlptracegrpc.WithInsecure(),
-// 		otlptracegrpc.WithEndpoint(addr),
-// 		otlptracegrpc.WithDialOption(grpc.WithBlock()),
-// 	)
-// 	traceExp, err := otlptrace.New(ctx, traceClient)
-// 	handleError(err, "Failed to create the collector trace exporter", l)
-// 	res, err := resource.New(ctx,
-// 		resource.WithFromEnv(),
-// 		resource.WithProcess(),
-// 		resource.WithTelemetrySDK(),
-// 		resource.WithHost(),
-// 		resource.WithAttributes(
-// 			// the service name used to display traces in backends
-// 			semconv.ServiceNameKey.String(api.ServiceName),
-// 		),
-// 	)
-// 	handleError(err, "Failed to create tracer resource", l)
-// 	// Register the trace exporter with a TracerProvider, using a batch
-// 	// span processor to aggregate spans before export.
-// 	bsp := sdktrace.NewBatchSpanProcessor(traceExp)
-// 	tracerProvider := sdktrace.NewTracerProvider(
-// 		sdktrace.WithSampler(sdktrace.AlwaysSample()),
-// 		sdktrace.WithResource(res),
-// 		sdktrace.WithSpanProcessor(bsp),
-// 	)
-// 	// set global propagator to tracecontext (the default is no-op).
-// 	otel.SetTextMapPropagator(propagation.TraceContext{})
-// 	otel.SetTracerProvider(tracerProvider)
-// 	return traceExp
-// }
-
-// func setUpMetricPusher(ctx context.Context, addr string, collectPeriod time.Duration, l *log.ZapLogger) *controller.Controller {
-// 	metricClient := otlpmetricgrpc.NewClient(
-// 		otlpmetricgrpc.WithInsecure(),
-// 		otlpmetricgrpc.WithEndpoint(addr))
-// 	metricExp, err := otlpmetric.New(ctx, metricClient)
-// 	handleError(err, "Failed to create the collector metric exporter", l)
-
-// 	pusher := controller.New(
-// 		processor.NewFactory(
-// 			simple.NewWithHistogramDistribution(),
-// 			metricExp,
-// 		),
-// 		controller.WithExporter(metricExp),
-// 		controller.WithCollectPeriod(collectPeriod),
-// 	)
-// 	global.SetMeterProvider(pusher)
-// 	err = pusher.Start(ctx)
-// 	handleError(err, "Failed to start otel meter pusher", l)
-// 	return pusher
-// }
-
-// func handleError(err error, message string, l *log.ZapLogger) {
-// 	if err != nil {
-// 		l.Error(message, zap.Error(err))
-// 	}
-// }
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/container"
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	OtelProtocolGRPC = "grpc"
+	OtelProtocolHTTP = "http"
+
+	otelServiceName       = "retina-agent"
+	otelScopeName         = "github.com/microsoft/retina"
+	defaultOtelInterval   = 30 * time.Second
+	otelExportTimeout     = 10 * time.Second
+	otelFlowBatchSize     = 512
+	otelFlowFlushInterval = 5 * time.Second
+	nodeNameEnvKey        = "NODE_NAME"
+)
+
+// Attributes set on the flow log records.
+const (
+	otelFlowEventName       = "retina.flow"
+	otelFlowAttrSourceIP    = "source.address"
+	otelFlowAttrDestIP      = "destination.address"
+	otelFlowAttrVerdict     = "retina.flow.verdict"
+	otelFlowAttrSourcePod   = "retina.flow.source.pod"
+	otelFlowAttrDestPod     = "retina.flow.destination.pod"
+	otelFlowAttrObservation = "retina.flow.observation_point"
+)
+
+var ErrInvalidOtelProtocol = errors.New("invalid otel exporter protocol, must be grpc or http")
+
+// otlpClient sends OTLP requests to a receiver.
+type otlpClient interface {
+	exportMetrics(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) error
+	exportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+	close() error
+}
+
+// FlowReaderFunc returns a new reader of the enriched flows.
+type FlowReaderFunc func() *container.RingReader
+
+// OtelAgent periodically pushes the metrics of a Prometheus gatherer to an OTLP receiver,
+// and optionally streams the enriched flows as OTLP log records.
+type OtelAgent struct {
+	l             *log.ZapLogger
+	interval      time.Duration
+	flushInterval time.Duration
+	gatherer      prometheus.Gatherer
+	flowReader    FlowReaderFunc
+	client        otlpClient
+	resource      *resourcepb.Resource
+	startTime     time.Time
+}
+
+// NewOtelAgent returns an agent exporting the metrics of the gatherer.
+// flowReader may be nil, in which case flows are not exported even if enabled in the config.
+func NewOtelAgent(l *log.ZapLogger, cfg config.OtelExporter, gatherer prometheus.Gatherer, flowReader FlowReaderFunc) (*OtelAgent, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("otel exporter endpoint is empty")
+	}
+
+	var (
+		client otlpClient
+		err    error
+	)
+	switch strings.ToLower(cfg.Protocol) {
+	case "", OtelProtocolGRPC:
+		client, err = newOtlpGRPCClient(cfg)
+	case OtelProtocolHTTP:
+		client, err = newOtlpHTTPClient(cfg)
+	default:
+		return nil, errors.Wrapf(ErrInvalidOtelProtocol, "got %q", cfg.Protocol)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultOtelInterval
+	}
+
+	if !cfg.ExportFlows {
+		flowReader = nil
+	}
+
+	return &OtelAgent{
+		l:             l,
+		interval:      interval,
+		flushInterval: otelFlowFlushInterval,
+		gatherer:      gatherer,
+		flowReader:    flowReader,
+		client:        client,
+		resource:      otelResource(),
+		startTime:     time.Now(),
+	}, nil
+}
+
+func otelResource() *resourcepb.Resource {
+	attrs := []*commonpb.KeyValue{stringAttr("service.name", otelServiceName)}
+	if node := os.Getenv(nodeNameEnvKey); node != "" {
+		attrs = append(attrs, stringAttr("k8s.node.name", node))
+	}
+	if host, err := os.Hostname(); err == nil {
+		attrs = append(attrs, stringAttr("host.name", host))
+	}
+	return &resourcepb.Resource{Attributes: attrs}
+}
+
+// Start pushes metrics, and flows if enabled, until the context is done.
+// Metrics are pushed one last time on shutdown.
+func (o *OtelAgent) Start(ctx context.Context) {
+	o.l.Info("Starting otel exporter", zap.Duration("interval", o.interval), zap.Bool("flows", o.flowReader != nil))
+	defer func() {
+		if err := o.client.close(); err != nil {
+			o.l.Error("failed to close otel exporter client", zap.Error(err))
+		}
+	}()
+
+	done := make(chan struct{})
+	if o.flowReader != nil {
+		go func() {
+			defer close(done)
+			o.exportFlows(ctx)
+		}()
+	} else {
+		close(done)
+	}
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			<-done
+			// Push the last values with a fresh context since ctx is done.
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), otelExportTimeout)
+			defer cancel()
+			if err := o.PushMetrics(shutdownCtx); err != nil {
+				o.l.Error("failed to push metrics on shutdown", zap.Error(err))
+			}
+			return
+		case <-ticker.C:
+			pushCtx, cancel := context.WithTimeout(ctx, otelExportTimeout)
+			if err := o.PushMetrics(pushCtx); err != nil {
+				o.l.Error("failed to push metrics", zap.Error(err))
+			}
+			cancel()
+		}
+	}
+}
+
+// PushMetrics gathers the metrics and sends them to the receiver.
+func (o *OtelAgent) PushMetrics(ctx context.Context) error {
+	mfs, err := o.gatherer.Gather()
+	if err != nil {
+		// Gather returns the metrics it could collect along with the error.
+		o.l.Warn("error gathering metrics", zap.Error(err))
+	}
+
+	metrics := metricFamiliesToOtlp(mfs, o.startTime, time.Now())
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	return o.client.exportMetrics(ctx, &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: o.resource,
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{
+						Scope:   &commonpb.InstrumentationScope{Name: otelScopeName},
+						Metrics: metrics,
+					},
+				},
+			},
+		},
+	})
+}
+
+func (o *OtelAgent) exportFlows(ctx context.Context) {
+	reader := o.flowReader()
+	defer func() {
+		if err := reader.Close(); err != nil {
+			o.l.Error("failed to close flow reader", zap.Error(err))
+		}
+	}()
+
+	records := make(chan *logspb.LogRecord, otelFlowBatchSize)
+	go func() {
+		defer close(records)
+		for {
+			ev := reader.NextFollow(ctx)
+			if ev == nil {
+				return
+			}
+			if f, ok := ev.Event.(*flow.Flow); ok {
+				records <- flowToLogRecord(f)
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(o.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*logspb.LogRecord, 0, otelFlowBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		flushCtx, cancel := context.WithTimeout(context.Background(), otelExportTimeout)
+		defer cancel()
+		if err := o.pushLogs(flushCtx, batch); err != nil {
+			o.l.Error("failed to push flows", zap.Int("flows", len(batch)), zap.Error(err))
+		}
+		batch = make([]*logspb.LogRecord, 0, otelFlowBatchSize)
+	}
+
+	for {
+		select {
+		case r, ok := <-records:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, r)
+			if len(batch) >= otelFlowBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (o *OtelAgent) pushLogs(ctx context.Context, records []*logspb.LogRecord) error {
+	return o.client.exportLogs(ctx, &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: o.resource,
+				ScopeLogs: []*logspb.ScopeLogs{
+					{
+						Scope:      &commonpb.InstrumentationScope{Name: otelScopeName},
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	})
+}
+
+// flowToLogRecord encodes the flow as JSON in the body of the record,
+// the most common fields are also set as attributes to filter on in the collector.
+func flowToLogRecord(f *flow.Flow) *logspb.LogRecord {
+	body, err := protojson.Marshal(f)
+	if err != nil {
+		body = []byte(f.String())
+	}
+
+	ts := uint64(time.Now().UnixNano())
+	observed := ts
+	if f.GetTime() != nil {
+		ts = uint64(f.GetTime().AsTime().UnixNano())
+	}
+
+	attrs := []*commonpb.KeyValue{
+		stringAttr("event.name", otelFlowEventName),
+		stringAttr(otelFlowAttrSourceIP, f.GetIP().GetSource()),
+		stringAttr(otelFlowAttrDestIP, f.GetIP().GetDestination()),
+		stringAttr(otelFlowAttrVerdict, f.GetVerdict().String()),
+		stringAttr(otelFlowAttrObservation, f.GetTraceObservationPoint().String()),
+	}
+	if src := f.GetSource(); src.GetPodName() != "" {
+		attrs = append(attrs, stringAttr(otelFlowAttrSourcePod, src.GetNamespace()+"/"+src.GetPodName()))
+	}
+	if dst := f.GetDestination(); dst.GetPodName() != "" {
+		attrs = append(attrs, stringAttr(otelFlowAttrDestPod, dst.GetNamespace()+"/"+dst.GetPodName()))
+	}
+
+	return &logspb.LogRecord{
+		TimeUnixNano:         ts,
+		ObservedTimeUnixNano: observed,
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+		Attributes:           attrs,
+	}
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
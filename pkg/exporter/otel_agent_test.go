@@ -0,0 +1,321 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package exporter
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/container"
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// otlpReceiver is an in-process OTLP receiver stub recording what it receives over gRPC and HTTP.
+type otlpReceiver struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+
+	mu      sync.Mutex
+	metrics []*metricspb.Metric
+	logs    []*logspb.LogRecord
+	headers []string
+}
+
+func (r *otlpReceiver) Export(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	r.recordMetrics(req, md.Get("x-tenant"))
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+func (r *otlpReceiver) recordMetrics(req *colmetricspb.ExportMetricsServiceRequest, headers []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.headers = append(r.headers, headers...)
+	for _, rm := range req.GetResourceMetrics() {
+		for _, sm := range rm.GetScopeMetrics() {
+			r.metrics = append(r.metrics, sm.GetMetrics()...)
+		}
+	}
+}
+
+func (r *otlpReceiver) recordLogs(req *collogspb.ExportLogsServiceRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rl := range req.GetResourceLogs() {
+		for _, sl := range rl.GetScopeLogs() {
+			r.logs = append(r.logs, sl.GetLogRecords()...)
+		}
+	}
+}
+
+func (r *otlpReceiver) metric(name string) *metricspb.Metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.metrics) - 1; i >= 0; i-- {
+		if r.metrics[i].GetName() == name {
+			return r.metrics[i]
+		}
+	}
+	return nil
+}
+
+func (r *otlpReceiver) logRecords() []*logspb.LogRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*logspb.LogRecord{}, r.logs...)
+}
+
+type logsServer struct {
+	collogspb.UnimplementedLogsServiceServer
+	r *otlpReceiver
+}
+
+func (s *logsServer) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	s.r.recordLogs(req)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func startGRPCReceiver(t *testing.T) (*otlpReceiver, string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	r := &otlpReceiver{}
+	s := grpc.NewServer()
+	colmetricspb.RegisterMetricsServiceServer(s, r)
+	collogspb.RegisterLogsServiceServer(s, &logsServer{r: r})
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	return r, lis.Addr().String()
+}
+
+func startHTTPReceiver(t *testing.T) (*otlpReceiver, string) {
+	t.Helper()
+	r := &otlpReceiver{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, otlpHTTPContentType, req.Header.Get("Content-Type"))
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		var resp proto.Message
+		switch req.URL.Path {
+		case otlpHTTPMetricsPath:
+			m := &colmetricspb.ExportMetricsServiceRequest{}
+			require.NoError(t, proto.Unmarshal(body, m))
+			r.recordMetrics(m, req.Header.Values("X-Tenant"))
+			resp = &colmetricspb.ExportMetricsServiceResponse{}
+		case otlpHTTPLogsPath:
+			l := &collogspb.ExportLogsServiceRequest{}
+			require.NoError(t, proto.Unmarshal(body, l))
+			r.recordLogs(l)
+			resp = &collogspb.ExportLogsServiceResponse{}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		b, _ := proto.Marshal(resp)
+		w.Header().Set("Content-Type", otlpHTTPContentType)
+		_, _ = w.Write(b)
+	}))
+	t.Cleanup(ts.Close)
+
+	return r, ts.URL
+}
+
+func testRegistry(t *testing.T) *prometheus.Registry {
+	t.Helper()
+	reg := prometheus.NewRegistry()
+
+	counter := CreatePrometheusCounterVecForMetric(reg, "test_forward_count", "forwarded packets", "direction")
+	counter.WithLabelValues("ingress").Add(3)
+	gauge := CreatePrometheusGaugeVecForMetric(reg, "test_gauge", "a gauge", "node")
+	gauge.WithLabelValues("node1").Set(7)
+	hist := CreatePrometheusHistogramWithLinearBucketsForMetric(reg, "test_latency", "latency", 1, 1, 2)
+	hist.Observe(0.5)
+	hist.Observe(1.5)
+	hist.Observe(5)
+	summary := prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "test_summary",
+		Help:       "a summary",
+		Objectives: map[float64]float64{0.5: 0.05},
+	})
+	reg.MustRegister(summary)
+	summary.Observe(2)
+
+	return reg
+}
+
+func TestMetricFamiliesToOtlp(t *testing.T) {
+	mfs, err := testRegistry(t).Gather()
+	require.NoError(t, err)
+
+	start := time.Unix(100, 0)
+	now := time.Unix(200, 0)
+	metrics := metricFamiliesToOtlp(mfs, start, now)
+	require.Len(t, metrics, 4)
+
+	byName := make(map[string]*metricspb.Metric)
+	for _, m := range metrics {
+		byName[m.GetName()] = m
+	}
+
+	sum := byName[RetinaNamespace+"_test_forward_count"].GetSum()
+	require.NotNil(t, sum)
+	assert.True(t, sum.GetIsMonotonic())
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, sum.GetAggregationTemporality())
+	require.Len(t, sum.GetDataPoints(), 1)
+	assert.InDelta(t, 3, sum.GetDataPoints()[0].GetAsDouble(), 0)
+	assert.Equal(t, uint64(start.UnixNano()), sum.GetDataPoints()[0].GetStartTimeUnixNano())
+	assert.Equal(t, uint64(now.UnixNano()), sum.GetDataPoints()[0].GetTimeUnixNano())
+	assert.Equal(t, "direction", sum.GetDataPoints()[0].GetAttributes()[0].GetKey())
+	assert.Equal(t, "ingress", sum.GetDataPoints()[0].GetAttributes()[0].GetValue().GetStringValue())
+
+	gauge := byName[RetinaNamespace+"_test_gauge"].GetGauge()
+	require.NotNil(t, gauge)
+	assert.InDelta(t, 7, gauge.GetDataPoints()[0].GetAsDouble(), 0)
+
+	hist := byName[RetinaNamespace+"_test_latency"].GetHistogram()
+	require.NotNil(t, hist)
+	dp := hist.GetDataPoints()[0]
+	assert.Equal(t, []float64{1, 2}, dp.GetExplicitBounds())
+	assert.Equal(t, []uint64{1, 1, 1}, dp.GetBucketCounts())
+	assert.Equal(t, uint64(3), dp.GetCount())
+	assert.InDelta(t, 7, dp.GetSum(), 0)
+
+	summary := byName["test_summary"].GetSummary()
+	require.NotNil(t, summary)
+	assert.Equal(t, uint64(1), summary.GetDataPoints()[0].GetCount())
+	require.Len(t, summary.GetDataPoints()[0].GetQuantileValues(), 1)
+	assert.InDelta(t, 0.5, summary.GetDataPoints()[0].GetQuantileValues()[0].GetQuantile(), 0)
+}
+
+func TestOtelAgentPushMetrics(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		protocol string
+		start    func(t *testing.T) (*otlpReceiver, string)
+	}{
+		{name: "grpc", protocol: OtelProtocolGRPC, start: startGRPCReceiver},
+		{name: "http", protocol: OtelProtocolHTTP, start: startHTTPReceiver},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, endpoint := tt.start(t)
+			agent, err := NewOtelAgent(log.Logger().Named("otel"), config.OtelExporter{
+				Endpoint: endpoint,
+				Protocol: tt.protocol,
+				Insecure: true,
+				Headers:  map[string]string{"x-tenant": "retina"},
+			}, testRegistry(t), nil)
+			require.NoError(t, err)
+			defer agent.client.close() //nolint:errcheck // test
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			require.NoError(t, agent.PushMetrics(ctx))
+
+			m := r.metric(RetinaNamespace + "_test_forward_count")
+			require.NotNil(t, m)
+			assert.InDelta(t, 3, m.GetSum().GetDataPoints()[0].GetAsDouble(), 0)
+			assert.Contains(t, r.headers, "retina")
+		})
+	}
+}
+
+func TestOtelAgentExportFlows(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	r, endpoint := startGRPCReceiver(t)
+	ring := container.NewRing(container.Capacity63)
+	agent, err := NewOtelAgent(log.Logger().Named("otel"), config.OtelExporter{
+		Endpoint:    endpoint,
+		Insecure:    true,
+		Interval:    time.Hour,
+		ExportFlows: true,
+	}, testRegistry(t), func() *container.RingReader {
+		return container.NewRingReader(ring, ring.OldestWrite())
+	})
+	require.NoError(t, err)
+	agent.flushInterval = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		agent.Start(ctx)
+		close(done)
+	}()
+
+	writeFlow := func(src string) {
+		ring.Write(&v1.Event{Event: &flow.Flow{
+			IP:          &flow.IP{Source: src, Destination: "10.0.0.2"},
+			Verdict:     flow.Verdict_FORWARDED,
+			Source:      &flow.Endpoint{Namespace: "ns1", PodName: "pod1"},
+			Destination: &flow.Endpoint{},
+		}})
+	}
+	// Give the agent time to open its reader before writing.
+	time.Sleep(100 * time.Millisecond)
+	writeFlow("10.0.0.1")
+	writeFlow("10.0.0.3")
+	// The last write of the ring is not readable until the next one.
+	writeFlow("10.0.0.4")
+
+	require.Eventually(t, func() bool { return len(r.logRecords()) >= 2 }, 5*time.Second, 50*time.Millisecond)
+
+	rec := r.logRecords()[0]
+	assert.True(t, strings.Contains(rec.GetBody().GetStringValue(), `"10.0.0.1"`), rec.GetBody().GetStringValue())
+	attrs := make(map[string]string)
+	for _, kv := range rec.GetAttributes() {
+		attrs[kv.GetKey()] = kv.GetValue().GetStringValue()
+	}
+	assert.Equal(t, "10.0.0.1", attrs[otelFlowAttrSourceIP])
+	assert.Equal(t, "FORWARDED", attrs[otelFlowAttrVerdict])
+	assert.Equal(t, "ns1/pod1", attrs[otelFlowAttrSourcePod])
+	assert.NotContains(t, attrs, otelFlowAttrDestPod)
+
+	// Metrics are pushed on shutdown even though the interval never elapsed.
+	cancel()
+	<-done
+	assert.NotNil(t, r.metric(RetinaNamespace+"_test_gauge"))
+}
+
+func TestNewOtelAgentErrors(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	_, err = NewOtelAgent(log.Logger(), config.OtelExporter{}, prometheus.NewRegistry(), nil)
+	require.Error(t, err)
+
+	_, err = NewOtelAgent(log.Logger(), config.OtelExporter{Endpoint: "localhost:4317", Protocol: "udp"}, prometheus.NewRegistry(), nil)
+	require.ErrorIs(t, err, ErrInvalidOtelProtocol)
+
+	agent, err := NewOtelAgent(log.Logger(), config.OtelExporter{Endpoint: "localhost:4318", Protocol: "HTTP"}, prometheus.NewRegistry(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://localhost:4318", agent.client.(*otlpHTTPClient).baseURL)
+	assert.Nil(t, agent.flowReader)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/microsoft/retina/pkg/config"
+	"github.com/pkg/errors"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	otlpHTTPMetricsPath = "/v1/metrics"
+	otlpHTTPLogsPath    = "/v1/logs"
+	otlpHTTPContentType = "application/x-protobuf"
+)
+
+type otlpGRPCClient struct {
+	conn    *grpc.ClientConn
+	metrics colmetricspb.MetricsServiceClient
+	logs    collogspb.LogsServiceClient
+	headers metadata.MD
+}
+
+func newOtlpGRPCClient(cfg config.OtelExporter) (*otlpGRPCClient, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if cfg.Insecure {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.NewClient(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create otlp grpc client for %s", cfg.Endpoint)
+	}
+
+	return &otlpGRPCClient{
+		conn:    conn,
+		metrics: colmetricspb.NewMetricsServiceClient(conn),
+		logs:    collogspb.NewLogsServiceClient(conn),
+		headers: metadata.New(cfg.Headers),
+	}, nil
+}
+
+func (c *otlpGRPCClient) exportMetrics(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) error {
+	resp, err := c.metrics.Export(metadata.NewOutgoingContext(ctx, c.headers), req)
+	if err != nil {
+		return errors.Wrap(err, "failed to export metrics")
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedDataPoints(); rejected > 0 {
+		return fmt.Errorf("receiver rejected %d data points: %s", rejected, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+func (c *otlpGRPCClient) exportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	resp, err := c.logs.Export(metadata.NewOutgoingContext(ctx, c.headers), req)
+	if err != nil {
+		return errors.Wrap(err, "failed to export logs")
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+		return fmt.Errorf("receiver rejected %d log records: %s", rejected, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+func (c *otlpGRPCClient) close() error {
+	return c.conn.Close()
+}
+
+type otlpHTTPClient struct {
+	baseURL string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newOtlpHTTPClient(cfg config.OtelExporter) (*otlpHTTPClient, error) {
+	baseURL := strings.TrimSuffix(cfg.Endpoint, "/")
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		scheme := "https://"
+		if cfg.Insecure {
+			scheme = "http://"
+		}
+		baseURL = scheme + baseURL
+	}
+
+	return &otlpHTTPClient{
+		baseURL: baseURL,
+		headers: cfg.Headers,
+		client:  &http.Client{Timeout: otelExportTimeout},
+	}, nil
+}
+
+func (c *otlpHTTPClient) exportMetrics(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) error {
+	resp := &colmetricspb.ExportMetricsServiceResponse{}
+	if err := c.post(ctx, otlpHTTPMetricsPath, req, resp); err != nil {
+		return errors.Wrap(err, "failed to export metrics")
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedDataPoints(); rejected > 0 {
+		return fmt.Errorf("receiver rejected %d data points: %s", rejected, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+func (c *otlpHTTPClient) exportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	resp := &collogspb.ExportLogsServiceResponse{}
+	if err := c.post(ctx, otlpHTTPLogsPath, req, resp); err != nil {
+		return errors.Wrap(err, "failed to export logs")
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+		return fmt.Errorf("receiver rejected %d log records: %s", rejected, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+func (c *otlpHTTPClient) post(ctx context.Context, path string, req, resp proto.Message) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request")
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	httpReq.Header.Set("Content-Type", otlpHTTPContentType)
+	for k, v := range c.headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	httpResp, err := c.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response")
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", httpResp.Status)
+	}
+	if len(respBody) == 0 {
+		return nil
+	}
+	return errors.Wrap(proto.Unmarshal(respBody, resp), "failed to unmarshal response")
+}
+
+func (c *otlpHTTPClient) close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
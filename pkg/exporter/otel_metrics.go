@@ -0,0 +1,151 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package exporter
+
+import (
+	"math"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// metricFamiliesToOtlp converts gathered Prometheus metric families to OTLP metrics.
+// Prometheus counters and histograms are cumulative since the start of the process,
+// so they are exported with a cumulative temporality starting at start.
+func metricFamiliesToOtlp(mfs []*dto.MetricFamily, start, now time.Time) []*metricspb.Metric {
+	startNano := uint64(start.UnixNano())
+	nowNano := uint64(now.UnixNano())
+
+	metrics := make([]*metricspb.Metric, 0, len(mfs))
+	for _, mf := range mfs {
+		if len(mf.GetMetric()) == 0 {
+			continue
+		}
+
+		m := &metricspb.Metric{
+			Name:        mf.GetName(),
+			Description: mf.GetHelp(),
+		}
+
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			points := make([]*metricspb.NumberDataPoint, 0, len(mf.GetMetric()))
+			for _, pm := range mf.GetMetric() {
+				points = append(points, numberDataPoint(pm, pm.GetCounter().GetValue(), startNano, nowNano))
+			}
+			m.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				DataPoints:             points,
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			}}
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			points := make([]*metricspb.NumberDataPoint, 0, len(mf.GetMetric()))
+			for _, pm := range mf.GetMetric() {
+				v := pm.GetGauge().GetValue()
+				if mf.GetType() == dto.MetricType_UNTYPED {
+					v = pm.GetUntyped().GetValue()
+				}
+				points = append(points, numberDataPoint(pm, v, 0, nowNano))
+			}
+			m.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: points}}
+		case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+			points := make([]*metricspb.HistogramDataPoint, 0, len(mf.GetMetric()))
+			for _, pm := range mf.GetMetric() {
+				points = append(points, histogramDataPoint(pm, startNano, nowNano))
+			}
+			m.Data = &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+				DataPoints:             points,
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			}}
+		case dto.MetricType_SUMMARY:
+			points := make([]*metricspb.SummaryDataPoint, 0, len(mf.GetMetric()))
+			for _, pm := range mf.GetMetric() {
+				points = append(points, summaryDataPoint(pm, startNano, nowNano))
+			}
+			m.Data = &metricspb.Metric_Summary{Summary: &metricspb.Summary{DataPoints: points}}
+		default:
+			continue
+		}
+
+		metrics = append(metrics, m)
+	}
+
+	return metrics
+}
+
+func numberDataPoint(pm *dto.Metric, v float64, start, now uint64) *metricspb.NumberDataPoint {
+	return &metricspb.NumberDataPoint{
+		Attributes:        labelsToAttributes(pm.GetLabel()),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      timestamp(pm, now),
+		Value:             &metricspb.NumberDataPoint_AsDouble{AsDouble: v},
+	}
+}
+
+// histogramDataPoint converts the cumulative Prometheus buckets to the
+// per bucket counts of OTLP, the last OTLP bucket is the +Inf bucket.
+func histogramDataPoint(pm *dto.Metric, start, now uint64) *metricspb.HistogramDataPoint {
+	h := pm.GetHistogram()
+
+	bounds := make([]float64, 0, len(h.GetBucket()))
+	counts := make([]uint64, 0, len(h.GetBucket())+1)
+	var prev uint64
+	for _, b := range h.GetBucket() {
+		if math.IsInf(b.GetUpperBound(), 1) {
+			continue
+		}
+		bounds = append(bounds, b.GetUpperBound())
+		counts = append(counts, b.GetCumulativeCount()-prev)
+		prev = b.GetCumulativeCount()
+	}
+	counts = append(counts, h.GetSampleCount()-prev)
+
+	sum := h.GetSampleSum()
+	return &metricspb.HistogramDataPoint{
+		Attributes:        labelsToAttributes(pm.GetLabel()),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      timestamp(pm, now),
+		Count:             h.GetSampleCount(),
+		Sum:               &sum,
+		BucketCounts:      counts,
+		ExplicitBounds:    bounds,
+	}
+}
+
+func summaryDataPoint(pm *dto.Metric, start, now uint64) *metricspb.SummaryDataPoint {
+	s := pm.GetSummary()
+
+	quantiles := make([]*metricspb.SummaryDataPoint_ValueAtQuantile, 0, len(s.GetQuantile()))
+	for _, q := range s.GetQuantile() {
+		quantiles = append(quantiles, &metricspb.SummaryDataPoint_ValueAtQuantile{
+			Quantile: q.GetQuantile(),
+			Value:    q.GetValue(),
+		})
+	}
+
+	return &metricspb.SummaryDataPoint{
+		Attributes:        labelsToAttributes(pm.GetLabel()),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      timestamp(pm, now),
+		Count:             s.GetSampleCount(),
+		Sum:               s.GetSampleSum(),
+		QuantileValues:    quantiles,
+	}
+}
+
+func labelsToAttributes(labels []*dto.LabelPair) []*commonpb.KeyValue {
+	attrs := make([]*commonpb.KeyValue, 0, len(labels))
+	for _, l := range labels {
+		attrs = append(attrs, stringAttr(l.GetName(), l.GetValue()))
+	}
+	return attrs
+}
+
+func timestamp(pm *dto.Metric, now uint64) uint64 {
+	if pm.TimestampMs != nil {
+		return uint64(pm.GetTimestampMs()) * uint64(time.Millisecond)
+	}
+	return now
+}
@@ -86,6 +86,18 @@ func CreatePrometheusHistogramWithLinearBucketsForMetric(r prometheus.Registerer
 	return promauto.With(r).NewHistogram(opts)
 }
 
+func CreatePrometheusHistogramVecForMetric(r prometheus.Registerer, name, desc string, buckets []float64, labels ...string) *prometheus.HistogramVec {
+	return promauto.With(r).NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: RetinaNamespace,
+			Name:      name,
+			Help:      desc,
+			Buckets:   buckets,
+		},
+		labels,
+	)
+}
+
 func UnregisterMetric(r prometheus.Registerer, metric prometheus.Collector) {
 	if metric != nil {
 		r.Unregister(metric)
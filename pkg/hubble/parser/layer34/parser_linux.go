@@ -6,6 +6,7 @@ import (
 
 	"github.com/cilium/cilium/api/v1/flow"
 	"github.com/cilium/cilium/pkg/ipcache"
+	"github.com/google/gopacket/layers"
 	"github.com/microsoft/retina/pkg/hubble/common"
 	"github.com/microsoft/retina/pkg/utils"
 	"github.com/sirupsen/logrus"
@@ -59,6 +60,8 @@ func (p *Parser) Decode(f *flow.Flow) *flow.Flow {
 func (p *Parser) decodeSummary(f *flow.Flow) {
 	if f.GetVerdict() == flow.Verdict_DROPPED {
 		// Setting subtype to DROPPED for huuble cli.
+		// The drop reason of the summary is the one of the metadata, including the kernel drop reasons which have no
+		// equivalent drop reason in Hubble.
 		if f.GetEventType() != nil {
 			f.GetEventType().SubType = int32(f.GetDropReasonDesc())
 			//nolint:lll // long line is long
@@ -79,6 +82,9 @@ func (p *Parser) decodeSummary(f *flow.Flow) {
 			}
 		case *flow.Layer4_UDP:
 			f.Summary = "UDP" // nolint:staticcheck // We need summary for now.
+		case *flow.Layer4_ICMPv4:
+			icmp := f.GetL4().GetICMPv4()
+			f.Summary = "ICMPv4 " + layers.CreateICMPv4TypeCode(uint8(icmp.GetType()), uint8(icmp.GetCode())).String() // nolint:staticcheck // We need summary for now.
 		}
 	}
 }
@@ -0,0 +1,52 @@
+package layer34
+
+import (
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSummaryDropReason(t *testing.T) {
+	tests := []struct {
+		name        string
+		dropReason  utils.DropReason
+		wantSubType flow.DropReason
+		wantReason  string
+	}{
+		{
+			name:        "retina drop reason",
+			dropReason:  utils.DropReason_IPTABLE_RULE_DROP,
+			wantSubType: flow.DropReason_POLICY_DENIED,
+			wantReason:  "IPTABLE_RULE_DROP",
+		},
+		{
+			name:        "kernel drop reason",
+			dropReason:  utils.DropReason_SKB_DROP_REASON_NO_SOCKET,
+			wantSubType: flow.DropReason_SOCKET_LOOKUP_FAILED,
+			wantReason:  "SKB_DROP_REASON_NO_SOCKET",
+		},
+		{
+			name:        "kernel drop reason unknown to Hubble",
+			dropReason:  utils.DropReason_SKB_DROP_REASON_TCP_ZEROWINDOW,
+			wantSubType: flow.DropReason_DROP_REASON_UNKNOWN,
+			wantReason:  "SKB_DROP_REASON_TCP_ZEROWINDOW",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &flow.Flow{}
+			meta := &utils.RetinaMetadata{}
+			utils.AddDropReason(f, meta, uint16(tt.dropReason))
+			utils.AddRetinaMetadata(f, meta)
+
+			p := &Parser{}
+			p.decodeSummary(f)
+
+			assert.Equal(t, int32(tt.wantSubType), f.GetEventType().GetSubType())
+			assert.Contains(t, f.GetSummary(), "Drop Reason: "+tt.wantReason+"\n") //nolint:staticcheck // We need summary for now.
+		})
+	}
+}
@@ -12,4 +12,7 @@ const (
 	AppLabel = LabelPrefix + "/app"
 
 	CaptureNameLabel = "capture-name"
+
+	// CaptureRunLabel identifies the run of a scheduled Capture by the start timestamp of its capture files.
+	CaptureRunLabel = "capture-run"
 )
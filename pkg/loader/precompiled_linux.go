@@ -0,0 +1,21 @@
+package loader
+
+import (
+	"github.com/cilium/ebpf"
+	"github.com/pkg/errors"
+)
+
+// LoadPrecompiled returns the collection spec of an object precompiled by bpf2go and embedded in the agent, loaded by
+// load, with the settings of the program rewritten in its volatile const globals.
+// An error means that the program must be compiled at runtime instead, for example because the object was built before
+// the settings were declared as constants.
+func LoadPrecompiled(load func() (*ebpf.CollectionSpec, error), consts map[string]interface{}) (*ebpf.CollectionSpec, error) {
+	spec, err := load()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load precompiled object")
+	}
+	if err := spec.RewriteConstants(consts); err != nil {
+		return nil, errors.Wrap(err, "failed to rewrite constants of precompiled object")
+	}
+	return spec, nil
+}
@@ -0,0 +1,57 @@
+package loader
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/btf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rodataSpec returns the spec of an object declaring the volatile const globals enable_a and enable_b of one byte.
+func rodataSpec() *ebpf.CollectionSpec {
+	u8 := &btf.Int{Name: "__u8", Size: 1}
+	return &ebpf.CollectionSpec{
+		Maps: map[string]*ebpf.MapSpec{
+			".rodata": {
+				Name:       ".rodata",
+				Type:       ebpf.Array,
+				KeySize:    4,
+				ValueSize:  2,
+				MaxEntries: 1,
+				Value: &btf.Datasec{
+					Name: ".rodata",
+					Size: 2,
+					Vars: []btf.VarSecinfo{
+						{Type: &btf.Var{Name: "enable_a", Type: &btf.Volatile{Type: &btf.Const{Type: u8}}}, Offset: 0, Size: 1},
+						{Type: &btf.Var{Name: "enable_b", Type: &btf.Volatile{Type: &btf.Const{Type: u8}}}, Offset: 1, Size: 1},
+					},
+				},
+				Contents: []ebpf.MapKV{{Key: uint32(0), Value: []byte{0, 0}}},
+			},
+		},
+	}
+}
+
+func TestLoadPrecompiled(t *testing.T) {
+	spec, err := LoadPrecompiled(func() (*ebpf.CollectionSpec, error) {
+		return rodataSpec(), nil
+	}, map[string]interface{}{"enable_a": uint8(1), "enable_b": uint8(2)})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, spec.Maps[".rodata"].Contents[0].Value)
+
+	// The objects built before the settings were declared as constants are not used.
+	_, err = LoadPrecompiled(func() (*ebpf.CollectionSpec, error) {
+		return rodataSpec(), nil
+	}, map[string]interface{}{"enable_a": uint8(1), "enable_c": uint8(1)})
+	var missing *ebpf.MissingConstantsError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, []string{"enable_c"}, missing.Constants)
+
+	_, err = LoadPrecompiled(func() (*ebpf.CollectionSpec, error) {
+		return nil, errors.New("no object")
+	}, nil)
+	require.Error(t, err)
+}
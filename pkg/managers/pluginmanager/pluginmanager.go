@@ -90,7 +90,7 @@ func (p *PluginManager) Stop() {
 // Reconcile reconciles a particular plugin.
 func (p *PluginManager) Reconcile(ctx context.Context, pl plugin.Plugin) error {
 	defer p.tel.StopPerf(p.tel.StartPerf("reconcile-" + pl.Name()))
-	// Regenerate eBPF code and bpf object.
+	// Prepare the precompiled bpf object, or regenerate eBPF code and bpf object if it cannot be used.
 	// This maybe no-op for plugins that don't use eBPF.
 	if err := pl.Generate(ctx); err != nil {
 		return errors.Wrap(err, "failed to generate plugin")
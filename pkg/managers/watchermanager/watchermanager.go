@@ -11,6 +11,7 @@ import (
 	"github.com/microsoft/retina/pkg/log"
 	"github.com/microsoft/retina/pkg/watchers/apiserver"
 	"github.com/microsoft/retina/pkg/watchers/endpoint"
+	"github.com/microsoft/retina/pkg/watchers/link"
 	"go.uber.org/zap"
 )
 
@@ -23,6 +24,7 @@ func NewWatcherManager() *WatcherManager {
 	return &WatcherManager{
 		Watchers: []IWatcher{
 			endpoint.Watcher(),
+			link.Watcher(),
 			apiserver.Watcher(),
 		},
 		l:           log.Logger().Named("watcher-manager"),
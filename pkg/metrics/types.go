@@ -100,6 +100,8 @@ func ToPrometheusType(metric interface{}) prometheus.Collector {
 		return m.(*prometheus.GaugeVec)
 	case CounterVec:
 		return m.(*prometheus.CounterVec)
+	case HistogramVec:
+		return m.(*prometheus.HistogramVec)
 	default:
 		metricsLogger.Error("error converting unknown metric type", zap.Any("metric", m))
 		return nil
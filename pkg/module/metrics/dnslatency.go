@@ -0,0 +1,230 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/cilium/cilium/api/v1/flow"
+	ttlcache "github.com/jellydator/ttlcache/v3"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	metricsinit "github.com/microsoft/retina/pkg/metrics"
+	"github.com/microsoft/retina/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// Metric descriptions
+	DNSLatencyDesc                = "Latency of DNS queries in seconds, from the query to its response"
+	DNSUnansweredQueryCounterDesc = "Total number of DNS queries that did not get a response"
+
+	// dnsQueryTimeout is how long a query waits for its response before it is counted as unanswered.
+	dnsQueryTimeout = 5 * time.Second
+	// dnsQueryCacheCapacity bounds the number of queries waiting for a response on the node.
+	dnsQueryCacheCapacity uint64 = 50000
+)
+
+var (
+	DNSLatencyName                = fmt.Sprintf("adv_%s_seconds", utils.DNSLatencyName)
+	DNSUnansweredQueryCounterName = fmt.Sprintf("adv_%s", utils.DNSUnansweredQueryCounterName)
+
+	// dnsLatencyBuckets from 0.5ms for a cached answer to 5s, the usual resolver timeout.
+	dnsLatencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5} //nolint:gomnd // buckets
+)
+
+// dnsKey pairs a response with its query.
+// The client is the source of the query and the destination of the response.
+type dnsKey struct {
+	clientIP   string
+	clientPort uint32
+	serverIP   string
+	serverPort uint32
+	id         uint32
+	query      string
+}
+
+type dnsQuery struct {
+	t time.Time
+	// labels of the query flow.
+	labels []string
+}
+
+// DNSLatencyMetrics pairs the DNS queries with their response, by transaction ID, 5-tuple and query name,
+// to observe the latency of the answered queries or count the unanswered ones.
+type DNSLatencyMetrics struct {
+	baseMetricObject
+	latency    metricsinit.HistogramVec
+	unanswered metricsinit.CounterVec
+	cache      *ttlcache.Cache[dnsKey, *dnsQuery]
+	timeout    time.Duration
+}
+
+func NewDNSLatencyMetrics(ctxOptions *api.MetricsContextOptions, fl *log.ZapLogger, isLocalContext enrichmentContext) *DNSLatencyMetrics {
+	if ctxOptions == nil || !isDNSLatencyMetric(ctxOptions.MetricName) {
+		return nil
+	}
+
+	fl = fl.Named("dnslatency-metricsmodule")
+	fl.Info("Creating DNS latency metrics", zap.Any("options", ctxOptions))
+	return &DNSLatencyMetrics{
+		baseMetricObject: newBaseMetricsObject(ctxOptions, fl, isLocalContext),
+		timeout:          dnsQueryTimeout,
+	}
+}
+
+func isDNSLatencyMetric(name string) bool {
+	name = strings.ToLower(name)
+	return name == utils.DNSLatencyName || name == utils.DNSUnansweredQueryCounterName
+}
+
+func (d *DNSLatencyMetrics) Init(metricName string) {
+	switch metricName {
+	case utils.DNSLatencyName:
+		d.latency = exporter.CreatePrometheusHistogramVecForMetric(
+			exporter.AdvancedRegistry,
+			DNSLatencyName,
+			DNSLatencyDesc,
+			dnsLatencyBuckets,
+			d.getLabels()...,
+		)
+	case utils.DNSUnansweredQueryCounterName:
+		d.unanswered = exporter.CreatePrometheusCounterVecForMetric(
+			exporter.AdvancedRegistry,
+			DNSUnansweredQueryCounterName,
+			DNSUnansweredQueryCounterDesc,
+			d.getLabels()...,
+		)
+	}
+
+	d.cache = ttlcache.New(
+		ttlcache.WithTTL[dnsKey, *dnsQuery](d.timeout),
+		ttlcache.WithCapacity[dnsKey, *dnsQuery](dnsQueryCacheCapacity),
+	)
+	d.cache.OnEviction(func(_ context.Context, reason ttlcache.EvictionReason, item *ttlcache.Item[dnsKey, *dnsQuery]) {
+		// Queries evicted because the cache is full are not known to be unanswered.
+		if reason == ttlcache.EvictionReasonExpired && d.unanswered != nil {
+			d.unanswered.WithLabelValues(item.Value().labels...).Inc()
+		}
+	})
+	go d.cache.Start()
+}
+
+func (d *DNSLatencyMetrics) getLabels() []string {
+	labels := make([]string, 0)
+	if d.srcCtx != nil {
+		labels = append(labels, d.srcCtx.getLabels()...)
+	}
+	if d.dstCtx != nil {
+		labels = append(labels, d.dstCtx.getLabels()...)
+	}
+	return labels
+}
+
+func (d *DNSLatencyMetrics) ProcessFlow(flow *v1.Flow) {
+	if flow == nil || flow.Verdict != utils.Verdict_DNS || flow.GetIP() == nil || flow.GetTime() == nil {
+		return
+	}
+	flowDNS, dnsType, _ := utils.GetDNS(flow)
+	if flowDNS == nil {
+		return
+	}
+
+	srcPort, dstPort := dnsPorts(flow)
+	switch dnsType {
+	case utils.DNSType_QUERY:
+		k := dnsKey{
+			clientIP:   flow.GetIP().GetSource(),
+			clientPort: srcPort,
+			serverIP:   flow.GetIP().GetDestination(),
+			serverPort: dstPort,
+			id:         utils.GetDNSID(flow),
+			query:      flowDNS.GetQuery(),
+		}
+		// A query may be seen more than once on its way out, keep the first one.
+		if d.cache.Has(k) {
+			return
+		}
+		labels, ok := d.values(flow)
+		if !ok {
+			return
+		}
+		d.cache.Set(k, &dnsQuery{t: flow.GetTime().AsTime(), labels: labels}, ttlcache.DefaultTTL)
+	case utils.DNSType_RESPONSE:
+		k := dnsKey{
+			clientIP:   flow.GetIP().GetDestination(),
+			clientPort: dstPort,
+			serverIP:   flow.GetIP().GetSource(),
+			serverPort: srcPort,
+			id:         utils.GetDNSID(flow),
+			query:      flowDNS.GetQuery(),
+		}
+		item, found := d.cache.GetAndDelete(k)
+		if !found || d.latency == nil {
+			return
+		}
+		latency := flow.GetTime().AsTime().Sub(item.Value().t)
+		if latency < 0 {
+			return
+		}
+		d.latency.WithLabelValues(item.Value().labels...).Observe(latency.Seconds())
+	case utils.DNSType_UNKNOWN:
+	}
+}
+
+// values returns the context label values of the query flow, false if there are none in local context.
+func (d *DNSLatencyMetrics) values(flow *v1.Flow) ([]string, bool) {
+	if d.isLocalContext() {
+		if d.srcCtx == nil {
+			return []string{}, true
+		}
+		labelValuesMap := d.srcCtx.getLocalCtxValues(flow)
+		if labelValuesMap == nil {
+			return nil, false
+		}
+		// Account the query to the local client pod if any, else to the local server pod.
+		if len(labelValuesMap[egress]) > 0 && (flow.TrafficDirection != v1.TrafficDirection_INGRESS || len(labelValuesMap[ingress]) == 0) {
+			return labelValuesMap[egress], true
+		}
+		if len(labelValuesMap[ingress]) > 0 {
+			return labelValuesMap[ingress], true
+		}
+		return nil, false
+	}
+
+	labels := make([]string, 0)
+	if d.srcCtx != nil {
+		labels = append(labels, d.srcCtx.getValues(flow)...)
+	}
+	if d.dstCtx != nil {
+		labels = append(labels, d.dstCtx.getValues(flow)...)
+	}
+	return labels, true
+}
+
+func dnsPorts(flow *v1.Flow) (src, dst uint32) {
+	if udp := flow.GetL4().GetUDP(); udp != nil {
+		return udp.GetSourcePort(), udp.GetDestinationPort()
+	}
+	if tcp := flow.GetL4().GetTCP(); tcp != nil {
+		return tcp.GetSourcePort(), tcp.GetDestinationPort()
+	}
+	return 0, 0
+}
+
+func (d *DNSLatencyMetrics) Clean() {
+	if d.latency != nil {
+		exporter.UnregisterMetric(exporter.AdvancedRegistry, metricsinit.ToPrometheusType(d.latency))
+	}
+	if d.unanswered != nil {
+		exporter.UnregisterMetric(exporter.AdvancedRegistry, metricsinit.ToPrometheusType(d.unanswered))
+	}
+	if d.cache != nil {
+		d.cache.Stop()
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func dnsFlow(ts time.Time, qr string, src, dst *flow.Endpoint, srcIP, dstIP string, srcPort, dstPort, id uint32, query string) *flow.Flow {
+	f := &flow.Flow{
+		Time:        timestamppb.New(ts),
+		Verdict:     utils.Verdict_DNS,
+		IP:          &flow.IP{Source: srcIP, Destination: dstIP},
+		L4:          &flow.Layer4{Protocol: &flow.Layer4_UDP{UDP: &flow.UDP{SourcePort: srcPort, DestinationPort: dstPort}}},
+		Source:      src,
+		Destination: dst,
+	}
+	meta := &utils.RetinaMetadata{}
+	utils.AddDNSInfo(f, meta, qr, 0, query, []string{"A"}, 0, nil)
+	utils.AddDNSID(meta, id)
+	utils.AddRetinaMetadata(f, meta)
+	return f
+}
+
+func TestNewDNSLatencyMetrics(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	assert.Nil(t, NewDNSLatencyMetrics(nil, l, remoteContext))
+	assert.Nil(t, NewDNSLatencyMetrics(&api.MetricsContextOptions{MetricName: utils.DNSRequestCounterName}, l, remoteContext))
+	assert.NotNil(t, NewDNSLatencyMetrics(&api.MetricsContextOptions{MetricName: utils.DNSLatencyName}, l, remoteContext))
+	assert.NotNil(t, NewDNSLatencyMetrics(&api.MetricsContextOptions{MetricName: utils.DNSUnansweredQueryCounterName}, l, remoteContext))
+	assert.True(t, utils.IsAdvancedMetric(utils.DNSLatencyName))
+	assert.True(t, utils.IsAdvancedMetric(utils.DNSUnansweredQueryCounterName))
+}
+
+func TestDNSLatencyProcessFlow(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	client := &flow.Endpoint{Namespace: "ns1", PodName: "client"}
+	t0 := time.Unix(1700000000, 0)
+
+	exporter.AdvancedRegistry = prometheus.NewRegistry()
+	d := NewDNSLatencyMetrics(&api.MetricsContextOptions{
+		MetricName:   utils.DNSLatencyName,
+		SourceLabels: []string{"podname"},
+	}, l, remoteContext)
+	d.Init(utils.DNSLatencyName)
+	defer d.Clean()
+
+	// Query and response of transaction 0x1234.
+	d.ProcessFlow(dnsFlow(t0, "Q", client, nil, "10.0.0.1", "10.0.0.10", 40000, 53, 0x1234, "example.com."))
+	// Another transaction on the same 5-tuple is paired separately.
+	d.ProcessFlow(dnsFlow(t0, "Q", client, nil, "10.0.0.1", "10.0.0.10", 40000, 53, 0x5678, "example.com."))
+	// The response of another query name or 5-tuple does not match.
+	d.ProcessFlow(dnsFlow(t0.Add(time.Millisecond), "R", nil, client, "10.0.0.10", "10.0.0.1", 53, 40000, 0x1234, "other.com."))
+	d.ProcessFlow(dnsFlow(t0.Add(time.Millisecond), "R", nil, client, "10.0.0.10", "10.0.0.1", 53, 40001, 0x1234, "example.com."))
+	d.ProcessFlow(dnsFlow(t0.Add(20*time.Millisecond), "R", nil, client, "10.0.0.10", "10.0.0.1", 53, 40000, 0x1234, "example.com."))
+
+	m := &dto.Metric{}
+	require.NoError(t, d.latency.WithLabelValues("client").(prometheus.Metric).Write(m))
+	assert.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+	assert.InDelta(t, 0.02, m.GetHistogram().GetSampleSum(), 0.0001)
+	// Transaction 0x5678 is still waiting for its response.
+	assert.Equal(t, 1, d.cache.Len())
+}
+
+func TestDNSUnansweredQueries(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	client := &flow.Endpoint{Namespace: "ns1", PodName: "client"}
+	server := &flow.Endpoint{Namespace: "kube-system", PodName: "coredns"}
+	t0 := time.Unix(1700000000, 0)
+
+	exporter.AdvancedRegistry = prometheus.NewRegistry()
+	d := NewDNSLatencyMetrics(&api.MetricsContextOptions{
+		MetricName:   utils.DNSUnansweredQueryCounterName,
+		SourceLabels: []string{"namespace", "podname"},
+	}, l, localContext)
+	d.timeout = 100 * time.Millisecond
+	d.Init(utils.DNSUnansweredQueryCounterName)
+	defer d.Clean()
+
+	// Answered query.
+	d.ProcessFlow(dnsFlow(t0, "Q", client, server, "10.0.0.1", "10.0.0.10", 40000, 53, 1, "example.com."))
+	d.ProcessFlow(dnsFlow(t0, "R", server, client, "10.0.0.10", "10.0.0.1", 53, 40000, 1, "example.com."))
+	// Unanswered query.
+	d.ProcessFlow(dnsFlow(t0, "Q", client, server, "10.0.0.1", "10.0.0.10", 40000, 53, 2, "example.com."))
+
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(d.unanswered.WithLabelValues("ns1", "client")) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Nil(t, d.latency)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	metricsinit "github.com/microsoft/retina/pkg/metrics"
+	"github.com/microsoft/retina/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// Metric descriptions
+	HTTPRequestCountDesc = "Total number of HTTP requests answered, by method and status code"
+	HTTPLatencyDesc      = "Latency of HTTP requests in ms, from the request to its response"
+)
+
+var (
+	HTTPRequestCountName = fmt.Sprintf("adv_%s", utils.HTTPRequestCounterName)
+	HTTPLatencyName      = fmt.Sprintf("adv_%s", utils.HTTPLatencyName)
+
+	// httpLatencyBuckets in ms, from 1ms to 10s.
+	httpLatencyBuckets = []float64{1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000} //nolint:gomnd // buckets
+)
+
+// HTTPMetrics counts the HTTP responses paired with their request by packetparser,
+// and observes their latency.
+type HTTPMetrics struct {
+	baseMetricObject
+	requests   metricsinit.CounterVec
+	latency    metricsinit.HistogramVec
+	metricName string
+}
+
+func NewHTTPMetrics(ctxOptions *api.MetricsContextOptions, fl *log.ZapLogger, isLocalContext enrichmentContext) *HTTPMetrics {
+	if ctxOptions == nil || !strings.Contains(strings.ToLower(ctxOptions.MetricName), "http") {
+		return nil
+	}
+
+	fl = fl.Named("http-metricsmodule")
+	fl.Info("Creating HTTP metrics", zap.Any("options", ctxOptions))
+	return &HTTPMetrics{
+		baseMetricObject: newBaseMetricsObject(ctxOptions, fl, isLocalContext),
+	}
+}
+
+func (h *HTTPMetrics) Init(metricName string) {
+	h.metricName = metricName
+	switch metricName {
+	case utils.HTTPRequestCounterName:
+		h.requests = exporter.CreatePrometheusCounterVecForMetric(
+			exporter.AdvancedRegistry,
+			HTTPRequestCountName,
+			HTTPRequestCountDesc,
+			h.getLabels()...,
+		)
+	case utils.HTTPLatencyName:
+		h.latency = exporter.CreatePrometheusHistogramVecForMetric(
+			exporter.AdvancedRegistry,
+			HTTPLatencyName,
+			HTTPLatencyDesc,
+			httpLatencyBuckets,
+			h.getLabels()...,
+		)
+	default:
+		h.l.Error("Invalid HTTP metric name", zap.String("metricName", metricName))
+	}
+}
+
+func (h *HTTPMetrics) getLabels() []string {
+	labels := append([]string{}, utils.HTTPLabels...)
+	if h.srcCtx != nil {
+		labels = append(labels, h.srcCtx.getLabels()...)
+	}
+	if h.dstCtx != nil {
+		labels = append(labels, h.dstCtx.getLabels()...)
+	}
+	return labels
+}
+
+// ProcessFlow updates the metrics for the HTTP responses.
+// The context labels are the ones of the response flow, from the server to the client.
+func (h *HTTPMetrics) ProcessFlow(flow *v1.Flow) {
+	if flow == nil || flow.GetL7().GetType() != v1.L7FlowType_RESPONSE {
+		return
+	}
+	httpFlow := flow.GetL7().GetHttp()
+	if httpFlow == nil {
+		return
+	}
+
+	labels := []string{httpFlow.GetMethod(), strconv.FormatUint(uint64(httpFlow.GetCode()), 10)}
+	if h.isLocalContext() {
+		ctxLabels, ok := h.localCtxValues(flow)
+		if !ok {
+			return
+		}
+		labels = append(labels, ctxLabels...)
+	} else {
+		if h.srcCtx != nil {
+			labels = append(labels, h.srcCtx.getValues(flow)...)
+		}
+		if h.dstCtx != nil {
+			labels = append(labels, h.dstCtx.getValues(flow)...)
+		}
+	}
+
+	switch {
+	case h.requests != nil:
+		h.requests.WithLabelValues(labels...).Inc()
+	case h.latency != nil:
+		h.latency.WithLabelValues(labels...).Observe(float64(flow.GetL7().GetLatencyNs()) / float64(time.Millisecond))
+	}
+	h.l.Debug("Update http metric", zap.String("metric", h.metricName), zap.Any("labels", labels))
+}
+
+// localCtxValues returns the label values of the local pod of the flow, false if there is none.
+func (h *HTTPMetrics) localCtxValues(flow *v1.Flow) ([]string, bool) {
+	if h.srcCtx == nil {
+		return []string{}, true
+	}
+	labelValuesMap := h.srcCtx.getLocalCtxValues(flow)
+	if labelValuesMap == nil {
+		return nil, false
+	}
+	switch {
+	case len(labelValuesMap[ingress]) > 0 && len(labelValuesMap[egress]) > 0:
+		if flow.TrafficDirection == v1.TrafficDirection_INGRESS {
+			return labelValuesMap[ingress], true
+		}
+		return labelValuesMap[egress], true
+	case len(labelValuesMap[ingress]) > 0:
+		return labelValuesMap[ingress], true
+	case len(labelValuesMap[egress]) > 0:
+		return labelValuesMap[egress], true
+	default:
+		return nil, false
+	}
+}
+
+func (h *HTTPMetrics) Clean() {
+	if h.requests != nil {
+		exporter.UnregisterMetric(exporter.AdvancedRegistry, metricsinit.ToPrometheusType(h.requests))
+	}
+	if h.latency != nil {
+		exporter.UnregisterMetric(exporter.AdvancedRegistry, metricsinit.ToPrometheusType(h.latency))
+	}
+}
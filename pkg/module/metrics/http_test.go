@@ -0,0 +1,105 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func httpFlow(l7Type flow.L7FlowType, src, dst *flow.Endpoint, method string, code uint32, latency time.Duration) *flow.Flow {
+	f := &flow.Flow{
+		IP:          &flow.IP{Source: "10.0.0.2", Destination: "10.0.0.1"},
+		Source:      src,
+		Destination: dst,
+	}
+	utils.AddHTTPInfo(f, l7Type, method, "/users/{id}", "HTTP/1.1", code, uint64(latency.Nanoseconds()))
+	return f
+}
+
+func TestNewHTTPMetrics(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	assert.Nil(t, NewHTTPMetrics(nil, l, remoteContext))
+	assert.Nil(t, NewHTTPMetrics(&api.MetricsContextOptions{MetricName: utils.DNSRequestCounterName}, l, remoteContext))
+	assert.True(t, utils.IsAdvancedMetric(utils.HTTPRequestCounterName))
+	assert.True(t, utils.IsAdvancedMetric(utils.HTTPLatencyName))
+
+	h := NewHTTPMetrics(&api.MetricsContextOptions{
+		MetricName:        utils.HTTPRequestCounterName,
+		SourceLabels:      []string{"podname"},
+		DestinationLabels: []string{"namespace"},
+	}, l, remoteContext)
+	require.NotNil(t, h)
+	assert.Equal(t, []string{"method", "status_code", "source_podname", "destination_namespace"}, h.getLabels())
+	// The shared label names are not modified.
+	assert.Equal(t, []string{"method", "status_code"}, utils.HTTPLabels)
+}
+
+func TestHTTPMetricsProcessFlow(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	client := &flow.Endpoint{Namespace: "ns1", PodName: "client"}
+	server := &flow.Endpoint{Namespace: "ns2", PodName: "server"}
+
+	t.Run("requests in remote context", func(t *testing.T) {
+		exporter.AdvancedRegistry = prometheus.NewRegistry()
+		h := NewHTTPMetrics(&api.MetricsContextOptions{
+			MetricName:        utils.HTTPRequestCounterName,
+			SourceLabels:      []string{"podname"},
+			DestinationLabels: []string{"podname"},
+		}, l, remoteContext)
+		h.Init(utils.HTTPRequestCounterName)
+		defer h.Clean()
+
+		// Requests and flows without HTTP are not counted.
+		h.ProcessFlow(nil)
+		h.ProcessFlow(httpFlow(flow.L7FlowType_REQUEST, client, server, "GET", 0, 0))
+		h.ProcessFlow(&flow.Flow{L7: &flow.Layer7{Type: flow.L7FlowType_RESPONSE}})
+		assert.Equal(t, 0, testutil.CollectAndCount(exporter.AdvancedRegistry))
+
+		h.ProcessFlow(httpFlow(flow.L7FlowType_RESPONSE, server, client, "GET", 200, time.Millisecond))
+		h.ProcessFlow(httpFlow(flow.L7FlowType_RESPONSE, server, client, "GET", 200, time.Millisecond))
+		h.ProcessFlow(httpFlow(flow.L7FlowType_RESPONSE, server, client, "POST", 500, time.Millisecond))
+
+		assert.InDelta(t, 2, testutil.ToFloat64(h.requests.WithLabelValues("GET", "200", "server", "client")), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(h.requests.WithLabelValues("POST", "500", "server", "client")), 0)
+	})
+
+	t.Run("latency in local context", func(t *testing.T) {
+		exporter.AdvancedRegistry = prometheus.NewRegistry()
+		h := NewHTTPMetrics(&api.MetricsContextOptions{
+			MetricName:   utils.HTTPLatencyName,
+			SourceLabels: []string{"namespace", "podname"},
+		}, l, localContext)
+		h.Init(utils.HTTPLatencyName)
+		defer h.Clean()
+
+		// The response of a remote server is accounted to the local client.
+		h.ProcessFlow(httpFlow(flow.L7FlowType_RESPONSE, nil, client, "GET", 200, 30*time.Millisecond))
+		// Flows without a local pod are ignored.
+		h.ProcessFlow(httpFlow(flow.L7FlowType_RESPONSE, nil, nil, "GET", 200, 30*time.Millisecond))
+
+		m := &dto.Metric{}
+		require.NoError(t, h.latency.WithLabelValues("GET", "200", "ns1", "client").(prometheus.Metric).Write(m))
+		assert.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+		assert.InDelta(t, 30, m.GetHistogram().GetSampleSum(), 0.001)
+		assert.Equal(t, 1, testutil.CollectAndCount(exporter.AdvancedRegistry))
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	metricsinit "github.com/microsoft/retina/pkg/metrics"
+	"github.com/microsoft/retina/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// Metric descriptions
+	ICMPErrorCountDesc = "Total number of ICMP destination unreachable, fragmentation needed and time exceeded messages"
+
+	// Values of the type label.
+	icmpDestinationUnreachable = "destination_unreachable"
+	icmpFragmentationNeeded    = "fragmentation_needed"
+	icmpTimeExceeded           = "time_exceeded"
+
+	// ICMP types and codes. Ref: https://www.iana.org/assignments/icmp-parameters/icmp-parameters.xhtml .
+	icmpv4TypeDestinationUnreachable = 3
+	icmpv4TypeTimeExceeded           = 11
+	icmpv4CodeFragmentationNeeded    = 4
+)
+
+var ICMPErrorCountName = fmt.Sprintf("adv_%s", utils.ICMPErrorCounterName)
+
+// ICMPMetrics counts the ICMP error messages used to find unreachable destinations,
+// MTU black holes and routing loops.
+type ICMPMetrics struct {
+	baseMetricObject
+	icmpErrorMetrics metricsinit.GaugeVec
+}
+
+func NewICMPMetrics(ctxOptions *api.MetricsContextOptions, fl *log.ZapLogger, isLocalContext enrichmentContext) *ICMPMetrics {
+	if ctxOptions == nil || !strings.Contains(strings.ToLower(ctxOptions.MetricName), "icmp") {
+		return nil
+	}
+
+	fl = fl.Named("icmp-metricsmodule")
+	fl.Info("Creating ICMP error count metrics", zap.Any("options", ctxOptions))
+	return &ICMPMetrics{
+		baseMetricObject: newBaseMetricsObject(ctxOptions, fl, isLocalContext),
+	}
+}
+
+func (i *ICMPMetrics) Init(metricName string) {
+	// only 1 metric. No need to check metric name which is already validated.
+	i.icmpErrorMetrics = exporter.CreatePrometheusGaugeVecForMetric(
+		exporter.AdvancedRegistry,
+		ICMPErrorCountName,
+		ICMPErrorCountDesc,
+		i.getLabels()...,
+	)
+}
+
+func (i *ICMPMetrics) getLabels() []string {
+	labels := append([]string{}, utils.ICMPLabels...)
+	if i.srcCtx != nil {
+		labels = append(labels, i.srcCtx.getLabels()...)
+	}
+	if i.dstCtx != nil {
+		labels = append(labels, i.dstCtx.getLabels()...)
+	}
+	return labels
+}
+
+// ProcessFlow counts the ICMP error messages.
+// The context labels are the ones of the message, from the host or router reporting the error to the sender of the
+// packet in error.
+func (i *ICMPMetrics) ProcessFlow(flow *v1.Flow) {
+	if flow == nil || flow.Verdict != v1.Verdict_FORWARDED {
+		return
+	}
+
+	icmpType, code, ok := icmpError(flow)
+	if !ok {
+		return
+	}
+
+	if i.isLocalContext() {
+		// when localcontext is enabled, we do not need the context options for both src and dst
+		// metrics aggregation will be on a single pod basis and not the src/dst pod combination basis.
+		i.processLocalCtxFlow(flow, icmpType, code)
+		return
+	}
+
+	labels := []string{icmpType, code}
+	if i.srcCtx != nil {
+		labels = append(labels, i.srcCtx.getValues(flow)...)
+	}
+	if i.dstCtx != nil {
+		labels = append(labels, i.dstCtx.getValues(flow)...)
+	}
+	i.icmpErrorMetrics.WithLabelValues(labels...).Inc()
+	i.l.Debug("ICMP error metric", zap.Strings("labels", labels))
+}
+
+func (i *ICMPMetrics) processLocalCtxFlow(flow *v1.Flow, icmpType, code string) {
+	labelValuesMap := i.srcCtx.getLocalCtxValues(flow)
+	if labelValuesMap == nil {
+		return
+	}
+
+	if len(labelValuesMap[ingress]) > 0 {
+		labels := append([]string{icmpType, code}, labelValuesMap[ingress]...)
+		i.icmpErrorMetrics.WithLabelValues(labels...).Inc()
+		i.l.Debug("ICMP error metric in INGRESS in local ctx", zap.Strings("labels", labels))
+	}
+
+	if len(labelValuesMap[egress]) > 0 {
+		labels := append([]string{icmpType, code}, labelValuesMap[egress]...)
+		i.icmpErrorMetrics.WithLabelValues(labels...).Inc()
+		i.l.Debug("ICMP error metric in EGRESS in local ctx", zap.Strings("labels", labels))
+	}
+}
+
+// icmpError returns the type label and the code of the ICMP error message of the flow,
+// false if the flow is not one of the counted error messages.
+func icmpError(flow *v1.Flow) (icmpType, code string, ok bool) {
+	icmp := flow.GetL4().GetICMPv4()
+	if icmp == nil {
+		return "", "", false
+	}
+	switch {
+	case icmp.GetType() == icmpv4TypeDestinationUnreachable && icmp.GetCode() == icmpv4CodeFragmentationNeeded:
+		icmpType = icmpFragmentationNeeded
+	case icmp.GetType() == icmpv4TypeDestinationUnreachable:
+		icmpType = icmpDestinationUnreachable
+	case icmp.GetType() == icmpv4TypeTimeExceeded:
+		icmpType = icmpTimeExceeded
+	default:
+		return "", "", false
+	}
+	return icmpType, strconv.FormatUint(uint64(icmp.GetCode()), 10), true
+}
+
+func (i *ICMPMetrics) Clean() {
+	exporter.UnregisterMetric(exporter.AdvancedRegistry, metricsinit.ToPrometheusType(i.icmpErrorMetrics))
+}
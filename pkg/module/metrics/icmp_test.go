@@ -0,0 +1,101 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func icmpFlow(proto uint8, src, dst *flow.Endpoint, icmpType, code uint32) *flow.Flow {
+	l := log.Logger().Named("test")
+	f := utils.ToFlow(l, 0, []byte{10, 0, 0, 1}, []byte{10, 0, 0, 2}, 0, 0, proto, 2, flow.Verdict_FORWARDED)
+	utils.AddICMP(f, icmpType, code)
+	f.Source = src
+	f.Destination = dst
+	return f
+}
+
+func TestNewICMPMetrics(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	assert.Nil(t, NewICMPMetrics(nil, l, remoteContext))
+	assert.Nil(t, NewICMPMetrics(&api.MetricsContextOptions{MetricName: utils.TCPFlagGauge}, l, remoteContext))
+	assert.True(t, utils.IsAdvancedMetric(utils.ICMPErrorCounterName))
+
+	i := NewICMPMetrics(&api.MetricsContextOptions{
+		MetricName:        utils.ICMPErrorCounterName,
+		SourceLabels:      []string{"ip"},
+		DestinationLabels: []string{"podname"},
+	}, l, remoteContext)
+	require.NotNil(t, i)
+	assert.Equal(t, []string{"type", "code", "source_ip", "destination_podname"}, i.getLabels())
+}
+
+func TestICMPMetricsProcessFlow(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	router := &flow.Endpoint{}
+	pod := &flow.Endpoint{Namespace: "ns1", PodName: "pod1"}
+
+	t.Run("remote context", func(t *testing.T) {
+		exporter.AdvancedRegistry = prometheus.NewRegistry()
+		i := NewICMPMetrics(&api.MetricsContextOptions{
+			MetricName:        utils.ICMPErrorCounterName,
+			DestinationLabels: []string{"podname"},
+		}, l, remoteContext)
+		i.Init(utils.ICMPErrorCounterName)
+		defer i.Clean()
+
+		// Echo messages, other protocols and dropped messages are not counted.
+		i.ProcessFlow(nil)
+		i.ProcessFlow(icmpFlow(1, router, pod, 8, 0))
+		i.ProcessFlow(icmpFlow(6, router, pod, 0, 0))
+		dropped := icmpFlow(1, router, pod, 3, 1)
+		dropped.Verdict = flow.Verdict_DROPPED
+		i.ProcessFlow(dropped)
+		assert.Equal(t, 0, testutil.CollectAndCount(exporter.AdvancedRegistry))
+
+		i.ProcessFlow(icmpFlow(1, router, pod, 3, 3))
+		i.ProcessFlow(icmpFlow(1, router, pod, 3, 4))
+		i.ProcessFlow(icmpFlow(1, router, pod, 11, 0))
+		i.ProcessFlow(icmpFlow(1, router, pod, 11, 1))
+
+		assert.InDelta(t, 1, testutil.ToFloat64(i.icmpErrorMetrics.WithLabelValues("destination_unreachable", "3", "pod1")), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(i.icmpErrorMetrics.WithLabelValues("fragmentation_needed", "4", "pod1")), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(i.icmpErrorMetrics.WithLabelValues("time_exceeded", "0", "pod1")), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(i.icmpErrorMetrics.WithLabelValues("time_exceeded", "1", "pod1")), 0)
+	})
+
+	t.Run("local context", func(t *testing.T) {
+		exporter.AdvancedRegistry = prometheus.NewRegistry()
+		i := NewICMPMetrics(&api.MetricsContextOptions{
+			MetricName:   utils.ICMPErrorCounterName,
+			SourceLabels: []string{"namespace", "podname"},
+		}, l, localContext)
+		i.Init(utils.ICMPErrorCounterName)
+		defer i.Clean()
+
+		// The message is accounted to the local pod receiving it.
+		i.ProcessFlow(icmpFlow(1, nil, pod, 3, 4))
+		// Flows without a local pod are ignored.
+		i.ProcessFlow(icmpFlow(1, nil, nil, 3, 4))
+
+		assert.InDelta(t, 1, testutil.ToFloat64(i.icmpErrorMetrics.WithLabelValues("fragmentation_needed", "4", "ns1", "pod1")), 0)
+		assert.Equal(t, 1, testutil.CollectAndCount(exporter.AdvancedRegistry))
+	})
+}
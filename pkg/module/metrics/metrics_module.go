@@ -32,6 +32,8 @@ const (
 	nodeApiserver string = "node_apiserver"
 	dns           string = "dns"
 	pktmon        string = "pktmon"
+	httpMetric    string = "http"
+	icmpMetric    string = "icmp"
 
 	metricModuleReq filtermanager.Requestor = "metricModule"
 	interval        time.Duration           = 1 * time.Second
@@ -139,7 +141,11 @@ func InitModule(ctx context.Context,
 	return m
 }
 
-func (m *Module) Reconcile(spec *api.MetricsSpec) error {
+// Reconcile reconciles the metric module with the spec merged from specs, e.g. the specs of all the accepted
+// MetricsConfigurations ordered by name. Check validations.MergeMetricsSpecs for the merge semantics.
+func (m *Module) Reconcile(specs ...*api.MetricsSpec) error {
+	spec := validations.MergeMetricsSpecs(specs)
+
 	// If the new spec has not changed, then do nothing.
 	if m.currentSpec != nil && m.currentSpec.Equals(spec) {
 		m.l.Debug("Spec has not changed. Not reconciling.")
@@ -241,6 +247,10 @@ func (m *Module) updateMetricsContexts(spec *api.MetricsSpec) {
 			if tr != nil {
 				m.registry[ctxOption.MetricName] = tr
 			}
+			rtt := NewTCPRTTMetrics(&ctxOption, m.l, ctxType)
+			if rtt != nil {
+				m.registry[ctxOption.MetricName] = rtt
+			}
 		case strings.Contains(ctxOption.MetricName, nodeApiserver):
 			// Uses the pattern we will follow in future where each base metric has one instance.
 			// Example - tcp, latency, dns, etc.
@@ -248,11 +258,26 @@ func (m *Module) updateMetricsContexts(spec *api.MetricsSpec) {
 			if lm != nil {
 				m.registry[nodeApiserver] = lm
 			}
+		case isDNSLatencyMetric(ctxOption.MetricName):
+			dl := NewDNSLatencyMetrics(&ctxOption, m.l, ctxType)
+			if dl != nil {
+				m.registry[ctxOption.MetricName] = dl
+			}
 		case strings.Contains(ctxOption.MetricName, dns) || strings.Contains(ctxOption.MetricName, pktmon):
 			dm := NewDNSMetrics(&ctxOption, m.l, ctxType)
 			if dm != nil {
 				m.registry[ctxOption.MetricName] = dm
 			}
+		case strings.Contains(ctxOption.MetricName, httpMetric):
+			hm := NewHTTPMetrics(&ctxOption, m.l, ctxType)
+			if hm != nil {
+				m.registry[ctxOption.MetricName] = hm
+			}
+		case strings.Contains(ctxOption.MetricName, icmpMetric):
+			im := NewICMPMetrics(&ctxOption, m.l, ctxType)
+			if im != nil {
+				m.registry[ctxOption.MetricName] = im
+			}
 		default:
 			m.l.Error("Invalid metric name", zap.String("metricName", ctxOption.MetricName))
 		}
@@ -41,17 +41,21 @@ func (m *MockIModule) EXPECT() *MockIModuleMockRecorder {
 }
 
 // Reconcile mocks base method.
-func (m *MockIModule) Reconcile(spec *v1alpha1.MetricsSpec) error {
+func (m *MockIModule) Reconcile(specs ...*v1alpha1.MetricsSpec) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Reconcile", spec)
+	varargs := []any{}
+	for _, a := range specs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Reconcile", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Reconcile indicates an expected call of Reconcile.
-func (mr *MockIModuleMockRecorder) Reconcile(spec any) *gomock.Call {
+func (mr *MockIModuleMockRecorder) Reconcile(specs ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockIModule)(nil).Reconcile), spec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockIModule)(nil).Reconcile), specs...)
 }
 
 // MockAdvMetricsInterface is a mock of AdvMetricsInterface interface.
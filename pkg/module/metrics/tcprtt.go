@@ -0,0 +1,175 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	ttlcache "github.com/jellydator/ttlcache/v3"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	metricsinit "github.com/microsoft/retina/pkg/metrics"
+	"github.com/microsoft/retina/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// Metric names
+	TCPRTTName = "adv_tcp_rtt"
+
+	// Metric descriptions
+	TCPRTTDesc = "Round trip time of TCP connections of pods in ms, measured from the TCP timestamps"
+
+	// tcpRTTTTL is how long a packet waits for the packet echoing its timestamp.
+	tcpRTTTTL = 2 * time.Second
+	// tcpRTTCacheCapacity bounds the number of packets waiting for a reply on the node.
+	tcpRTTCacheCapacity uint64 = 50000
+)
+
+// tcpRTTBuckets in ms, from 0.05ms for pods on the same node to ~1.6s across regions.
+var tcpRTTBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 1600} //nolint:gomnd // buckets
+
+// rttVal is the packet waiting for a reply.
+type rttVal struct {
+	t time.Time
+	// labels of the flow that sent the packet.
+	labels []string
+}
+
+// TCPRTTMetrics generalizes the TSval/TSecr matching of the apiserver latency
+// to the TCP connections of any pod.
+type TCPRTTMetrics struct {
+	baseMetricObject
+	tcpRTT metricsinit.HistogramVec
+	cache  *ttlcache.Cache[key, *rttVal]
+}
+
+func NewTCPRTTMetrics(ctxOptions *api.MetricsContextOptions, fl *log.ZapLogger, isLocalContext enrichmentContext) *TCPRTTMetrics {
+	if ctxOptions == nil || !strings.Contains(strings.ToLower(ctxOptions.MetricName), "rtt") {
+		return nil
+	}
+
+	fl = fl.Named("tcprtt-metricsmodule")
+	fl.Info("Creating TCP RTT metrics", zap.Any("options", ctxOptions))
+	return &TCPRTTMetrics{
+		baseMetricObject: newBaseMetricsObject(ctxOptions, fl, isLocalContext),
+	}
+}
+
+func (t *TCPRTTMetrics) Init(metricName string) {
+	// only 1 metric. No need to check metric name which is already validated.
+	t.tcpRTT = exporter.CreatePrometheusHistogramVecForMetric(
+		exporter.AdvancedRegistry,
+		TCPRTTName,
+		TCPRTTDesc,
+		tcpRTTBuckets,
+		t.getLabels()...,
+	)
+
+	t.cache = ttlcache.New(
+		ttlcache.WithTTL[key, *rttVal](tcpRTTTTL),
+		ttlcache.WithCapacity[key, *rttVal](tcpRTTCacheCapacity),
+	)
+	go t.cache.Start()
+}
+
+func (t *TCPRTTMetrics) getLabels() []string {
+	labels := make([]string, 0)
+	if t.srcCtx != nil {
+		labels = append(labels, t.srcCtx.getLabels()...)
+	}
+	if t.dstCtx != nil {
+		labels = append(labels, t.dstCtx.getLabels()...)
+	}
+	return labels
+}
+
+// ProcessFlow stores the TSval of the packets sent by a pod, and observes the RTT
+// when the packet echoing it in its TSecr comes back.
+// The metric is labeled after the flow that sent the packet.
+func (t *TCPRTTMetrics) ProcessFlow(f *flow.Flow) {
+	if f == nil || f.GetL4().GetTCP() == nil || f.GetIP() == nil || f.GetTime() == nil {
+		return
+	}
+	id := utils.GetTCPID(f)
+	if id == 0 {
+		return
+	}
+
+	tcp := f.GetL4().GetTCP()
+	switch f.GetTraceObservationPoint() {
+	case flow.TraceObservationPoint_FROM_ENDPOINT, flow.TraceObservationPoint_TO_NETWORK:
+		k := key{
+			srcIP: f.GetIP().GetSource(),
+			dstIP: f.GetIP().GetDestination(),
+			srcP:  tcp.GetSourcePort(),
+			dstP:  tcp.GetDestinationPort(),
+			id:    id,
+		}
+		// The packet is seen at both the endpoint and the network, as well as
+		// its duplicates with the same TSval. Store only the first one.
+		if t.cache.Has(k) {
+			return
+		}
+		labels, ok := t.values(f)
+		if !ok {
+			return
+		}
+		t.cache.Set(k, &rttVal{t: f.GetTime().AsTime(), labels: labels}, ttlcache.DefaultTTL)
+	case flow.TraceObservationPoint_FROM_NETWORK, flow.TraceObservationPoint_TO_ENDPOINT:
+		k := key{
+			srcIP: f.GetIP().GetDestination(),
+			dstIP: f.GetIP().GetSource(),
+			srcP:  tcp.GetDestinationPort(),
+			dstP:  tcp.GetSourcePort(),
+			id:    id,
+		}
+		item, found := t.cache.GetAndDelete(k)
+		if !found {
+			return
+		}
+		rtt := f.GetTime().AsTime().Sub(item.Value().t)
+		if rtt < 0 {
+			return
+		}
+		t.tcpRTT.WithLabelValues(item.Value().labels...).Observe(float64(rtt) / float64(time.Millisecond))
+	}
+}
+
+// values returns the label values of the sending flow,
+// false if the flow is not sent by a pod or to a pod or service.
+func (t *TCPRTTMetrics) values(f *flow.Flow) ([]string, bool) {
+	if t.isLocalContext() {
+		// In local context the RTT is accounted to the local pod sending the packet.
+		if f.GetSource().GetPodName() == "" || isAPIServerPod(f.GetSource()) {
+			return nil, false
+		}
+		if t.srcCtx == nil {
+			return []string{}, true
+		}
+		return t.srcCtx.getLocalCtxValues(f)[egress], true
+	}
+
+	if f.GetSource().GetPodName() == "" && f.GetDestination().GetPodName() == "" && f.GetDestinationService() == nil {
+		return nil, false
+	}
+	labels := make([]string, 0)
+	if t.srcCtx != nil {
+		labels = append(labels, t.srcCtx.getValues(f)...)
+	}
+	if t.dstCtx != nil {
+		labels = append(labels, t.dstCtx.getValues(f)...)
+	}
+	return labels, true
+}
+
+func (t *TCPRTTMetrics) Clean() {
+	exporter.UnregisterMetric(exporter.AdvancedRegistry, metricsinit.ToPrometheusType(t.tcpRTT))
+	if t.cache != nil {
+		t.cache.Stop()
+	}
+}
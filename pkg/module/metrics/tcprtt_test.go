@@ -0,0 +1,138 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/exporter"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func rttFlow(ts time.Time, src, dst *flow.Endpoint, srcIP, dstIP string, srcPort, dstPort uint32, obs flow.TraceObservationPoint, id uint64) *flow.Flow {
+	f := &flow.Flow{
+		Time:                  timestamppb.New(ts),
+		IP:                    &flow.IP{Source: srcIP, Destination: dstIP},
+		L4:                    &flow.Layer4{Protocol: &flow.Layer4_TCP{TCP: &flow.TCP{SourcePort: srcPort, DestinationPort: dstPort}}},
+		TraceObservationPoint: obs,
+		Source:                src,
+		Destination:           dst,
+	}
+	meta := &utils.RetinaMetadata{}
+	utils.AddTCPID(meta, id)
+	utils.AddRetinaMetadata(f, meta)
+	return f
+}
+
+// rttSamples returns the number of samples and their sum for the label values.
+func rttSamples(t *testing.T, h *TCPRTTMetrics, labels ...string) (uint64, float64) {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, h.tcpRTT.WithLabelValues(labels...).(prometheus.Metric).Write(m))
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
+
+func TestNewTCPRTTMetrics(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	assert.Nil(t, NewTCPRTTMetrics(nil, l, remoteContext))
+	assert.Nil(t, NewTCPRTTMetrics(&api.MetricsContextOptions{MetricName: utils.TCPRetransCount}, l, remoteContext))
+	assert.Nil(t, NewTCPMetrics(&api.MetricsContextOptions{MetricName: utils.TCPRTTName}, l, remoteContext))
+	assert.Nil(t, NewTCPRetransMetrics(&api.MetricsContextOptions{MetricName: utils.TCPRTTName}, l, remoteContext))
+
+	exporter.AdvancedRegistry = prometheus.NewRegistry()
+	rtt := NewTCPRTTMetrics(&api.MetricsContextOptions{
+		MetricName:        utils.TCPRTTName,
+		SourceLabels:      []string{"namespace", "podname"},
+		DestinationLabels: []string{"ip"},
+	}, l, remoteContext)
+	require.NotNil(t, rtt)
+	assert.Equal(t, []string{"source_namespace", "source_podname", "destination_ip"}, rtt.getLabels())
+
+	rtt.Init(utils.TCPRTTName)
+	defer rtt.Clean()
+	require.NotNil(t, rtt.cache)
+	require.NotNil(t, rtt.tcpRTT)
+}
+
+func TestTCPRTTProcessFlow(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+	l := log.Logger().Named("test")
+
+	podA := &flow.Endpoint{Namespace: "ns1", PodName: "a"}
+	podB := &flow.Endpoint{Namespace: "ns2", PodName: "b"}
+	t0 := time.Unix(1700000000, 0)
+
+	t.Run("pod to pod on the same node", func(t *testing.T) {
+		exporter.AdvancedRegistry = prometheus.NewRegistry()
+		rtt := NewTCPRTTMetrics(&api.MetricsContextOptions{
+			MetricName:        utils.TCPRTTName,
+			SourceLabels:      []string{"podname"},
+			DestinationLabels: []string{"podname"},
+		}, l, remoteContext)
+		rtt.Init(utils.TCPRTTName)
+		defer rtt.Clean()
+
+		// a -> b leaves a with TSval 100, and arrives at b.
+		rtt.ProcessFlow(rttFlow(t0, podA, podB, "10.0.0.1", "10.0.0.2", 40000, 80, flow.TraceObservationPoint_FROM_ENDPOINT, 100))
+		// A duplicate with the same TSval does not reset the send time.
+		rtt.ProcessFlow(rttFlow(t0.Add(time.Millisecond), podA, podB, "10.0.0.1", "10.0.0.2", 40000, 80, flow.TraceObservationPoint_FROM_ENDPOINT, 100))
+		// b -> a echoes TSval 100 in its TSecr when it arrives at a.
+		rtt.ProcessFlow(rttFlow(t0.Add(3*time.Millisecond), podB, podA, "10.0.0.2", "10.0.0.1", 80, 40000, flow.TraceObservationPoint_TO_ENDPOINT, 100))
+		// A second echo of the same TSval is not observed again.
+		rtt.ProcessFlow(rttFlow(t0.Add(4*time.Millisecond), podB, podA, "10.0.0.2", "10.0.0.1", 80, 40000, flow.TraceObservationPoint_TO_ENDPOINT, 100))
+
+		count, sum := rttSamples(t, rtt, "a", "b")
+		assert.Equal(t, uint64(1), count)
+		assert.InDelta(t, 3, sum, 0.001)
+		assert.Equal(t, 0, rtt.cache.Len())
+	})
+
+	t.Run("pod to remote pod", func(t *testing.T) {
+		exporter.AdvancedRegistry = prometheus.NewRegistry()
+		rtt := NewTCPRTTMetrics(&api.MetricsContextOptions{
+			MetricName:   utils.TCPRTTName,
+			SourceLabels: []string{"namespace", "podname"},
+		}, l, localContext)
+		rtt.Init(utils.TCPRTTName)
+		defer rtt.Clean()
+
+		rtt.ProcessFlow(rttFlow(t0, podA, podB, "10.0.0.1", "10.1.0.2", 40000, 80, flow.TraceObservationPoint_TO_NETWORK, 200))
+		rtt.ProcessFlow(rttFlow(t0.Add(10*time.Millisecond), podB, podA, "10.1.0.2", "10.0.0.1", 80, 40000, flow.TraceObservationPoint_FROM_NETWORK, 200))
+
+		count, sum := rttSamples(t, rtt, "ns1", "a")
+		assert.Equal(t, uint64(1), count)
+		assert.InDelta(t, 10, sum, 0.001)
+	})
+
+	t.Run("ignored flows", func(t *testing.T) {
+		exporter.AdvancedRegistry = prometheus.NewRegistry()
+		rtt := NewTCPRTTMetrics(&api.MetricsContextOptions{MetricName: utils.TCPRTTName}, l, remoteContext)
+		rtt.Init(utils.TCPRTTName)
+		defer rtt.Clean()
+
+		// Nodes without pods, no TCP ID and nil flows are ignored.
+		rtt.ProcessFlow(nil)
+		rtt.ProcessFlow(rttFlow(t0, nil, nil, "10.0.0.1", "10.1.0.2", 40000, 80, flow.TraceObservationPoint_TO_NETWORK, 300))
+		rtt.ProcessFlow(rttFlow(t0, podA, podB, "10.0.0.1", "10.1.0.2", 40000, 80, flow.TraceObservationPoint_TO_NETWORK, 0))
+		assert.Equal(t, 0, rtt.cache.Len())
+
+		// Replies without a matching packet are not observed.
+		rtt.ProcessFlow(rttFlow(t0, podB, podA, "10.1.0.2", "10.0.0.1", 80, 40000, flow.TraceObservationPoint_FROM_NETWORK, 400))
+		assert.Equal(t, 0, testutil.CollectAndCount(exporter.AdvancedRegistry))
+	})
+}
@@ -44,6 +44,9 @@ const (
 	// workload context option
 	workloadCtxOption = "workload"
 
+	// node context option, the name of the peer node for the node endpoints
+	nodeCtxOption = "node"
+
 	// localContext means only the pods on this node will be watched
 	// and only these events will be enriched
 	localContext enrichmentContext = "local"
@@ -61,7 +64,7 @@ const (
 
 //go:generate go run go.uber.org/mock/mockgen@v0.4.0 -source=types.go -destination=mock_types.go -package=metrics
 type IModule interface {
-	Reconcile(spec *api.MetricsSpec) error
+	Reconcile(specs ...*api.MetricsSpec) error
 }
 
 type enrichmentContext string
@@ -95,6 +98,7 @@ type ContextOptions struct {
 	Workload  bool
 	Service   bool
 	Port      bool
+	Node      bool
 }
 
 type DirtyCachePod struct {
@@ -129,6 +133,8 @@ func NewCtxOption(opts []string, option ctxOptionType) *ContextOptions {
 			c.Service = true
 		case portCtxOption:
 			c.Port = true
+		case nodeCtxOption:
+			c.Node = true
 		}
 	}
 
@@ -170,6 +176,10 @@ func (c *ContextOptions) getLabels() []string {
 		labels = append(labels, prefix+portCtxOption)
 	}
 
+	if c.Node {
+		labels = append(labels, prefix+nodeCtxOption)
+	}
+
 	return labels
 }
 
@@ -213,11 +223,11 @@ func (c *ContextOptions) getLocalCtxValues(f *flow.Flow) map[string][]string {
 		return values
 	}
 
-	if f.Source != nil && !isAPIServerPod(f.Source) {
+	if f.Source != nil && !isAPIServerPod(f.Source) && !isNodeEndpoint(f.Source) {
 		values[egress] = c.getByDirectionValues(f, false)
 	}
 
-	if f.Destination != nil && !isAPIServerPod(f.Destination) {
+	if f.Destination != nil && !isAPIServerPod(f.Destination) && !isNodeEndpoint(f.Destination) {
 		values[ingress] = c.getByDirectionValues(f, true)
 	}
 
@@ -310,6 +320,14 @@ func (c *ContextOptions) getByDirectionValues(f *flow.Flow, dest bool) []string
 		}
 	}
 
+	if c.Node {
+		if name := nodeName(ep); name != "" {
+			values = append(values, name)
+		} else {
+			values = append(values, "unknown")
+		}
+	}
+
 	return values
 }
 
@@ -366,3 +384,27 @@ func isAPIServerPod(ep *flow.Endpoint) bool {
 
 	return false
 }
+
+// isNodeEndpoint returns true for the endpoints of the node IPs.
+func isNodeEndpoint(ep *flow.Endpoint) bool {
+	for _, l := range ep.GetLabels() {
+		if l == common.ReservedHostLabel || l == common.ReservedRemoteNodeLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeName returns the name of the node of a node endpoint, empty for other endpoints.
+func nodeName(ep *flow.Endpoint) string {
+	if !isNodeEndpoint(ep) {
+		return ""
+	}
+	prefix := common.NodeNameLabel + "="
+	for _, l := range ep.GetLabels() {
+		if strings.HasPrefix(l, prefix) {
+			return strings.TrimPrefix(l, prefix)
+		}
+	}
+	return ""
+}
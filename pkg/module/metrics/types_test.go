@@ -130,6 +130,36 @@ func TestNewCtxOps(t *testing.T) {
 			},
 			expectedVals: []string{"", "unknown", "unknown"},
 		},
+		{
+			name:     "dest opts of remote node",
+			opts:     []string{"ip", "namespace", "Node"},
+			ctxType:  destination,
+			expected: []string{"destination_ip", "destination_namespace", "destination_node"},
+			f: &flow.Flow{
+				Destination: &flow.Endpoint{
+					Identity: 6,
+					Labels:   []string{"reserved:remote-node", "kubernetes.io/hostname=node2", "topology.kubernetes.io/zone=eastus-1"},
+				},
+				IP: &flow.IP{
+					Destination: "10.224.0.5",
+				},
+			},
+			expectedVals: []string{"10.224.0.5", "", "node2"},
+		},
+		{
+			name:     "source opts of node with a pod",
+			opts:     []string{"podName", "node"},
+			ctxType:  source,
+			expected: []string{"source_podname", "source_node"},
+			f: &flow.Flow{
+				Source: &flow.Endpoint{
+					Namespace: "ns",
+					PodName:   "test",
+					Labels:    []string{"kubernetes.io/hostname=node1"},
+				},
+			},
+			expectedVals: []string{"test", "unknown"},
+		},
 	}
 
 	for _, tc := range tt {
@@ -139,3 +169,13 @@ func TestNewCtxOps(t *testing.T) {
 		assert.Equal(t, tc.expectedVals, values, "values should match %s", tc.name)
 	}
 }
+
+func TestLocalCtxValuesNodeEndpoints(t *testing.T) {
+	c := NewCtxOption([]string{"podname"}, localCtx)
+	values := c.getLocalCtxValues(&flow.Flow{
+		Source:      &flow.Endpoint{Labels: []string{"reserved:host", "kubernetes.io/hostname=node1"}},
+		Destination: &flow.Endpoint{Namespace: "ns", PodName: "test"},
+	})
+	assert.Nil(t, values[egress])
+	assert.Equal(t, []string{"test"}, values[ingress])
+}
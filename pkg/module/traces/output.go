@@ -0,0 +1,62 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package traces
+
+import (
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ErrUnsupportedOutput is returned for trace output destinations the agent cannot write to yet.
+var ErrUnsupportedOutput = errors.New("unsupported trace output destination")
+
+// FlowWriter writes traced flows to an output destination.
+type FlowWriter interface {
+	Write(f *flow.Flow) error
+}
+
+// newFlowWriter returns the writer for the given output configuration.
+// A nil configuration defaults to stdout.
+func newFlowWriter(cfg *api.TraceOutputConfiguration) (FlowWriter, error) {
+	if cfg == nil {
+		return newJSONWriter(os.Stdout), nil
+	}
+
+	switch strings.ToLower(cfg.TraceOutputDestination) {
+	case "", api.TraceOutputStdout:
+		return newJSONWriter(os.Stdout), nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedOutput, "destination %s", cfg.TraceOutputDestination)
+	}
+}
+
+// jsonWriter writes each flow as a single line of JSON.
+type jsonWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	return &jsonWriter{w: w}
+}
+
+func (j *jsonWriter) Write(f *flow.Flow) error {
+	b, err := protojson.Marshal(f)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal flow")
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.w.Write(append(b, '\n')); err != nil {
+		return errors.Wrap(err, "failed to write flow")
+	}
+	return nil
+}
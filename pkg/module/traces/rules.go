@@ -0,0 +1,375 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package traces
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/controllers/cache"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// namespaceNameLabel is set by Kubernetes on every namespace,
+	// namespace selectors are matched against it.
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+
+	// hostnameLabel is set by the kubelet on every node,
+	// node selectors are matched against it.
+	hostnameLabel = "kubernetes.io/hostname"
+
+	// serviceNameLabel is the well-known label EndpointSlices use to point at
+	// their service, service selectors are matched against it.
+	serviceNameLabel = "kubernetes.io/service-name"
+)
+
+var tracePointToObservationPoint = map[string]flow.TraceObservationPoint{
+	api.PodToNode:     flow.TraceObservationPoint_TO_STACK,
+	api.NodeToPod:     flow.TraceObservationPoint_TO_ENDPOINT,
+	api.NodeToNetwork: flow.TraceObservationPoint_TO_NETWORK,
+	api.NetworkToNode: flow.TraceObservationPoint_FROM_NETWORK,
+}
+
+// traceRule is a single TraceTargets entry of a TraceConfiguration.
+// Each rule is programmed into the filter manager under its own RequestMetadata.
+type traceRule struct {
+	id                string
+	captureLevel      string
+	includeLayer7Data bool
+
+	src *traceTarget
+	dst *traceTarget
+
+	ports  []portRange
+	points map[flow.TraceObservationPoint]struct{}
+
+	// ips are the IPs currently programmed into the filter manager for this rule.
+	ips map[string]net.IP
+}
+
+// traceTarget is a compiled TraceTarget.
+type traceTarget struct {
+	cidr   *net.IPNet
+	except []*net.IPNet
+
+	namespaceSelector labels.Selector
+	podSelector       labels.Selector
+	nodeSelector      labels.Selector
+	serviceSelector   labels.Selector
+
+	// resolved holds the IPs the selectors resolved to on the last refresh.
+	resolved map[string]net.IP
+}
+
+type portRange struct {
+	protocol string
+	start    uint32
+	end      uint32
+}
+
+func newTraceRule(id string, cfg *api.TraceConfiguration, tt *api.TraceTargets) (*traceRule, error) {
+	if tt == nil {
+		return nil, errors.Errorf("trace targets for rule %s is nil", id)
+	}
+
+	r := &traceRule{
+		id:                id,
+		captureLevel:      cfg.TraceCaptureLevel,
+		includeLayer7Data: cfg.IncludeLayer7Data,
+		points:            make(map[flow.TraceObservationPoint]struct{}),
+		ips:               make(map[string]net.IP),
+	}
+
+	var err error
+	if r.src, err = newTraceTarget(tt.Source); err != nil {
+		return nil, errors.Wrapf(err, "invalid source for rule %s", id)
+	}
+	if r.dst, err = newTraceTarget(tt.Destination); err != nil {
+		return nil, errors.Wrapf(err, "invalid destination for rule %s", id)
+	}
+
+	for _, p := range tt.Ports {
+		if p == nil {
+			continue
+		}
+		pr, err := newPortRange(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid port for rule %s", id)
+		}
+		r.ports = append(r.ports, pr)
+	}
+
+	for _, tp := range tt.TracePoints {
+		op, ok := tracePointToObservationPoint[tp]
+		if !ok {
+			return nil, errors.Errorf("invalid trace point %s for rule %s", tp, id)
+		}
+		r.points[op] = struct{}{}
+	}
+
+	return r, nil
+}
+
+func newTraceTarget(tt *api.TraceTarget) (*traceTarget, error) {
+	if tt == nil {
+		return nil, nil
+	}
+
+	t := &traceTarget{resolved: make(map[string]net.IP)}
+
+	if !tt.IPBlock.IsEmpty() {
+		_, cidr, err := net.ParseCIDR(tt.IPBlock.CIDR)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cidr %s", tt.IPBlock.CIDR)
+		}
+		t.cidr = cidr
+		for _, e := range tt.IPBlock.Except {
+			_, except, err := net.ParseCIDR(e)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid except cidr %s", e)
+			}
+			t.except = append(t.except, except)
+		}
+	}
+
+	var err error
+	if t.namespaceSelector, err = toSelector(tt.NamespaceSelector); err != nil {
+		return nil, err
+	}
+	if t.podSelector, err = toSelector(tt.PodSelector); err != nil {
+		return nil, err
+	}
+	if t.nodeSelector, err = toSelector(tt.NodeSelector); err != nil {
+		return nil, err
+	}
+	if t.serviceSelector, err = toSelector(tt.ServiceSelector); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func toSelector(ls *metav1.LabelSelector) (labels.Selector, error) {
+	if ls == nil {
+		return nil, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(ls)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid label selector %s", ls.String())
+	}
+	return s, nil
+}
+
+func newPortRange(p *api.TracePorts) (portRange, error) {
+	start, err := strconv.ParseUint(p.Port, 10, 16)
+	if err != nil {
+		return portRange{}, errors.Wrapf(err, "invalid port %s", p.Port)
+	}
+	end := start
+	if p.EndPort != "" && p.EndPort != "0" {
+		end, err = strconv.ParseUint(p.EndPort, 10, 16)
+		if err != nil {
+			return portRange{}, errors.Wrapf(err, "invalid end port %s", p.EndPort)
+		}
+		if end < start {
+			return portRange{}, fmt.Errorf("end port %s is smaller than port %s", p.EndPort, p.Port)
+		}
+	}
+	return portRange{
+		protocol: strings.ToUpper(p.Protocol),
+		start:    uint32(start),
+		end:      uint32(end),
+	}, nil
+}
+
+// resolve returns the IPs of all the objects in the cache that the target selects.
+func (t *traceTarget) resolve(c cache.CacheInterface) map[string]net.IP {
+	ips := make(map[string]net.IP)
+	if t == nil {
+		return ips
+	}
+
+	add := func(ip net.IP) {
+		if ip != nil {
+			ips[ip.String()] = ip
+		}
+	}
+
+	switch {
+	case t.cidr != nil:
+		for _, ep := range c.GetEndpoints() {
+			if ep.NetIPs() == nil {
+				continue
+			}
+			for _, ip := range ep.NetIPs().GetNetIPs() {
+				if t.inIPBlock(ip) {
+					add(ip)
+				}
+			}
+		}
+		for _, node := range c.GetNodes() {
+			if t.inIPBlock(node.IP()) {
+				add(node.IP())
+			}
+		}
+	case t.namespaceSelector != nil || t.podSelector != nil:
+		// Without a namespace selector, the pod selector matches pods in all namespaces.
+		for _, ep := range c.GetEndpoints() {
+			if t.namespaceSelector != nil && !t.namespaceSelector.Matches(labels.Set{namespaceNameLabel: ep.Namespace()}) {
+				continue
+			}
+			if t.podSelector != nil && !t.podSelector.Matches(labels.Set(ep.Labels())) {
+				continue
+			}
+			if ep.NetIPs() != nil {
+				for _, ip := range ep.NetIPs().GetNetIPs() {
+					add(ip)
+				}
+			}
+		}
+	case t.nodeSelector != nil:
+		for _, node := range c.GetNodes() {
+			if t.nodeSelector.Matches(labels.Set{hostnameLabel: node.Name()}) {
+				add(node.IP())
+			}
+		}
+	case t.serviceSelector != nil:
+		for _, svc := range c.GetSvcs() {
+			if !t.serviceSelector.Matches(labels.Set{
+				serviceNameLabel:   svc.Name(),
+				namespaceNameLabel: svc.Namespace(),
+			}) {
+				continue
+			}
+			if svc.IPs() != nil {
+				for _, ip := range svc.IPs().GetNetIPs() {
+					add(ip)
+				}
+			}
+			// Flows observed on the node carry the backend pod IPs after DNAT,
+			// so the pods behind the service are traced as well.
+			if len(svc.Selector()) == 0 {
+				continue
+			}
+			podSelector := labels.SelectorFromSet(svc.Selector())
+			for _, ep := range c.GetEndpoints() {
+				if ep.Namespace() != svc.Namespace() || !podSelector.Matches(labels.Set(ep.Labels())) {
+					continue
+				}
+				if ep.NetIPs() != nil {
+					for _, ip := range ep.NetIPs().GetNetIPs() {
+						add(ip)
+					}
+				}
+			}
+		}
+	}
+
+	return ips
+}
+
+func (t *traceTarget) inIPBlock(ip net.IP) bool {
+	if ip == nil || !t.cidr.Contains(ip) {
+		return false
+	}
+	for _, e := range t.except {
+		if e.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchIP returns true if the given flow IP is selected by the target.
+// A nil target matches any IP.
+func (t *traceTarget) matchIP(ip string) bool {
+	if t == nil {
+		return true
+	}
+	if t.cidr != nil {
+		return t.inIPBlock(net.ParseIP(ip))
+	}
+	if parsed := net.ParseIP(ip); parsed != nil {
+		ip = parsed.String()
+	}
+	_, ok := t.resolved[ip]
+	return ok
+}
+
+// matches returns true if the flow is selected by the rule.
+func (r *traceRule) matches(f *flow.Flow) bool {
+	if f.GetIP() == nil {
+		return false
+	}
+
+	if len(r.points) > 0 {
+		if _, ok := r.points[f.GetTraceObservationPoint()]; !ok {
+			return false
+		}
+	}
+
+	if !r.src.matchIP(f.GetIP().GetSource()) || !r.dst.matchIP(f.GetIP().GetDestination()) {
+		return false
+	}
+
+	if len(r.ports) == 0 {
+		return true
+	}
+	proto, port := destinationPort(f)
+	for _, pr := range r.ports {
+		if pr.protocol == proto && port >= pr.start && port <= pr.end {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve refreshes the IPs of the source and destination targets and returns their union.
+func (r *traceRule) resolve(c cache.CacheInterface) map[string]net.IP {
+	want := make(map[string]net.IP)
+	for _, t := range []*traceTarget{r.src, r.dst} {
+		if t == nil {
+			continue
+		}
+		t.resolved = t.resolve(c)
+		for k, ip := range t.resolved {
+			want[k] = ip
+		}
+	}
+	return want
+}
+
+func destinationPort(f *flow.Flow) (string, uint32) {
+	switch {
+	case f.GetL4().GetTCP() != nil:
+		return "TCP", f.GetL4().GetTCP().GetDestinationPort()
+	case f.GetL4().GetUDP() != nil:
+		return "UDP", f.GetL4().GetUDP().GetDestinationPort()
+	case f.GetL4().GetSCTP() != nil:
+		return "SCTP", f.GetL4().GetSCTP().GetDestinationPort()
+	default:
+		return "", 0
+	}
+}
+
+// flowKey returns the 5-tuple of the flow, used to only trace the first packet of a connection.
+func flowKey(f *flow.Flow) string {
+	var srcPort uint32
+	proto, dstPort := destinationPort(f)
+	switch {
+	case f.GetL4().GetTCP() != nil:
+		srcPort = f.GetL4().GetTCP().GetSourcePort()
+	case f.GetL4().GetUDP() != nil:
+		srcPort = f.GetL4().GetUDP().GetSourcePort()
+	case f.GetL4().GetSCTP() != nil:
+		srcPort = f.GetL4().GetSCTP().GetSourcePort()
+	}
+	return fmt.Sprintf("%s/%s:%d/%s:%d", proto, f.GetIP().GetSource(), srcPort, f.GetIP().GetDestination(), dstPort)
+}
@@ -0,0 +1,195 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package traces
+
+import (
+	"net"
+	"sort"
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/common"
+	"github.com/microsoft/retina/pkg/controllers/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testCache(ctrl *gomock.Controller) *cache.MockCacheInterface {
+	web := common.NewRetinaEndpoint("web", "ns1", common.NewIPAddress(net.ParseIP("10.0.0.1"), net.ParseIP("fd00::1")))
+	web.SetLabels(map[string]string{"app": "web"})
+	db := common.NewRetinaEndpoint("db", "ns1", common.NewIPAddress(net.ParseIP("10.0.0.2"), nil))
+	db.SetLabels(map[string]string{"app": "db"})
+	other := common.NewRetinaEndpoint("web", "ns2", common.NewIPAddress(net.ParseIP("10.0.1.1"), nil))
+	other.SetLabels(map[string]string{"app": "web"})
+
+	svc := common.NewRetinaSvc("web-svc", "ns1", common.NewIPAddress(net.ParseIP("10.96.0.10"), nil), nil, map[string]string{"app": "web"})
+
+	c := cache.NewMockCacheInterface(ctrl)
+	c.EXPECT().GetEndpoints().Return([]*common.RetinaEndpoint{web, db, other}).AnyTimes()
+	c.EXPECT().GetNodes().Return([]*common.RetinaNode{
+		common.NewRetinaNode("node1", net.ParseIP("10.224.0.4")),
+		common.NewRetinaNode("node2", net.ParseIP("10.224.0.5")),
+	}).AnyTimes()
+	c.EXPECT().GetSvcs().Return([]*common.RetinaSvc{svc}).AnyTimes()
+	return c
+}
+
+func TestTraceTargetResolve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := testCache(ctrl)
+
+	tests := []struct {
+		name   string
+		target *api.TraceTarget
+		want   []string
+	}{
+		{
+			name:   "nil target",
+			target: nil,
+			want:   []string{},
+		},
+		{
+			name: "namespace selector",
+			target: &api.TraceTarget{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "ns1"}},
+			},
+			want: []string{"10.0.0.1", "10.0.0.2", "fd00::1"},
+		},
+		{
+			name: "namespace and pod selector",
+			target: &api.TraceTarget{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "ns1"}},
+				PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			},
+			want: []string{"10.0.0.2"},
+		},
+		{
+			name: "pod selector without namespace selector matches all namespaces",
+			target: &api.TraceTarget{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			},
+			want: []string{"10.0.0.1", "10.0.1.1", "fd00::1"},
+		},
+		{
+			name: "node selector",
+			target: &api.TraceTarget{
+				NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{hostnameLabel: "node2"}},
+			},
+			want: []string{"10.224.0.5"},
+		},
+		{
+			name: "service selector includes backends",
+			target: &api.TraceTarget{
+				ServiceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{serviceNameLabel: "web-svc"}},
+			},
+			want: []string{"10.0.0.1", "10.96.0.10", "fd00::1"},
+		},
+		{
+			name: "ip block with except",
+			target: &api.TraceTarget{
+				IPBlock: api.IPBlock{CIDR: "10.0.0.0/16", Except: []string{"10.0.1.0/24"}},
+			},
+			want: []string{"10.0.0.1", "10.0.0.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := newTraceTarget(tt.target)
+			require.NoError(t, err)
+
+			got := make([]string, 0)
+			for k := range target.resolve(c) {
+				got = append(got, k)
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewTraceRuleErrors(t *testing.T) {
+	cfg := &api.TraceConfiguration{TraceCaptureLevel: api.AllPacketsCapture}
+
+	tests := []struct {
+		name string
+		tt   *api.TraceTargets
+	}{
+		{
+			name: "nil targets",
+			tt:   nil,
+		},
+		{
+			name: "invalid cidr",
+			tt:   &api.TraceTargets{Source: &api.TraceTarget{IPBlock: api.IPBlock{CIDR: "10.0.0.0/33"}}},
+		},
+		{
+			name: "invalid port",
+			tt:   &api.TraceTargets{Ports: []*api.TracePorts{{Port: "http", Protocol: "TCP"}}},
+		},
+		{
+			name: "end port before port",
+			tt:   &api.TraceTargets{Ports: []*api.TracePorts{{Port: "90", EndPort: "80", Protocol: "TCP"}}},
+		},
+		{
+			name: "invalid trace point",
+			tt:   &api.TraceTargets{TracePoints: api.TracePoints{"PodToPod"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTraceRule("rule", cfg, tt.tt)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTraceRuleMatches(t *testing.T) {
+	cfg := &api.TraceConfiguration{TraceCaptureLevel: api.AllPacketsCapture}
+	r, err := newTraceRule("rule", cfg, &api.TraceTargets{
+		Source:      &api.TraceTarget{IPBlock: api.IPBlock{CIDR: "10.0.0.0/24", Except: []string{"10.0.0.128/25"}}},
+		Ports:       []*api.TracePorts{{Port: "8080", EndPort: "8090", Protocol: "tcp"}, {Port: "53", Protocol: "UDP"}},
+		TracePoints: api.TracePoints{api.NodeToPod},
+	})
+	require.NoError(t, err)
+
+	newFlow := func(src string, point flow.TraceObservationPoint, l4 *flow.Layer4) *flow.Flow {
+		return &flow.Flow{
+			IP:                    &flow.IP{Source: src, Destination: "10.0.1.1"},
+			L4:                    l4,
+			TraceObservationPoint: point,
+		}
+	}
+	tcp := func(port uint32) *flow.Layer4 {
+		return &flow.Layer4{Protocol: &flow.Layer4_TCP{TCP: &flow.TCP{SourcePort: 40000, DestinationPort: port}}}
+	}
+	udp := func(port uint32) *flow.Layer4 {
+		return &flow.Layer4{Protocol: &flow.Layer4_UDP{UDP: &flow.UDP{SourcePort: 40000, DestinationPort: port}}}
+	}
+
+	tests := []struct {
+		name string
+		f    *flow.Flow
+		want bool
+	}{
+		{"tcp in range", newFlow("10.0.0.1", flow.TraceObservationPoint_TO_ENDPOINT, tcp(8085)), true},
+		{"udp port", newFlow("10.0.0.1", flow.TraceObservationPoint_TO_ENDPOINT, udp(53)), true},
+		{"tcp out of range", newFlow("10.0.0.1", flow.TraceObservationPoint_TO_ENDPOINT, tcp(8091)), false},
+		{"wrong protocol", newFlow("10.0.0.1", flow.TraceObservationPoint_TO_ENDPOINT, udp(8080)), false},
+		{"excepted source", newFlow("10.0.0.200", flow.TraceObservationPoint_TO_ENDPOINT, tcp(8080)), false},
+		{"source outside cidr", newFlow("10.0.2.1", flow.TraceObservationPoint_TO_ENDPOINT, tcp(8080)), false},
+		{"wrong trace point", newFlow("10.0.0.1", flow.TraceObservationPoint_TO_STACK, tcp(8080)), false},
+		{"no ip", &flow.Flow{L4: tcp(8080), TraceObservationPoint: flow.TraceObservationPoint_TO_ENDPOINT}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.matches(tt.f))
+		})
+	}
+}
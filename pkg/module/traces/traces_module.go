@@ -4,13 +4,21 @@ package traces
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"sync"
 	"time"
 
+	"github.com/cilium/cilium/api/v1/flow"
+	lru "github.com/hashicorp/golang-lru/v2"
 	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/controllers/cache"
+	"github.com/microsoft/retina/pkg/enricher"
 	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/managers/filtermanager"
 	"github.com/microsoft/retina/pkg/pubsub"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -20,6 +28,12 @@ var (
 
 const (
 	moduleIntervalSecs = 1 * time.Second
+
+	traceModuleReq filtermanager.Requestor = "traceModule"
+
+	// firstPacketCacheSize bounds the number of connections remembered
+	// to only trace the first packet of non-TCP connections.
+	firstPacketCacheSize = 10000
 )
 
 type Module struct {
@@ -42,11 +56,39 @@ type Module struct {
 	// isRunning is the flag to indicate if the trace module is running
 	isRunning bool
 
-	// TODO add the filter manager here or add a pubsub topic for
-	// filter manager to subscribe to
+	// enricher to read enriched flows from
+	enricher enricher.EnricherInterface
+
+	// filterManager to add or delete the IPs selected by the trace rules
+	filterManager filtermanager.IFilterManager
+
+	// daemonCache is used to resolve the trace targets to IPs
+	daemonCache cache.CacheInterface
+
+	// currentSpec is the last spec that was reconciled
+	currentSpec *api.TracesSpec
+
+	// rules are the compiled trace targets of all the trace configurations
+	rules []*traceRule
+
+	// staleRules are the rules removed by a reconcile whose IPs could not be deleted
+	// from the filter manager. The deletion is retried on every refresh.
+	staleRules []*traceRule
+
+	// writer is where the traced flows are written to
+	writer FlowWriter
+
+	// firstPackets remembers the connections that were already traced
+	// for trace configurations with the FirstPacket capture level.
+	firstPackets *lru.Cache[string, struct{}]
 }
 
-func NewModule(ctx context.Context, pubsub pubsub.PubSubInterface) *Module {
+func NewModule(ctx context.Context,
+	pubsub pubsub.PubSubInterface,
+	enricher enricher.EnricherInterface,
+	fm filtermanager.IFilterManager,
+	cache cache.CacheInterface,
+) *Module {
 	// this is a thread-safe singleton instance of the trace module
 	once.Do(func() {
 		t = &Module{
@@ -56,6 +98,9 @@ func NewModule(ctx context.Context, pubsub pubsub.PubSubInterface) *Module {
 			pubsub:        pubsub,
 			configs:       make([]*api.TraceConfiguration, 0),
 			outputConfigs: make([]*api.TraceOutputConfiguration, 0),
+			enricher:      enricher,
+			filterManager: fm,
+			daemonCache:   cache,
 		}
 
 		t.init()
@@ -65,15 +110,20 @@ func NewModule(ctx context.Context, pubsub pubsub.PubSubInterface) *Module {
 }
 
 func (t *Module) init() {
-	// TODO
+	// lru.New only fails for a non-positive size.
+	t.firstPackets, _ = lru.New[string, struct{}](firstPacketCacheSize)
 }
 
 func (t *Module) Run() {
+	t.Lock()
 	if t.isRunning {
+		t.Unlock()
 		return
 	}
+	t.isRunning = true
+	t.Unlock()
+
 	go func() {
-		t.isRunning = true
 		ticker := time.NewTicker(moduleIntervalSecs)
 		defer ticker.Stop()
 
@@ -88,12 +138,255 @@ func (t *Module) Run() {
 			}
 		}
 	}()
+
+	go t.consume()
 }
 
+// Reconcile compiles the trace configurations of the spec into rules and
+// programs the IPs they select into the filter manager.
+// A nil spec removes all the rules.
 func (t *Module) Reconcile(spec *api.TracesSpec) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if tracesSpecEqual(t.currentSpec, spec) {
+		t.l.Debug("Spec has not changed. Not reconciling.")
+		return nil
+	}
+
+	var (
+		rules         []*traceRule
+		writer        FlowWriter
+		configs       = make([]*api.TraceConfiguration, 0)
+		outputConfigs = make([]*api.TraceOutputConfiguration, 0)
+	)
+	if spec != nil {
+		for i, cfg := range spec.TraceConfiguration {
+			if cfg == nil {
+				continue
+			}
+			for j, tt := range cfg.TraceTargets {
+				r, err := newTraceRule(fmt.Sprintf("trace-%d-target-%d", i, j), cfg, tt)
+				if err != nil {
+					return err
+				}
+				rules = append(rules, r)
+			}
+			configs = append(configs, cfg)
+		}
+
+		var err error
+		if writer, err = newFlowWriter(spec.TraceOutputConfiguration); err != nil {
+			return err
+		}
+		if spec.TraceOutputConfiguration != nil {
+			outputConfigs = append(outputConfigs, spec.TraceOutputConfiguration)
+		}
+	}
+
+	t.l.Info("Reconciling trace module", zap.Int("rules", len(rules)))
+
+	// The IPs of the previous rules are deleted by the refresh below.
+	for _, r := range t.rules {
+		if len(r.ips) > 0 {
+			t.staleRules = append(t.staleRules, r)
+		}
+	}
+
+	t.rules = rules
+	t.writer = writer
+	t.configs = configs
+	t.outputConfigs = outputConfigs
+	t.currentSpec = spec
+	t.firstPackets.Purge()
+
+	t.refresh()
 	return nil
 }
 
 func (t *Module) run() error {
+	t.Lock()
+	defer t.Unlock()
+
+	t.refresh()
 	return nil
 }
+
+// refresh resolves the trace targets against the cache and updates
+// the filter manager with the IPs that were added or removed since the last refresh.
+// Caller must hold the lock.
+func (t *Module) refresh() {
+	t.deleteStaleRuleIPs()
+
+	for _, r := range t.rules {
+		want := r.resolve(t.daemonCache)
+
+		toAdd := make([]net.IP, 0)
+		for k, ip := range want {
+			if _, ok := r.ips[k]; !ok {
+				toAdd = append(toAdd, ip)
+			}
+		}
+		toDelete := make(map[string]net.IP)
+		for k, ip := range r.ips {
+			if _, ok := want[k]; !ok {
+				toDelete[k] = ip
+			}
+		}
+
+		if len(toAdd) > 0 {
+			t.l.Debug("Adding trace IPs to filtermap", zap.String("rule", r.id), zap.Any("IPs", toAdd))
+			if err := t.filterManager.AddIPs(toAdd, traceModuleReq, filtermanager.RequestMetadata{RuleID: r.id}); err != nil {
+				// Retried on the next refresh.
+				t.l.Error("Error adding trace IPs to filter manager", zap.String("rule", r.id), zap.Error(err))
+			} else {
+				for _, ip := range toAdd {
+					r.ips[ip.String()] = ip
+				}
+			}
+		}
+		t.deleteRuleIPs(r, toDelete)
+	}
+}
+
+// deleteStaleRuleIPs retries deleting the IPs of the removed rules from the filter manager.
+// An IP that a current rule with the same ID has programmed since then is owned by that rule and is kept.
+// Caller must hold the lock.
+func (t *Module) deleteStaleRuleIPs() {
+	current := make(map[string]*traceRule, len(t.rules))
+	for _, r := range t.rules {
+		current[r.id] = r
+	}
+
+	stale := t.staleRules[:0]
+	for _, r := range t.staleRules {
+		toDelete := make(map[string]net.IP, len(r.ips))
+		for k, ip := range r.ips {
+			if owner, ok := current[r.id]; ok {
+				if _, owned := owner.ips[k]; owned {
+					delete(r.ips, k)
+					continue
+				}
+			}
+			toDelete[k] = ip
+		}
+		t.deleteRuleIPs(r, toDelete)
+		if len(r.ips) > 0 {
+			stale = append(stale, r)
+		}
+	}
+	t.staleRules = stale
+}
+
+// deleteRuleIPs removes the given IPs of the rule from the filter manager.
+// Caller must hold the lock.
+func (t *Module) deleteRuleIPs(r *traceRule, ips map[string]net.IP) {
+	if len(ips) == 0 {
+		return
+	}
+
+	toDelete := make([]net.IP, 0, len(ips))
+	for _, ip := range ips {
+		toDelete = append(toDelete, ip)
+	}
+
+	t.l.Debug("Deleting trace IPs from filtermap", zap.String("rule", r.id), zap.Any("IPs", toDelete))
+	if err := t.filterManager.DeleteIPs(toDelete, traceModuleReq, filtermanager.RequestMetadata{RuleID: r.id}); err != nil {
+		t.l.Error("Error deleting trace IPs from filter manager", zap.String("rule", r.id), zap.Error(err))
+		return
+	}
+	for k := range ips {
+		delete(r.ips, k)
+	}
+}
+
+// consume reads the enriched flows and writes the ones selected by a rule.
+func (t *Module) consume() {
+	evReader := t.enricher.ExportReader()
+	for {
+		ev := evReader.NextFollow(t.ctx)
+		if ev == nil {
+			break
+		}
+
+		if f, ok := ev.Event.(*flow.Flow); ok {
+			t.processFlow(f)
+		}
+	}
+
+	if err := evReader.Close(); err != nil {
+		t.l.Error("Error closing the event reader", zap.Error(err))
+	}
+
+	t.Lock()
+	t.isRunning = false
+	t.Unlock()
+}
+
+func (t *Module) processFlow(f *flow.Flow) {
+	t.RLock()
+	defer t.RUnlock()
+
+	if t.writer == nil {
+		return
+	}
+
+	for _, r := range t.rules {
+		if !r.matches(f) {
+			continue
+		}
+
+		if r.captureLevel == api.FirstPacketCapture && !t.isFirstPacket(r, f) {
+			continue
+		}
+
+		out := f
+		if !r.includeLayer7Data && f.GetL7() != nil {
+			out = proto.Clone(f).(*flow.Flow)
+			out.L7 = nil
+		}
+
+		if err := t.writer.Write(out); err != nil {
+			t.l.Error("Error writing traced flow", zap.Error(err))
+		}
+		// A flow is only written once even if it is selected by multiple rules.
+		return
+	}
+}
+
+// isFirstPacket returns true if the flow is the first packet of its connection.
+// TCP connections are identified by their SYN, for other protocols the first
+// flow seen for the 5-tuple is traced.
+func (t *Module) isFirstPacket(r *traceRule, f *flow.Flow) bool {
+	if tcp := f.GetL4().GetTCP(); tcp != nil {
+		return tcp.GetFlags().GetSYN() && !tcp.GetFlags().GetACK()
+	}
+
+	key := r.id + "/" + flowKey(f)
+	if t.firstPackets.Contains(key) {
+		return false
+	}
+	t.firstPackets.Add(key, struct{}{})
+	return true
+}
+
+func tracesSpecEqual(old, new *api.TracesSpec) bool {
+	if old == nil || new == nil {
+		return old == nil && new == nil
+	}
+
+	if len(old.TraceConfiguration) != len(new.TraceConfiguration) {
+		return false
+	}
+	for i := range old.TraceConfiguration {
+		if !old.TraceConfiguration[i].Equal(new.TraceConfiguration[i]) {
+			return false
+		}
+	}
+
+	oo, no := old.TraceOutputConfiguration, new.TraceOutputConfiguration
+	if oo == nil || no == nil {
+		return oo == nil && no == nil
+	}
+	return *oo == *no
+}
@@ -0,0 +1,311 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package traces
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	api "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/enricher"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/managers/filtermanager"
+	"github.com/microsoft/retina/pkg/pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type recordingWriter struct {
+	flows []*flow.Flow
+}
+
+func (r *recordingWriter) Write(f *flow.Flow) error {
+	r.flows = append(r.flows, f)
+	return nil
+}
+
+func newTestModule(t *testing.T, ctrl *gomock.Controller, fm filtermanager.IFilterManager) *Module {
+	t.Helper()
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	once = sync.Once{}
+	return NewModule(
+		context.Background(),
+		pubsub.NewMockPubSubInterface(ctrl),
+		enricher.NewMockEnricherInterface(ctrl),
+		fm,
+		testCache(ctrl),
+	)
+}
+
+func ipStrings(ips []net.IP) []string {
+	s := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		s = append(s, ip.String())
+	}
+	sort.Strings(s)
+	return s
+}
+
+func TestReconcile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fm := filtermanager.NewMockIFilterManager(ctrl)
+	m := newTestModule(t, ctrl, fm)
+
+	spec := &api.TracesSpec{
+		TraceConfiguration: []*api.TraceConfiguration{
+			{
+				TraceCaptureLevel: api.AllPacketsCapture,
+				TraceTargets: []*api.TraceTargets{
+					{
+						Source: &api.TraceTarget{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "ns1"}},
+							PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+						},
+					},
+				},
+			},
+		},
+		TraceOutputConfiguration: &api.TraceOutputConfiguration{TraceOutputDestination: api.TraceOutputStdout},
+	}
+
+	meta := filtermanager.RequestMetadata{RuleID: "trace-0-target-0"}
+	fm.EXPECT().AddIPs(gomock.Any(), traceModuleReq, meta).DoAndReturn(
+		func(ips []net.IP, _ filtermanager.Requestor, _ filtermanager.RequestMetadata) error {
+			assert.Equal(t, []string{"10.0.0.2"}, ipStrings(ips))
+			return nil
+		}).Times(1)
+	require.NoError(t, m.Reconcile(spec))
+	require.Len(t, m.rules, 1)
+	assert.Len(t, m.outputConfigs, 1)
+
+	// Nothing changed in the cache, so a refresh must not reprogram the filter manager.
+	require.NoError(t, m.run())
+
+	// Reconciling the same spec is a no-op.
+	require.NoError(t, m.Reconcile(spec))
+
+	// Removing the spec removes the IPs of the rule.
+	fm.EXPECT().DeleteIPs(gomock.Any(), traceModuleReq, meta).DoAndReturn(
+		func(ips []net.IP, _ filtermanager.Requestor, _ filtermanager.RequestMetadata) error {
+			assert.Equal(t, []string{"10.0.0.2"}, ipStrings(ips))
+			return nil
+		}).Times(1)
+	require.NoError(t, m.Reconcile(nil))
+	assert.Empty(t, m.rules)
+	assert.Nil(t, m.writer)
+}
+
+func TestReconcileRetriesFailedDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fm := filtermanager.NewMockIFilterManager(ctrl)
+	m := newTestModule(t, ctrl, fm)
+
+	spec := &api.TracesSpec{
+		TraceConfiguration: []*api.TraceConfiguration{
+			{
+				TraceCaptureLevel: api.AllPacketsCapture,
+				TraceTargets: []*api.TraceTargets{
+					{Source: &api.TraceTarget{IPBlock: api.IPBlock{CIDR: "10.0.0.2/32"}}},
+				},
+			},
+		},
+		TraceOutputConfiguration: &api.TraceOutputConfiguration{TraceOutputDestination: api.TraceOutputStdout},
+	}
+
+	meta := filtermanager.RequestMetadata{RuleID: "trace-0-target-0"}
+	fm.EXPECT().AddIPs(gomock.Any(), traceModuleReq, meta).Return(nil).Times(1)
+	require.NoError(t, m.Reconcile(spec))
+
+	// The rule is kept until its IPs are deleted from the filter manager.
+	gomock.InOrder(
+		fm.EXPECT().DeleteIPs(gomock.Any(), traceModuleReq, meta).Return(errors.New("map update failed")).Times(2),
+		fm.EXPECT().DeleteIPs(gomock.Any(), traceModuleReq, meta).DoAndReturn(
+			func(ips []net.IP, _ filtermanager.Requestor, _ filtermanager.RequestMetadata) error {
+				assert.Equal(t, []string{"10.0.0.2"}, ipStrings(ips))
+				return nil
+			}).Times(1),
+	)
+	require.NoError(t, m.Reconcile(nil))
+	assert.Empty(t, m.rules)
+	require.Len(t, m.staleRules, 1)
+
+	require.NoError(t, m.run())
+	require.Len(t, m.staleRules, 1)
+
+	require.NoError(t, m.run())
+	assert.Empty(t, m.staleRules)
+
+	// Nothing left to delete.
+	require.NoError(t, m.run())
+}
+
+func TestReconcileStaleIPsOwnedByNewRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fm := filtermanager.NewMockIFilterManager(ctrl)
+	m := newTestModule(t, ctrl, fm)
+
+	spec := func(cidr string) *api.TracesSpec {
+		return &api.TracesSpec{
+			TraceConfiguration: []*api.TraceConfiguration{
+				{
+					TraceCaptureLevel: api.AllPacketsCapture,
+					TraceTargets:      []*api.TraceTargets{{Source: &api.TraceTarget{IPBlock: api.IPBlock{CIDR: cidr}}}},
+				},
+			},
+			TraceOutputConfiguration: &api.TraceOutputConfiguration{TraceOutputDestination: api.TraceOutputStdout},
+		}
+	}
+
+	meta := filtermanager.RequestMetadata{RuleID: "trace-0-target-0"}
+	fm.EXPECT().AddIPs(gomock.Any(), traceModuleReq, meta).Return(nil).Times(2)
+	require.NoError(t, m.Reconcile(spec("10.0.0.2/32")))
+
+	// The new rule has the same ID and programs the IP the old rule failed to delete,
+	// the IP is not deleted from under it.
+	fm.EXPECT().DeleteIPs(gomock.Any(), traceModuleReq, meta).Return(errors.New("map update failed")).Times(1)
+	require.NoError(t, m.Reconcile(spec("10.0.0.0/30")))
+	require.Len(t, m.staleRules, 1)
+
+	require.NoError(t, m.run())
+	assert.Empty(t, m.staleRules)
+}
+
+func TestReconcileInvalidSpec(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := newTestModule(t, ctrl, filtermanager.NewMockIFilterManager(ctrl))
+
+	err := m.Reconcile(&api.TracesSpec{
+		TraceConfiguration: []*api.TraceConfiguration{
+			{
+				TraceCaptureLevel: api.AllPacketsCapture,
+				TraceTargets:      []*api.TraceTargets{{}},
+			},
+		},
+		TraceOutputConfiguration: &api.TraceOutputConfiguration{TraceOutputDestination: api.TraceOutputAzureTable},
+	})
+	require.ErrorIs(t, err, ErrUnsupportedOutput)
+	assert.Nil(t, m.currentSpec)
+
+	err = m.Reconcile(&api.TracesSpec{
+		TraceConfiguration: []*api.TraceConfiguration{
+			{
+				TraceCaptureLevel: api.AllPacketsCapture,
+				TraceTargets:      []*api.TraceTargets{{TracePoints: api.TracePoints{"invalid"}}},
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Nil(t, m.currentSpec)
+}
+
+func TestProcessFlow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fm := filtermanager.NewMockIFilterManager(ctrl)
+	fm.EXPECT().AddIPs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m := newTestModule(t, ctrl, fm)
+
+	dst := &api.TraceTarget{IPBlock: api.IPBlock{CIDR: "10.0.0.0/24"}}
+	require.NoError(t, m.Reconcile(&api.TracesSpec{
+		TraceConfiguration: []*api.TraceConfiguration{
+			{
+				TraceCaptureLevel: api.FirstPacketCapture,
+				TraceTargets:      []*api.TraceTargets{{Destination: dst}},
+			},
+		},
+	}))
+	w := &recordingWriter{}
+	m.writer = w
+
+	tcpFlow := func(syn, ack bool) *flow.Flow {
+		return &flow.Flow{
+			IP: &flow.IP{Source: "10.0.1.1", Destination: "10.0.0.1"},
+			L4: &flow.Layer4{Protocol: &flow.Layer4_TCP{TCP: &flow.TCP{
+				SourcePort:      40000,
+				DestinationPort: 80,
+				Flags:           &flow.TCPFlags{SYN: syn, ACK: ack},
+			}}},
+			L7: &flow.Layer7{Type: flow.L7FlowType_REQUEST},
+		}
+	}
+	udpFlow := &flow.Flow{
+		IP: &flow.IP{Source: "10.0.1.1", Destination: "10.0.0.1"},
+		L4: &flow.Layer4{Protocol: &flow.Layer4_UDP{UDP: &flow.UDP{SourcePort: 40000, DestinationPort: 53}}},
+	}
+
+	m.processFlow(tcpFlow(true, false))
+	m.processFlow(tcpFlow(true, true))
+	m.processFlow(tcpFlow(false, true))
+	m.processFlow(udpFlow)
+	m.processFlow(udpFlow)
+	m.processFlow(&flow.Flow{IP: &flow.IP{Source: "10.0.1.1", Destination: "10.0.2.1"}})
+
+	require.Len(t, w.flows, 2)
+	assert.True(t, w.flows[0].GetL4().GetTCP().GetFlags().GetSYN())
+	assert.Nil(t, w.flows[0].GetL7(), "layer 7 data should be stripped")
+	assert.NotNil(t, w.flows[1].GetL4().GetUDP())
+}
+
+func TestProcessFlowNextRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fm := filtermanager.NewMockIFilterManager(ctrl)
+	fm.EXPECT().AddIPs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m := newTestModule(t, ctrl, fm)
+
+	dst := &api.TraceTarget{IPBlock: api.IPBlock{CIDR: "10.0.0.0/24"}}
+	require.NoError(t, m.Reconcile(&api.TracesSpec{
+		TraceConfiguration: []*api.TraceConfiguration{
+			{
+				TraceCaptureLevel: api.FirstPacketCapture,
+				TraceTargets:      []*api.TraceTargets{{Destination: dst}},
+			},
+			{
+				TraceCaptureLevel: api.AllPacketsCapture,
+				TraceTargets:      []*api.TraceTargets{{Destination: dst}},
+			},
+		},
+	}))
+	w := &recordingWriter{}
+	m.writer = w
+
+	udpFlow := &flow.Flow{
+		IP: &flow.IP{Source: "10.0.1.1", Destination: "10.0.0.1"},
+		L4: &flow.Layer4{Protocol: &flow.Layer4_UDP{UDP: &flow.UDP{SourcePort: 40000, DestinationPort: 53}}},
+	}
+
+	// The flows that are not the first packet for the first rule are still traced by the second one, once.
+	m.processFlow(udpFlow)
+	m.processFlow(udpFlow)
+	m.processFlow(udpFlow)
+
+	require.Len(t, w.flows, 3)
+}
+
+func TestJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := newJSONWriter(&buf)
+
+	require.NoError(t, w.Write(&flow.Flow{IP: &flow.IP{Source: "10.0.0.1", Destination: "10.0.0.2"}}))
+	require.NoError(t, w.Write(&flow.Flow{IP: &flow.IP{Source: "fd00::1", Destination: "fd00::2"}}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"10.0.0.1"`)
+	assert.Contains(t, lines[1], `"fd00::2"`)
+}
@@ -9,6 +9,14 @@
 #include "conntrack.h"
 #include "dynamic.h"
 
+#ifndef ENABLE_CONNTRACK_METRICS
+#define ENABLE_CONNTRACK_METRICS 0
+#endif
+
+// Whether the packets and bytes of the connections are counted.
+// The loader rewrites the constant in the precompiled objects, the objects compiled at runtime use dynamic.h.
+volatile const __u8 enable_conntrack_metrics = ENABLE_CONNTRACK_METRICS;
+
 struct tcpmetadata {
 	__u32 seq; // TCP sequence number
 	__u32 ack_num; // TCP ack number
@@ -31,6 +39,18 @@ struct conntrackmetadata {
     __u32 packets_reply_count;
 };
 
+struct tunnelmetadata {
+	__u32 src_ip; // Outer source IP of the encapsulated packet.
+	__u32 dst_ip; // Outer destination IP of the encapsulated packet.
+	__u32 vni; // VXLAN or Geneve network identifier.
+	__u8 tunnel_type; // Tunnel type, TUNNEL_TYPE_NONE if the packet is not encapsulated.
+};
+
+struct icmpmetadata {
+	__u8 type; // ICMP message type.
+	__u8 code; // ICMP message code.
+};
+
 struct packet
 {
 	__u64 t_nsec; // timestamp in nanoseconds
@@ -46,6 +66,8 @@ struct packet
 	__u8 flags; // For TCP packets, this is the TCP flags. For UDP packets, this is will always be 1 for conntrack purposes.
 	bool is_reply;
     struct conntrackmetadata conntrack_metadata;
+	struct tunnelmetadata tunnel_metadata; // Outer headers of the packets encapsulated in an overlay tunnel.
+	struct icmpmetadata icmp_metadata; // ICMP metadata, for ICMP packets only.
 };
 
 
@@ -144,12 +166,12 @@ static __always_inline bool _ct_create_new_tcp_connection(struct packet *p, stru
     new_value.is_direction_unknown = false;
     new_value.traffic_direction = _ct_get_traffic_direction(observation_point);
 
-    #ifdef ENABLE_CONNTRACK_METRICS
+    if (enable_conntrack_metrics) {
         new_value.conntrack_metadata.packets_forward_count = 1;
         new_value.conntrack_metadata.bytes_forward_count = p->bytes;
         // Update initial conntrack metadata for the connection.
         __builtin_memcpy(&p->conntrack_metadata, &new_value.conntrack_metadata, sizeof(struct conntrackmetadata));
-    #endif // ENABLE_CONNTRACK_METRICS
+    }
 
     // Update packet
     p->is_reply = false;
@@ -176,12 +198,12 @@ static __always_inline bool _ct_handle_udp_connection(struct packet *p, struct c
     new_value.flags_seen_tx_dir = p->flags;
     new_value.last_report_tx_dir = now;
     new_value.traffic_direction = _ct_get_traffic_direction(observation_point);
-    #ifdef ENABLE_CONNTRACK_METRICS
+    if (enable_conntrack_metrics) {
         new_value.conntrack_metadata.packets_forward_count = 1;
         new_value.conntrack_metadata.bytes_forward_count = p->bytes;
         // Update packet's conntrack metadata.
-        __builtin_memcpy(&p->conntrack_metadata, &new_value.conntrack_metadata, sizeof(struct conntrackmetadata));;
-    #endif // ENABLE_CONNTRACK_METRICS    
+        __builtin_memcpy(&p->conntrack_metadata, &new_value.conntrack_metadata, sizeof(struct conntrackmetadata));
+    }
 
     // Update packet
     p->is_reply = false;
@@ -225,25 +247,25 @@ static __always_inline bool _ct_handle_tcp_connection(struct packet *p, struct c
         p->is_reply = true;
         new_value.flags_seen_rx_dir = p->flags;
         new_value.last_report_rx_dir = now;
-        #ifdef ENABLE_CONNTRACK_METRICS
+        if (enable_conntrack_metrics) {
             new_value.conntrack_metadata.bytes_reply_count = p->bytes;
             new_value.conntrack_metadata.packets_reply_count = 1;
-        #endif // ENABLE_CONNTRACK_METRICS
+        }
         bpf_map_update_elem(&retina_conntrack, &reverse_key, &new_value, BPF_ANY);
     } else { // Otherwise, the packet is considered as a packet in the send direction.
         p->is_reply = false;
         new_value.flags_seen_tx_dir = p->flags;
         new_value.last_report_tx_dir = now;
-        #ifdef ENABLE_CONNTRACK_METRICS
+        if (enable_conntrack_metrics) {
             new_value.conntrack_metadata.bytes_forward_count = p->bytes;
             new_value.conntrack_metadata.packets_forward_count = 1;
-        #endif // ENABLE_CONNTRACK_METRICS
+        }
         bpf_map_update_elem(&retina_conntrack, &key, &new_value, BPF_ANY);
     }
-    #ifdef ENABLE_CONNTRACK_METRICS
+    if (enable_conntrack_metrics) {
         // Update packet's conntrack metadata.
         __builtin_memcpy(&p->conntrack_metadata, &new_value.conntrack_metadata, sizeof(struct conntrackmetadata));
-    #endif // ENABLE_CONNTRACK_METRICS
+    }
     return true;
 }
 
@@ -362,13 +384,13 @@ static __always_inline __attribute__((unused)) bool ct_process_packet(struct pac
         // Update the packet accordingly.
         p->is_reply = false;
         p->traffic_direction = entry->traffic_direction;
-        #ifdef ENABLE_CONNTRACK_METRICS
+        if (enable_conntrack_metrics) {
             // Update packet count and bytes count on conntrack entry.
             WRITE_ONCE(entry->conntrack_metadata.packets_forward_count, READ_ONCE(entry->conntrack_metadata.packets_forward_count) + 1);
             WRITE_ONCE(entry->conntrack_metadata.bytes_forward_count, READ_ONCE(entry->conntrack_metadata.bytes_forward_count) + p->bytes);
             // Update packet's conntract metadata.
             __builtin_memcpy(&p->conntrack_metadata, &entry->conntrack_metadata, sizeof(struct conntrackmetadata));
-        #endif // ENABLE_CONNTRACK_METRICS
+        }
         return _ct_should_report_packet(entry, p->flags, CT_PACKET_DIR_TX, &key);
     }
     
@@ -384,13 +406,13 @@ static __always_inline __attribute__((unused)) bool ct_process_packet(struct pac
         // Update the packet accordingly.
         p->is_reply = true;
         p->traffic_direction = entry->traffic_direction;
-        #ifdef ENABLE_CONNTRACK_METRICS
+        if (enable_conntrack_metrics) {
             // Update packet count and bytes count on conntrack entry.
             WRITE_ONCE(entry->conntrack_metadata.packets_reply_count, READ_ONCE(entry->conntrack_metadata.packets_reply_count) + 1);
             WRITE_ONCE(entry->conntrack_metadata.bytes_reply_count, READ_ONCE(entry->conntrack_metadata.bytes_reply_count) + p->bytes);
             // Update packet's conntract metadata.
             __builtin_memcpy(&p->conntrack_metadata, &entry->conntrack_metadata, sizeof(struct conntrackmetadata));
-        #endif // ENABLE_CONNTRACK_METRICS
+        }
         return _ct_should_report_packet(entry, p->flags, CT_PACKET_DIR_RX, &reverse_key);
     }
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"net"
 	"os"
+	"strconv"
 
 	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
 	"github.com/inspektor-gadget/inspektor-gadget/pkg/gadgets/trace/dns/tracer"
@@ -140,6 +141,11 @@ func (d *dns) eventHandler(event *types.Event) {
 
 	utils.AddDNSInfo(fl, meta, string(event.Qr), common.RCodeToFlow(event.Rcode), event.DNSName, []string{event.QType}, event.NumAnswers, event.Addresses)
 
+	// The transaction ID is formatted in hex by the tracer.
+	if id, err := strconv.ParseUint(event.ID, 16, 16); err == nil {
+		utils.AddDNSID(meta, uint32(id))
+	}
+
 	// Add metadata to the flow.
 	utils.AddRetinaMetadata(fl, meta)
 
@@ -124,6 +124,7 @@ func TestRequestEventHandler(t *testing.T) {
 	defer ctrl.Finish()
 
 	event := &types.Event{
+		ID:         "1a2b",
 		Qr:         "Q",
 		Rcode:      "No Error",
 		QType:      "A",
@@ -148,7 +149,7 @@ func TestRequestEventHandler(t *testing.T) {
 	// Advanced metrics.
 	mockEnricher := enricher.NewMockEnricherInterface(ctrl)
 	mockEnricher.EXPECT().Write(EventMatched(
-		utils.DNSType_QUERY, 0, event.DNSName, []string{event.QType}, 0, []string{},
+		utils.DNSType_QUERY, 0, event.DNSName, []string{event.QType}, 0, []string{}, 0x1a2b,
 	)).Times(1)
 	d.enricher = mockEnricher
 
@@ -198,7 +199,7 @@ func TestResponseEventHandler(t *testing.T) {
 	// Advanced metrics.
 	mockEnricher := enricher.NewMockEnricherInterface(ctrl)
 	mockEnricher.EXPECT().Write(EventMatched(
-		utils.DNSType_RESPONSE, 0, event.DNSName, []string{event.QType}, 2, []string{"1.1.1.1", "2.2.2.2"},
+		utils.DNSType_RESPONSE, 0, event.DNSName, []string{event.QType}, 2, []string{"1.1.1.1", "2.2.2.2"}, 0,
 	)).Times(1)
 	d.enricher = mockEnricher
 
@@ -223,6 +224,7 @@ type EventMatcher struct {
 	qTypes     []string
 	numAnswers uint32
 	ips        []string
+	id         uint32
 }
 
 func (m *EventMatcher) Matches(x interface{}) bool {
@@ -234,14 +236,15 @@ func (m *EventMatcher) Matches(x interface{}) bool {
 		reflect.DeepEqual(expectedDNS.GetIps(), m.ips) &&
 		reflect.DeepEqual(expectedDNS.GetQtypes(), m.qTypes) &&
 		expectedDNSType == m.qType &&
-		expectedNumResponses == m.numAnswers
+		expectedNumResponses == m.numAnswers &&
+		utils.GetDNSID(inputFlow) == m.id
 }
 
 func (m *EventMatcher) String() string {
 	return "is anything"
 }
 
-func EventMatched(qType utils.DNSType, rCode uint32, query string, qTypes []string, numAnswers uint32, ips []string) gomock.Matcher {
+func EventMatched(qType utils.DNSType, rCode uint32, query string, qTypes []string, numAnswers uint32, ips []string, id uint32) gomock.Matcher {
 	return &EventMatcher{
 		qType:      qType,
 		rCode:      rCode,
@@ -249,5 +252,6 @@ func EventMatched(qType utils.DNSType, rCode uint32, query string, qTypes []stri
 		qTypes:     qTypes,
 		numAnswers: numAnswers,
 		ips:        ips,
+		id:         id,
 	}
 }
@@ -14,6 +14,18 @@
 
 char __license[] SEC("license") = "Dual MIT/GPL";
 
+#ifndef ADVANCED_METRICS
+#define ADVANCED_METRICS 0
+#endif
+#ifndef BYPASS_LOOKUP_IP_OF_INTEREST
+#define BYPASS_LOOKUP_IP_OF_INTEREST 0
+#endif
+
+// Settings of the program.
+// The loader rewrites the constants in the precompiled objects, the objects compiled at runtime use dynamic.h.
+volatile const __u8 advanced_metrics = ADVANCED_METRICS;
+volatile const __u8 bypass_lookup_ip_of_interest = BYPASS_LOOKUP_IP_OF_INTEREST;
+
 #define ETH_P_IP 0x0800
 #define ETH_P_IPV6 0x86DD
 #define ETH_P_8021Q 0x8100
@@ -129,16 +141,12 @@ void update_metrics_map(void *ctx, drop_reason_t drop_type, int ret_val, struct
         bpf_map_update_elem(&retina_dropreason_metrics, &key, &new_entry, 0);
     }
 // parse packet if advanced metrics are enabled
-#ifdef ADVANCED_METRICS
-#if ADVANCED_METRICS == 1
-    if (p->in_filtermap)
+    if (advanced_metrics && p->in_filtermap)
     {
         p->drop_type = drop_type;
         p->return_val = ret_val;
         bpf_perf_event_output(ctx, &retina_dropreason_events, BPF_F_CURRENT_CPU, p, sizeof(struct packet));
     };
-#endif
-#endif
 }
 
 static void get_packet_from_skb(struct packet *p, struct sk_buff *skb)
@@ -152,8 +160,9 @@ static void get_packet_from_skb(struct packet *p, struct sk_buff *skb)
     member_read(&skb_len, skb, len);
     p->skb_len = skb_len;
 
-#ifdef ADVANCED_METRICS
-#if ADVANCED_METRICS == 1
+    if (!advanced_metrics)
+        return;
+
     char *head;
     __u16 nw_header, trans_header, eth_proto;
 
@@ -167,12 +176,8 @@ static void get_packet_from_skb(struct packet *p, struct sk_buff *skb)
     bpf_probe_read(&iphdr, sizeof(iphdr), ip_header_address);
 
     // Check if the packet is of interest.
-    #ifdef BYPASS_LOOKUP_IP_OF_INTEREST
-	#if BYPASS_LOOKUP_IP_OF_INTEREST == 0
-        if (!lookup(iphdr.saddr) && !lookup(iphdr.daddr))
-            return;
-    #endif
-	#endif
+    if (!bypass_lookup_ip_of_interest && !lookup(iphdr.saddr) && !lookup(iphdr.daddr))
+        return;
     
     p->in_filtermap = true;
     p->src_ip = iphdr.saddr;
@@ -198,8 +203,6 @@ static void get_packet_from_skb(struct packet *p, struct sk_buff *skb)
         p->dst_port = bpf_htons(udphdr.dest);
         p->proto = iphdr.protocol;
     }
-#endif
-#endif
 }
 
 static void get_packet_from_sock(struct packet *p, struct sock *sk)
@@ -215,12 +218,8 @@ static void get_packet_from_sock(struct packet *p, struct sock *sk)
     BPF_CORE_READ_INTO(&dport, sk, __sk_common.skc_dport);
     BPF_CORE_READ_INTO(&sport, sk, __sk_common.skc_num);
     // Check if the packet is of interest.
-    #ifdef BYPASS_LOOKUP_IP_OF_INTEREST
-	#if BYPASS_LOOKUP_IP_OF_INTEREST == 0
-        if (!lookup(saddr) && !lookup(daddr))
-            return;
-    #endif
-	#endif
+    if (!bypass_lookup_ip_of_interest && !lookup(saddr) && !lookup(daddr))
+        return;
 
     // get current timestamp in ns
     p->ts = bpf_ktime_get_boot_ns();
@@ -358,11 +357,8 @@ int BPF_KRETPROBE(inet_csk_accept_ret, struct sock *sk)
     p.in_filtermap = false;
     p.skb_len = 0;
 
-#ifdef ADVANCED_METRICS
-#if ADVANCED_METRICS == 1
-    get_packet_from_sock(&p, sk);
-#endif
-#endif
+    if (advanced_metrics)
+        get_packet_from_sock(&p, sk);
 
     update_metrics_map(ctx, TCP_ACCEPT_BASIC, err, &p);
     return 0;
@@ -459,3 +455,38 @@ int BPF_KRETPROBE(nf_conntrack_confirm_ret, int retVal)
     update_metrics_map(ctx, CONNTRACK_ADD_DROP, retVal, p);
     return 0;
 }
+
+// Ref: include/trace/events/skb.h of the kernel.
+// The reason was added in Linux 5.17, and the fields moved in later versions, which CO-RE relocates.
+struct trace_event_raw_kfree_skb___reason
+{
+    void *skbaddr;
+    void *location;
+    unsigned short protocol;
+    int reason;
+} __attribute__((preserve_access_index));
+
+SEC("tracepoint/skb/kfree_skb")
+int kfree_skb(struct trace_event_raw_kfree_skb___reason *ctx)
+{
+    if (!bpf_core_field_exists(ctx->reason))
+        return 0;
+
+    struct sk_buff *skb = ctx->skbaddr;
+    if (!skb)
+        return 0;
+
+    struct packet p;
+    __builtin_memset(&p, 0, sizeof(p));
+    if (ctx->protocol == bpf_htons(ETH_P_IP))
+    {
+        get_packet_from_skb(&p, skb);
+    }
+    else
+    {
+        member_read(&p.skb_len, skb, len);
+    }
+
+    update_metrics_map(ctx, KFREE_SKB_DROP, ctx->reason, &p);
+    return 0;
+}
@@ -12,6 +12,9 @@ typedef enum
     TCP_CLOSE_BASIC,
     CONNTRACK_ADD_DROP,
     UNKNOWN_DROP,
+    // Drop reported by the skb:kfree_skb tracepoint. The return value is the drop reason of the kernel,
+    // which user space maps to the name of the value in the enum skb_drop_reason of the kernel.
+    KFREE_SKB_DROP,
 } drop_reason_t;
 
 #define NF_DROP 0
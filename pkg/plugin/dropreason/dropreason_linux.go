@@ -62,7 +62,32 @@ func (dr *dropReason) Name() string {
 	return name
 }
 
+// constants returns the settings of the eBPF program, rewritten in its volatile const globals.
+func (dr *dropReason) constants() map[string]interface{} {
+	consts := map[string]interface{}{
+		"advanced_metrics":             uint8(0),
+		"bypass_lookup_ip_of_interest": uint8(0),
+	}
+	if dr.cfg.EnablePodLevel {
+		consts["advanced_metrics"] = uint8(1)
+	}
+	if dr.cfg.BypassLookupIPOfInterest {
+		consts["bypass_lookup_ip_of_interest"] = uint8(1)
+	}
+	return consts
+}
+
+// Generate uses the object precompiled with the agent, with the settings of the plugin rewritten in its constants.
+// If the precompiled object cannot be used, it generates the dynamic header to compile the eBPF program at runtime.
 func (dr *dropReason) Generate(ctx context.Context) error {
+	var err error
+	dr.spec, err = loader.LoadPrecompiled(loadPrecompiled, dr.constants())
+	if err == nil {
+		dr.l.Info("Using precompiled DropReason object")
+		return nil
+	}
+	dr.l.Warn("Cannot use precompiled DropReason object, falling back to runtime compilation", zap.Error(err))
+
 	// Get absolute path to this file during runtime.
 	_, filename, _, ok := runtime.Caller(0)
 	if !ok {
@@ -81,7 +106,7 @@ func (dr *dropReason) Generate(ctx context.Context) error {
 		j = 1
 	}
 	st := fmt.Sprintf("#define ADVANCED_METRICS %d \n#define BYPASS_LOOKUP_IP_OF_INTEREST %d \n", i, j)
-	err := loader.WriteFile(ctx, dynamicHeaderPath, st)
+	err = loader.WriteFile(ctx, dynamicHeaderPath, st)
 	if err != nil {
 		dr.l.Error("Error writing dynamic header", zap.Error(err))
 		return err
@@ -93,6 +118,11 @@ func (dr *dropReason) Generate(ctx context.Context) error {
 
 // Compile should be able to compile the eBPF source code during runtime.
 func (dr *dropReason) Compile(ctx context.Context) error {
+	// The precompiled object is used, there is nothing to compile.
+	if dr.spec != nil {
+		return nil
+	}
+
 	// Get the absolute path to this file during runtime.
 	dir, err := absPath()
 	if err != nil {
@@ -121,19 +151,27 @@ func (dr *dropReason) Compile(ctx context.Context) error {
 
 func (dr *dropReason) Init() error {
 	var err error
-	// Get the absolute path to this file during runtime.
-	dir, err := absPath()
-	if err != nil {
-		return err
-	}
+	spec := dr.spec
+	if spec == nil {
+		// Load the object compiled at runtime.
+		// Get the absolute path to this file during runtime.
+		var dir string
+		dir, err = absPath()
+		if err != nil {
+			return err
+		}
 
-	bpfOutputFile := fmt.Sprintf("%s/%s", dir, bpfObjectFileName)
+		bpfOutputFile := fmt.Sprintf("%s/%s", dir, bpfObjectFileName)
+		spec, err = ebpf.LoadCollectionSpec(bpfOutputFile)
+		if err != nil {
+			return err
+		}
+		if err = spec.RewriteConstants(dr.constants()); err != nil {
+			return errors.Wrap(err, "failed to rewrite constants")
+		}
+	}
 
 	objs := &kprobeObjects{} //nolint:typecheck
-	spec, err := ebpf.LoadCollectionSpec(bpfOutputFile)
-	if err != nil {
-		return err
-	}
 
 	// TODO remove the opts
 	if err := spec.LoadAndAssign(objs, &ebpf.CollectionOptions{
@@ -155,31 +193,47 @@ func (dr *dropReason) Init() error {
 		return err
 	}
 
-	dr.KNfHook, err = link.Kprobe(nfHookSlowFn, objs.NfHookSlow, nil)
-	if err != nil {
-		dr.l.Error("opening kprobe: %w", zap.Error(err))
+	if dr.cfg.DropReasonMode == kcfg.DropReasonModeTracepoint {
+		// The drops of netfilter are reported by skb:kfree_skb with the NETFILTER_DROP reason.
+		if err := dr.attachKfreeSkb(objs); err != nil {
+			return err
+		}
+	} else if err := dr.attachNetfilterKprobes(objs); err != nil {
 		return err
 	}
 
-	dr.KRetnfhook, err = link.Kretprobe(nfHookSlowFn, objs.NfHookSlowRet, nil)
+	dr.KRetTCPConnect, err = link.Kretprobe(tcpConnectFn, objs.TcpV4ConnectRet, nil)
 	if err != nil {
 		dr.l.Error("opening kretprobe: %w", zap.Error(err))
 		return err
 	}
 
-	dr.KRetTCPConnect, err = link.Kretprobe(tcpConnectFn, objs.TcpV4ConnectRet, nil)
+	dr.KTCPAccept, err = link.Kretprobe(intCskAcceptFn, objs.InetCskAccept, nil)
 	if err != nil {
 		dr.l.Error("opening kretprobe: %w", zap.Error(err))
 		return err
 	}
 
-	dr.KTCPAccept, err = link.Kretprobe(intCskAcceptFn, objs.InetCskAccept, nil)
+	dr.KRetTCPAccept, err = link.Kretprobe(intCskAcceptFn, objs.InetCskAcceptRet, nil)
 	if err != nil {
 		dr.l.Error("opening kretprobe: %w", zap.Error(err))
 		return err
 	}
 
-	dr.KRetTCPAccept, err = link.Kretprobe(intCskAcceptFn, objs.InetCskAcceptRet, nil)
+	dr.metricsMapData = objs.RetinaDropreasonMetrics
+	return nil
+}
+
+// attachNetfilterKprobes attaches the kprobes reporting the drops of netfilter.
+func (dr *dropReason) attachNetfilterKprobes(objs *kprobeObjects) error { //nolint:typecheck
+	var err error
+	dr.KNfHook, err = link.Kprobe(nfHookSlowFn, objs.NfHookSlow, nil)
+	if err != nil {
+		dr.l.Error("opening kprobe: %w", zap.Error(err))
+		return err
+	}
+
+	dr.KRetnfhook, err = link.Kretprobe(nfHookSlowFn, objs.NfHookSlowRet, nil)
 	if err != nil {
 		dr.l.Error("opening kretprobe: %w", zap.Error(err))
 		return err
@@ -229,7 +283,25 @@ func (dr *dropReason) Init() error {
 		}
 	}
 
-	dr.metricsMapData = objs.RetinaDropreasonMetrics
+	return nil
+}
+
+// attachKfreeSkb attaches to the skb:kfree_skb tracepoint, which reports the packets dropped by the kernel with the
+// drop reason of the kernel.
+func (dr *dropReason) attachKfreeSkb(objs *kprobeObjects) error { //nolint:typecheck
+	var err error
+	dr.kernelDropReasons, err = loadKernelDropReasons()
+	if err != nil {
+		dr.l.Error("Error loading the drop reasons of the kernel", zap.Error(err))
+		return err
+	}
+
+	dr.KKfreeSkb, err = link.Tracepoint(kfreeSkbTracepointGroup, kfreeSkbTracepointName, objs.KfreeSkb, nil)
+	if err != nil {
+		dr.l.Error("opening tracepoint: %w", zap.Error(err))
+		return err
+	}
+	dr.l.Info("Attached to the skb:kfree_skb tracepoint", zap.Int("kernel drop reasons", len(dr.kernelDropReasons)))
 	return nil
 }
 
@@ -309,9 +381,11 @@ func (dr *dropReason) readBasicMetricsData(ctx context.Context) {
 			if err := iter.Err(); err != nil {
 				dr.l.Error("Error while reading metrics map...", zap.String("iter error", err.Error()))
 			}
+			totals := make(map[dropMetricLabels]dropMetricTotal)
 			for iter.Next(&dataKey, &dataValue) {
-				dr.processMapValue(dataKey, dataValue)
+				dr.processMapValue(dataKey, dataValue, totals)
 			}
+			dr.updateDropMetrics(totals)
 
 			// TODO manage deletiong of old entries
 			// If we start deleting keys, we need to change the metric add logic in DropMetricAdd
@@ -377,7 +451,7 @@ func (dr *dropReason) processRecord(ctx context.Context, id int) {
 			meta := &utils.RetinaMetadata{}
 
 			// Add drop reason to the flow's metadata.
-			utils.AddDropReason(fl, meta, bpfEvent.DropType)
+			utils.AddDropReason(fl, meta, uint16(dr.dropReason(bpfEvent.DropType, bpfEvent.ReturnVal)))
 
 			// Add packet size to the flow's metadata.
 			utils.AddPacketSize(meta, bpfEvent.SkbLen)
@@ -440,15 +514,31 @@ func (dr *dropReason) readEventArrayData() error {
 	return nil
 }
 
-func (dr *dropReason) processMapValue(dataKey dropMetricKey, dataValue dropMetricValues) {
+// processMapValue adds the drops of an entry of the metrics map to the totals of its labels. Several entries can have
+// the same labels, e.g. the failures of TCP connect with different errors, or the drop reasons of the kernel unknown
+// to Retina.
+func (dr *dropReason) processMapValue(dataKey dropMetricKey, dataValue dropMetricValues, totals map[dropMetricLabels]dropMetricTotal) {
 	pktCount, pktBytes := dataValue.getPktCountAndBytes()
+	labels := dropMetricLabels{
+		reason:    dr.dropReason(dataKey.DropType, dataKey.ReturnVal).String(),
+		direction: dataKey.getDirection(),
+	}
 
-	dr.l.Debug("DATA From the DropReason Map", zap.String("Droptype", dataKey.getType()),
+	dr.l.Debug("DATA From the DropReason Map", zap.String("Droptype", labels.reason),
 		zap.Uint32("Return Val", dataKey.ReturnVal),
 		zap.Int("DropCount", int(pktCount)),
 		zap.Int("DropBytes", int(pktBytes)))
 
-	dr.dropMetricAdd(dataKey.getType(), dataKey.getDirection(), pktCount, pktBytes)
+	total := totals[labels]
+	total.count += pktCount
+	total.bytes += pktBytes
+	totals[labels] = total
+}
+
+func (dr *dropReason) updateDropMetrics(totals map[dropMetricLabels]dropMetricTotal) {
+	for labels, total := range totals {
+		dr.dropMetricAdd(labels.reason, labels.direction, total.count, total.bytes)
+	}
 }
 
 func (dr *dropReason) Stop() error {
@@ -471,6 +561,9 @@ func (dr *dropReason) Stop() error {
 	if dr.KTCPAccept != nil {
 		dr.KTCPAccept.Close()
 	}
+	if dr.KKfreeSkb != nil {
+		dr.KKfreeSkb.Close()
+	}
 	if dr.metricsMapData != nil {
 		dr.metricsMapData.Close()
 	}
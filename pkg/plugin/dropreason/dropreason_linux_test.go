@@ -14,12 +14,15 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/btf"
 	"github.com/cilium/ebpf/perf"
 	kcfg "github.com/microsoft/retina/pkg/config"
 	"github.com/microsoft/retina/pkg/enricher"
 	"github.com/microsoft/retina/pkg/log"
 	"github.com/microsoft/retina/pkg/metrics"
 	mocks "github.com/microsoft/retina/pkg/plugin/dropreason/mocks"
+	"github.com/microsoft/retina/pkg/utils"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -86,10 +89,31 @@ func TestShutdown(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestPrecompiled checks that the objects embedded in the agent declare all the settings of the plugin, so that it
+// does not need to compile the eBPF program at runtime.
+func TestPrecompiled(t *testing.T) {
+	for _, cfg := range []*kcfg.Config{cfgPodLevelEnabled, cfgPodLevelDisabled} {
+		dr := &dropReason{cfg: cfg}
+		for _, obj := range []string{"kprobe_bpfel_x86.o", "kprobe_bpfel_arm64.o"} {
+			spec, err := ebpf.LoadCollectionSpec(obj)
+			require.NoError(t, err, obj)
+			require.NotEmpty(t, spec.Programs, obj)
+			require.NoError(t, spec.RewriteConstants(dr.constants()), obj)
+		}
+	}
+}
+
 func TestCompile(t *testing.T) {
 	takeBackup()
 	defer restoreBackup()
 
+	loadPrecompiled = func() (*ebpf.CollectionSpec, error) {
+		return nil, errors.New("no precompiled object")
+	}
+	defer func() {
+		loadPrecompiled = loadKprobe
+	}()
+
 	log.SetupZapLogger(log.GetDefaultLogOpts())
 	p := &dropReason{
 		cfg: cfgPodLevelEnabled,
@@ -128,7 +152,9 @@ func TestProcessMapValue(t *testing.T) {
 	testMetricKey := dropMetricKey{DropType: 1, ReturnVal: 2}
 	testMetricValues := dropMetricValues{{Count: 10, Bytes: 100}}
 
-	dr.processMapValue(testMetricKey, testMetricValues)
+	totals := make(map[dropMetricLabels]dropMetricTotal)
+	dr.processMapValue(testMetricKey, testMetricValues, totals)
+	dr.updateDropMetrics(totals)
 
 	// check if the metrics are updated
 	reason := testMetricKey.getType()
@@ -149,6 +175,52 @@ func TestProcessMapValue(t *testing.T) {
 	require.Equal(t, float64(testMetricValues[0].Bytes), dropBytesValue, "Expected drop bytes to be %d but got %d", float64(testMetricValues[0].Bytes), dropBytesValue)
 }
 
+func TestProcessMapValue_KfreeSkb(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	metrics.InitializeMetrics()
+	dr := &dropReason{
+		cfg: cfgPodLevelEnabled,
+		l:   log.Logger().Named(name),
+		kernelDropReasons: kernelDropReasonsFromEnum(&btf.Enum{
+			Name: skbDropReasonEnum,
+			Values: []btf.EnumValue{
+				{Name: "SKB_DROP_REASON_NO_SOCKET", Value: 2},
+				{Name: "SKB_DROP_REASON_SOMETHING_NEW", Value: 99},
+			},
+		}),
+	}
+
+	totals := make(map[dropMetricLabels]dropMetricTotal)
+	dr.processMapValue(dropMetricKey{DropType: kfreeSkbDrop, ReturnVal: 2}, dropMetricValues{{Count: 1, Bytes: 10}}, totals)
+	// The drop reasons unknown to Retina are summed up as UNKNOWN_DROP.
+	dr.processMapValue(dropMetricKey{DropType: kfreeSkbDrop, ReturnVal: 99}, dropMetricValues{{Count: 2, Bytes: 20}}, totals)
+	dr.processMapValue(dropMetricKey{DropType: kfreeSkbDrop, ReturnVal: 1000}, dropMetricValues{{Count: 3, Bytes: 30}}, totals)
+	dr.updateDropMetrics(totals)
+
+	for reason, expected := range map[string]float64{
+		utils.DropReason_SKB_DROP_REASON_NO_SOCKET.String(): 1,
+		utils.DropReason_UNKNOWN_DROP.String():              5,
+	} {
+		dropCount := &dto.Metric{}
+		err := metrics.DropPacketsGauge.WithLabelValues(reason, "unknown").Write(dropCount)
+		require.Nil(t, err, "Expected no error but got: %w", err)
+		require.Equal(t, expected, *dropCount.Gauge.Value, "Unexpected drop count for %s", reason)
+	}
+}
+
+func TestDropReason(t *testing.T) {
+	dr := &dropReason{
+		kernelDropReasons: kernelDropReasonsFromEnum(&btf.Enum{
+			Name:   skbDropReasonEnum,
+			Values: []btf.EnumValue{{Name: "SKB_DROP_REASON_NETFILTER_DROP", Value: 12}},
+		}),
+	}
+
+	require.Equal(t, utils.DropReason_IPTABLE_RULE_DROP, dr.dropReason(uint16(utils.DropReason_IPTABLE_RULE_DROP), 0))
+	require.Equal(t, utils.DropReason_SKB_DROP_REASON_NETFILTER_DROP, dr.dropReason(kfreeSkbDrop, 12))
+	require.Equal(t, utils.DropReason_UNKNOWN_DROP, dr.dropReason(kfreeSkbDrop, 13))
+}
+
 func TestDropReasonRun_Error(t *testing.T) {
 	log.SetupZapLogger(log.GetDefaultLogOpts())
 	ctrl := gomock.NewController(t)
@@ -431,6 +503,14 @@ func TestDropReasonGenerate(t *testing.T) {
 	takeBackup()
 	defer restoreBackup()
 
+	// The dynamic header is generated when the precompiled object cannot be used.
+	loadPrecompiled = func() (*ebpf.CollectionSpec, error) {
+		return &ebpf.CollectionSpec{}, nil
+	}
+	defer func() {
+		loadPrecompiled = loadKprobe
+	}()
+
 	log.SetupZapLogger(log.GetDefaultLogOpts())
 	// Get the directory of the current test file.
 	_, filename, _, ok := runtime.Caller(0)
@@ -451,6 +531,7 @@ func TestDropReasonGenerate(t *testing.T) {
 	if err := dr.Generate(ctx); err != nil {
 		t.Fatalf("failed to generate DropReason header: %v", err)
 	}
+	require.Nil(t, dr.spec)
 
 	// Verify that the dynamic header file was created in the expected location and contains the expected contents.
 	if _, err := os.Stat(dynamicHeaderPath); os.IsNotExist(err) {
@@ -0,0 +1,58 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dropreason
+
+import (
+	"github.com/cilium/ebpf/btf"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	kfreeSkbTracepointGroup = "skb"
+	kfreeSkbTracepointName  = "kfree_skb"
+	skbDropReasonEnum       = "skb_drop_reason"
+)
+
+// loadKernelDropReasons maps the values of the enum skb_drop_reason of the running kernel to the Retina drop reasons.
+// The values differ between kernel versions, so they are mapped by name from the BTF of the kernel.
+func loadKernelDropReasons() (map[uint32]utils.DropReason, error) {
+	spec, err := btf.LoadKernelSpec()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the BTF of the kernel")
+	}
+
+	var enum *btf.Enum
+	if err := spec.TypeByName(skbDropReasonEnum, &enum); err != nil {
+		return nil, errors.Wrap(err, "the kernel does not report drop reasons in skb:kfree_skb, which requires Linux 5.17 or later")
+	}
+	return kernelDropReasonsFromEnum(enum), nil
+}
+
+// kernelDropReasonsFromEnum maps the values of the enum skb_drop_reason to the Retina drop reason of the same name.
+// The reasons unknown to Retina, e.g. added by a newer kernel, are mapped to UNKNOWN_DROP.
+func kernelDropReasonsFromEnum(enum *btf.Enum) map[uint32]utils.DropReason {
+	reasons := make(map[uint32]utils.DropReason, len(enum.Values))
+	for _, v := range enum.Values {
+		reason, ok := utils.DropReason_value[v.Name]
+		if !ok {
+			reason = int32(utils.DropReason_UNKNOWN_DROP)
+		}
+		reasons[uint32(v.Value)] = utils.DropReason(reason)
+	}
+	return reasons
+}
+
+// dropReason returns the Retina drop reason of the drop type and return value of the eBPF program. The return value
+// of the drops of skb:kfree_skb is the drop reason of the kernel.
+func (dr *dropReason) dropReason(dropType uint16, returnVal uint32) utils.DropReason {
+	if dropType != kfreeSkbDrop {
+		return utils.DropReason(dropType)
+	}
+	if reason, ok := dr.kernelDropReasons[returnVal]; ok {
+		return reason
+	}
+	// e.g. the drop reasons of a subsystem, which are not in the enum skb_drop_reason.
+	return utils.DropReason_UNKNOWN_DROP
+}
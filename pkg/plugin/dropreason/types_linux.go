@@ -23,11 +23,19 @@ const (
 	dynamicHeaderFileName string = "dynamic.h"
 	buffer                int    = 10000
 	workers               int    = 2
+
+	// kfreeSkbDrop is the drop type of the drops of the skb:kfree_skb tracepoint.
+	// Ref: pkg/plugin/dropreason/_cprog/drop_reason.h.
+	kfreeSkbDrop uint16 = 7
 )
 
-// Determined via testing on a large cluster.
-// Actual buffer size will be 16 * pagesize.
-var perCPUBuffer = 16
+var (
+	// Determined via testing on a large cluster.
+	// Actual buffer size will be 16 * pagesize.
+	perCPUBuffer = 16
+
+	loadPrecompiled = loadKprobe //nolint:typecheck
+)
 
 type dropReason struct {
 	cfg                    *kcfg.Config
@@ -41,6 +49,7 @@ type dropReason struct {
 	KRetNfNatInet          link.Link
 	KNfConntrackConfirm    link.Link
 	KRetNfConntrackConfirm link.Link
+	KKfreeSkb              link.Link
 	metricsMapData         IMap
 	isRunning              bool
 	reader                 IPerfReader
@@ -48,6 +57,11 @@ type dropReason struct {
 	recordsChannel         chan perf.Record
 	wg                     sync.WaitGroup
 	externalChannel        chan *hubblev1.Event
+	// kernelDropReasons maps the drop reasons of the kernel to the Retina drop reasons.
+	kernelDropReasons map[uint32]utils.DropReason
+	// spec is the spec of the precompiled object with the settings of the plugin, nil if the object must be compiled
+	// at runtime.
+	spec *ebpf.CollectionSpec
 }
 
 type (
@@ -73,6 +87,18 @@ type IPerfReader interface {
 	Close() error
 }
 
+// dropMetricLabels are the labels of the basic drop metrics.
+type dropMetricLabels struct {
+	reason    string
+	direction string
+}
+
+// dropMetricTotal is the number of packets and bytes dropped with the same labels.
+type dropMetricTotal struct {
+	count float64
+	bytes float64
+}
+
 //lint:ignore
 type dropMetricKey kprobeMetricsMapKey //nolint:typecheck
 
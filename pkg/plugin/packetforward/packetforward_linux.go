@@ -98,14 +98,25 @@ func (p *packetForward) Name() string {
 	return name
 }
 
+// Generate uses the object precompiled with the agent, the program has no settings to rewrite.
+// If the precompiled object cannot be used, the eBPF program is compiled at runtime.
 func (p *packetForward) Generate(context.Context) error {
-	// Use this function to parse p and generate header files under cprog.
-	// Example: https://github.com/anubhabMajumdar/Retina/blob/c4bc06e7f922124f92536ffb5312bada5c2dfe99/pkg/plugin/custom/packetforward/packetforward.go#L77
-	p.l.Info("Packet forwarding metric header generated")
+	var err error
+	p.spec, err = loader.LoadPrecompiled(loadPrecompiled, nil)
+	if err != nil {
+		p.l.Warn("Cannot use precompiled packet forwarding object, falling back to runtime compilation", zap.Error(err))
+		return nil
+	}
+	p.l.Info("Using precompiled packet forwarding object")
 	return nil
 }
 
 func (p *packetForward) Compile(ctx context.Context) error {
+	// The precompiled object is used, there is nothing to compile.
+	if p.spec != nil {
+		return nil
+	}
+
 	// Get the absolute path to this file during runtime.
 	dir, err := absPath()
 	if err != nil {
@@ -134,20 +145,26 @@ func (p *packetForward) Compile(ctx context.Context) error {
 }
 
 func (p *packetForward) Init() error {
-	// Get the absolute path to this file during runtime.
-	dir, err := absPath()
-	if err != nil {
-		return err
-	}
+	var err error
+	spec := p.spec
+	if spec == nil {
+		// Load the object compiled at runtime.
+		// Get the absolute path to this file during runtime.
+		var dir string
+		dir, err = absPath()
+		if err != nil {
+			return err
+		}
 
-	bpfOutputFile := fmt.Sprintf("%s/%s", dir, bpfObjectFileName)
+		bpfOutputFile := fmt.Sprintf("%s/%s", dir, bpfObjectFileName)
+		spec, err = ebpf.LoadCollectionSpec(bpfOutputFile)
+		if err != nil {
+			p.l.Error("Error loading collection specs: %w", zap.Error(err))
+			return err
+		}
+	}
 
 	objs := &packetforwardObjects{} //nolint:typecheck
-	spec, err := ebpf.LoadCollectionSpec(bpfOutputFile)
-	if err != nil {
-		p.l.Error("Error loading collection specs: %w", zap.Error(err))
-		return err
-	}
 
 	if err := spec.LoadAndAssign(objs, nil); err != nil {
 		p.l.Error("Error assigning specs: %w", zap.Error(err))
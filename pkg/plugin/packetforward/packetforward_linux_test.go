@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cilium/ebpf"
 	kcfg "github.com/microsoft/retina/pkg/config"
 
 	"github.com/microsoft/retina/pkg/log"
@@ -133,6 +134,17 @@ func TestStop_NonNilMap(t *testing.T) {
 	}
 }
 
+// TestPrecompiled checks that the objects embedded in the agent can be loaded, so that the plugin does not need to
+// compile the eBPF program at runtime.
+func TestPrecompiled(t *testing.T) {
+	for _, obj := range []string{"packetforward_bpfel_x86.o", "packetforward_bpfel_arm64.o"} {
+		spec, err := ebpf.LoadCollectionSpec(obj)
+		require.NoError(t, err, obj)
+		require.NotEmpty(t, spec.Programs, obj)
+		require.NoError(t, spec.RewriteConstants(nil), obj)
+	}
+}
+
 func TestCompile(t *testing.T) {
 	log.SetupZapLogger(log.GetDefaultLogOpts())
 	p := &packetForward{
@@ -5,6 +5,7 @@ package packetforward
 import (
 	"fmt"
 
+	"github.com/cilium/ebpf"
 	kcfg "github.com/microsoft/retina/pkg/config"
 
 	"github.com/microsoft/retina/pkg/log"
@@ -24,6 +25,8 @@ const (
 	dynamicHeaderFileName     string = "dynamic.h"
 )
 
+var loadPrecompiled = loadPacketforward //nolint:typecheck
+
 // Interface to https://pkg.go.dev/github.com/cilium/ebpf#Map.
 // Added for unit tests.
 //
@@ -39,6 +42,8 @@ type packetForward struct {
 	hashmapData IMap
 	sock        int
 	isRunning   bool
+	// spec is the spec of the precompiled object, nil if the object must be compiled at runtime.
+	spec *ebpf.CollectionSpec
 }
 
 type PacketForwardData struct {
@@ -14,12 +14,38 @@
 
 char __license[] SEC("license") = "Dual MIT/GPL";
 
+#ifndef BYPASS_LOOKUP_IP_OF_INTEREST
+#define BYPASS_LOOKUP_IP_OF_INTEREST 0
+#endif
+#ifndef ENABLE_HTTP_PARSER
+#define ENABLE_HTTP_PARSER 0
+#endif
+#ifndef DATA_AGGREGATION_LEVEL
+#define DATA_AGGREGATION_LEVEL DATA_AGGREGATION_LEVEL_LOW
+#endif
+
+// Settings of the program.
+// The loader rewrites the constants in the precompiled objects, the objects compiled at runtime use dynamic.h.
+volatile const __u8 bypass_lookup_ip_of_interest = BYPASS_LOOKUP_IP_OF_INTEREST;
+volatile const __u8 enable_http_parser = ENABLE_HTTP_PARSER;
+volatile const __u8 data_aggregation_level = DATA_AGGREGATION_LEVEL;
+
 struct
 {
 	__uint(type, BPF_MAP_TYPE_PERF_EVENT_ARRAY);
 	__uint(max_entries, 16384);
 } retina_packetparser_events SEC(".maps");
 
+// Direction of the last packet with a payload of the TCP connections, keyed by the send direction of the connection.
+// Used to only append the first packet of each HTTP request and response to the events.
+struct
+{
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__type(key, struct ct_v4_key);
+	__type(value, __u8);
+	__uint(max_entries, HTTP_CONNECTIONS_MAP_SIZE);
+} retina_packetparser_payload_dir SEC(".maps");
+
 // Define const variables to avoid warnings.
 const struct packet *unused __attribute__((unused));
 
@@ -114,6 +140,121 @@ static int parse_tcp_ts(struct tcphdr *tcph, void *data_end, __u32 *tsval, __u32
 	return -1;
 }
 
+/*
+ * Decapsulates the VXLAN, Geneve and IPIP packets. If the packet is encapsulated
+ * and the inner IPv4 header is within the packet, the outer IPs and the VNI are
+ * stored in the tunnel metadata of p.
+ * Returns the inner IPv4 header of the encapsulated packets, and ip otherwise.
+ * Like parse, assumes IPv4 headers without options.
+ */
+static __always_inline struct iphdr *parse_tunnel(struct iphdr *ip, void *data_end, struct packet *p)
+{
+	void *inner;
+	__u8 tunnel_type;
+	__u32 vni = 0;
+
+	if (ip->protocol == IPPROTO_IPIP) {
+		tunnel_type = TUNNEL_TYPE_IPIP;
+		inner = (void *)ip + sizeof(struct iphdr);
+	} else if (ip->protocol == IPPROTO_UDP) {
+		struct udphdr *udp = (void *)ip + sizeof(struct iphdr);
+		if ((void *)udp + sizeof(struct udphdr) > data_end)
+			return ip;
+
+		struct ethhdr *eth;
+		__u16 dport = bpf_ntohs(udp->dest);
+		if (dport == VXLAN_PORT || dport == VXLAN_LINUX_PORT) {
+			struct vxlan_header *vxlan = (void *)udp + sizeof(struct udphdr);
+			if ((void *)vxlan + sizeof(struct vxlan_header) > data_end)
+				return ip;
+			if (!(vxlan->flags & VXLAN_FLAG_VNI))
+				return ip;
+			tunnel_type = TUNNEL_TYPE_VXLAN;
+			vni = (vxlan->vni[0] << 16) | (vxlan->vni[1] << 8) | vxlan->vni[2];
+			eth = (void *)vxlan + sizeof(struct vxlan_header);
+		} else if (dport == GENEVE_PORT) {
+			struct geneve_header *geneve = (void *)udp + sizeof(struct udphdr);
+			if ((void *)geneve + sizeof(struct geneve_header) > data_end)
+				return ip;
+			if (bpf_ntohs(geneve->protocol_type) != ETH_P_TEB)
+				return ip;
+			tunnel_type = TUNNEL_TYPE_GENEVE;
+			vni = (geneve->vni[0] << 16) | (geneve->vni[1] << 8) | geneve->vni[2];
+			// Skip the options, at most 63 4-byte words.
+			__u32 opt_len = (geneve->ver_opt_len & 0x3f) << 2;
+			eth = (void *)geneve + sizeof(struct geneve_header) + opt_len;
+		} else {
+			return ip;
+		}
+
+		if ((void *)eth + sizeof(struct ethhdr) > data_end)
+			return ip;
+		if (bpf_ntohs(eth->h_proto) != ETH_P_IP)
+			return ip;
+		inner = (void *)eth + sizeof(struct ethhdr);
+	} else {
+		return ip;
+	}
+
+	if (inner + sizeof(struct iphdr) > data_end)
+		return ip;
+
+	p->tunnel_metadata.src_ip = ip->saddr;
+	p->tunnel_metadata.dst_ip = ip->daddr;
+	p->tunnel_metadata.vni = vni;
+	p->tunnel_metadata.tunnel_type = tunnel_type;
+	return inner;
+}
+
+/*
+ * Returns true if the packet carries the first payload of a request or a response, that is
+ * if the previous packet with a payload of the connection was sent in the other direction.
+ * Must be called after ct_process_packet, which sets the direction of the packet in the connection.
+ */
+static __always_inline bool is_first_payload(struct packet *p)
+{
+	struct ct_v4_key key;
+	__builtin_memset(&key, 0, sizeof(key));
+	key.proto = p->proto;
+	if (p->is_reply) {
+		key.src_ip = p->dst_ip;
+		key.dst_ip = p->src_ip;
+		key.src_port = p->dst_port;
+		key.dst_port = p->src_port;
+	} else {
+		key.src_ip = p->src_ip;
+		key.dst_ip = p->dst_ip;
+		key.src_port = p->src_port;
+		key.dst_port = p->dst_port;
+	}
+
+	__u8 dir = p->is_reply ? CT_PACKET_DIR_RX : CT_PACKET_DIR_TX;
+	__u8 *last_dir = bpf_map_lookup_elem(&retina_packetparser_payload_dir, &key);
+	if (last_dir && *last_dir == dir)
+		return false;
+
+	bpf_map_update_elem(&retina_packetparser_payload_dir, &key, &dir, BPF_ANY);
+	return true;
+}
+
+// Returns true if the packet is an ICMP error message, e.g. destination unreachable or time exceeded.
+static __always_inline bool is_icmp_error(struct packet *p)
+{
+	if (p->proto != IPPROTO_ICMP)
+		return false;
+
+	switch (p->icmp_metadata.type)
+	{
+	case ICMP_DEST_UNREACH:
+	case ICMP_REDIRECT:
+	case ICMP_TIME_EXCEEDED:
+	case ICMP_PARAMETERPROB:
+		return true;
+	default:
+		return false;
+	}
+}
+
 // Function to parse the packet and send it to the perf buffer.
 static void parse(struct __sk_buff *skb, __u8 obs)
 {
@@ -143,25 +284,27 @@ static void parse(struct __sk_buff *skb, __u8 obs)
 	if (data + sizeof(struct ethhdr) + sizeof(struct iphdr) > data_end)
 		return;
 
+	// Report the inner packet of the packets encapsulated in an overlay tunnel.
+	ip = parse_tunnel(ip, data_end, &p);
+
 	p.src_ip = ip->saddr;
 	p.dst_ip = ip->daddr;
 	p.proto = ip->protocol;
 
 	// Check if the packet is of interest.
-	#ifdef BYPASS_LOOKUP_IP_OF_INTEREST
-	#if BYPASS_LOOKUP_IP_OF_INTEREST == 0
-		if (!lookup(p.src_ip) && !lookup(p.dst_ip))
-		{
-			return;
-		}
-	#endif
-	#endif
+	if (!bypass_lookup_ip_of_interest && !lookup(p.src_ip) && !lookup(p.dst_ip))
+	{
+		return;
+	}
+
+	// Whether the TCP packet carries a payload.
+	bool has_payload = false;
 
 	// Get source and destination ports.
 	if (ip->protocol == IPPROTO_TCP)
 	{
-		struct tcphdr *tcp = data + sizeof(struct ethhdr) + sizeof(struct iphdr);
-		if (data + sizeof(struct ethhdr) + sizeof(struct iphdr) + sizeof(struct tcphdr) > data_end)
+		struct tcphdr *tcp = (void *)ip + sizeof(struct iphdr);
+		if ((void *)tcp + sizeof(struct tcphdr) > data_end)
 			return;
 
 		p.src_port = tcp->source;
@@ -175,6 +318,8 @@ static void parse(struct __sk_buff *skb, __u8 obs)
 		// Get all TCP flags.
 		p.flags = (tcp->fin << 0) | (tcp->syn << 1) | (tcp->rst << 2) | (tcp->psh << 3) | (tcp->ack << 4) | (tcp->urg << 5) | (tcp->ece << 6) | (tcp->cwr << 7);
 
+		has_payload = bpf_ntohs(ip->tot_len) > (ip->ihl << 2) + (tcp->doff << 2);
+
 		tcp_metadata.seq = tcp->seq;
 		tcp_metadata.ack_num = tcp->ack_seq;
 		p.tcp_metadata = tcp_metadata;
@@ -187,8 +332,8 @@ static void parse(struct __sk_buff *skb, __u8 obs)
 	}
 	else if (ip->protocol == IPPROTO_UDP)
 	{
-		struct udphdr *udp = data + sizeof(struct ethhdr) + sizeof(struct iphdr);
-		if (data + sizeof(struct ethhdr) + sizeof(struct iphdr) + sizeof(struct udphdr) > data_end)
+		struct udphdr *udp = (void *)ip + sizeof(struct iphdr);
+		if ((void *)udp + sizeof(struct udphdr) > data_end)
 			return;
 
 		p.src_port = udp->source;
@@ -196,33 +341,46 @@ static void parse(struct __sk_buff *skb, __u8 obs)
 
 		p.flags = 1;
 	}
+	else if (ip->protocol == IPPROTO_ICMP)
+	{
+		struct icmphdr *icmp = (void *)ip + sizeof(struct iphdr);
+		if ((void *)icmp + sizeof(struct icmphdr) > data_end)
+			return;
+
+		p.icmp_metadata.type = icmp->type;
+		p.icmp_metadata.code = icmp->code;
+	}
 	else
 	{
 		return;
 	}
 
-	#ifdef ENABLE_CONNTRACK_METRICS
+	if (enable_conntrack_metrics) {
 		// Initialize conntrack metadata in packet struct.
 		struct conntrackmetadata conntrack_metadata;
 		__builtin_memset(&conntrack_metadata, 0, sizeof(conntrack_metadata));
 		p.conntrack_metadata = conntrack_metadata;
-	#endif // ENABLE_CONNTRACK_METRICS
+	}
 
-	// Process the packet in ct
-	bool report __attribute__((unused));
-	report = ct_process_packet(&p, obs);
-	#ifdef DATA_AGGREGATION_LEVEL
-	// If the data aggregation level is low, always send the packet to the perf buffer.
-	#if DATA_AGGREGATION_LEVEL == DATA_AGGREGATION_LEVEL_LOW
-		bpf_perf_event_output(skb, &retina_packetparser_events, BPF_F_CURRENT_CPU, &p, sizeof(p));
+	// Process the packet in ct.
+	// ICMP error messages are not tracked, and are always reported. The other ICMP messages, e.g. echo requests and
+	// replies, are tracked like the other packets.
+	bool report = is_icmp_error(&p) || ct_process_packet(&p, obs);
+
+	// Append the beginning of the first packet of each request and response to the event, for the HTTP parser in
+	// user space. The following segments of the same message are not appended.
+	// These packets are always sent, whatever the data aggregation level.
+	if (enable_http_parser && has_payload && is_first_payload(&p)) {
+		__u64 capture_len = skb->len < HTTP_CAPTURE_LEN ? skb->len : HTTP_CAPTURE_LEN;
+		bpf_perf_event_output(skb, &retina_packetparser_events, (capture_len << 32) | BPF_F_CURRENT_CPU, &p, sizeof(p));
 		return;
+	}
+
+	// If the data aggregation level is low, always send the packet to the perf buffer.
 	// If the data aggregation level is high, only send the packet to the perf buffer if it needs to be reported.
-	#elif DATA_AGGREGATION_LEVEL == DATA_AGGREGATION_LEVEL_HIGH
-		if (report) {
-			bpf_perf_event_output(skb, &retina_packetparser_events, BPF_F_CURRENT_CPU, &p, sizeof(p));
-		}
-	#endif
-	#endif
+	if (data_aggregation_level == DATA_AGGREGATION_LEVEL_LOW || report) {
+		bpf_perf_event_output(skb, &retina_packetparser_events, BPF_F_CURRENT_CPU, &p, sizeof(p));
+	}
 	return;
 }
 
@@ -2,6 +2,8 @@
 // Licensed under the MIT license.
 
 #define ETH_P_IP	0x0800
+// Transparent Ethernet Bridging, the protocol type of Geneve for Ethernet frames.
+#define ETH_P_TEB	0x6558
 // The maximum length of the TCP options field.
 #define MAX_TCP_OPTIONS_LEN 40
 // tc-bpf return code to execute the next tc-bpf program.
@@ -9,3 +11,48 @@
 
 #define DATA_AGGREGATION_LEVEL_LOW 0
 #define DATA_AGGREGATION_LEVEL_HIGH 1
+// Number of bytes of the packet, from the ethernet header, appended to the event
+// of the first TCP packet of each request and response when the HTTP parser is enabled.
+#define HTTP_CAPTURE_LEN 256
+// Maximum number of TCP connections whose direction of the last payload is remembered.
+#define HTTP_CONNECTIONS_MAP_SIZE 65536
+
+// ICMP error messages, reported without going through conntrack.
+// Ref: https://www.iana.org/assignments/icmp-parameters/icmp-parameters.xhtml
+#define ICMP_DEST_UNREACH 3
+#define ICMP_REDIRECT 5
+#define ICMP_TIME_EXCEEDED 11
+#define ICMP_PARAMETERPROB 12
+
+// Tunnel types of the encapsulated packets.
+// Ref: TunnelType in pkg/utils/metadata_linux.proto.
+#define TUNNEL_TYPE_NONE 0
+#define TUNNEL_TYPE_VXLAN 1
+#define TUNNEL_TYPE_GENEVE 2
+#define TUNNEL_TYPE_IPIP 3
+
+// UDP destination ports of the overlay tunnels.
+#define VXLAN_PORT 4789
+// Default VXLAN port of the Linux kernel, used by Flannel.
+#define VXLAN_LINUX_PORT 8472
+#define GENEVE_PORT 6081
+
+// VXLAN header flag indicating a valid VNI.
+#define VXLAN_FLAG_VNI 0x08
+
+// Ref: https://www.rfc-editor.org/rfc/rfc7348#section-5
+struct vxlan_header {
+	__u8 flags;
+	__u8 reserved1[3];
+	__u8 vni[3];
+	__u8 reserved2;
+};
+
+// Ref: https://www.rfc-editor.org/rfc/rfc8926#section-3.4
+struct geneve_header {
+	__u8 ver_opt_len; // 2 bits of version and 6 bits of length of the options in 4-byte words.
+	__u8 flags;
+	__be16 protocol_type;
+	__u8 vni[3];
+	__u8 reserved;
+};
@@ -0,0 +1,270 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package packetparser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	ttlcache "github.com/jellydator/ttlcache/v3"
+	"github.com/microsoft/retina/pkg/utils"
+)
+
+const (
+	// httpRequestTimeout is how long a request waits for its response.
+	httpRequestTimeout = 30 * time.Second
+	// httpCacheCapacity bounds the number of requests waiting for a response on the node.
+	httpCacheCapacity uint64 = 50000
+
+	ethHeaderLen  = 14
+	ipv4HeaderLen = 20
+	tcpHeaderLen  = 20
+	// httpDefaultProtocol is used when the request line is cut before the protocol.
+	httpDefaultProtocol = "HTTP/1.1"
+	// httpPathIDSegment replaces the IDs in the path template.
+	httpPathIDSegment = "{id}"
+)
+
+var httpMethods = map[string]struct{}{
+	"GET":     {},
+	"HEAD":    {},
+	"POST":    {},
+	"PUT":     {},
+	"DELETE":  {},
+	"CONNECT": {},
+	"OPTIONS": {},
+	"TRACE":   {},
+	"PATCH":   {},
+}
+
+// httpKey is the connection of a request, from the client to the server.
+type httpKey struct {
+	clientIP   string
+	clientPort uint32
+	serverIP   string
+	serverPort uint32
+}
+
+type httpRequest struct {
+	t        time.Time
+	seq      uint32
+	method   string
+	url      string
+	protocol string
+}
+
+// httpParser parses the HTTP/1.x requests and responses from the beginning of the
+// TCP payload appended to the events, and pairs the responses with their request.
+// Only the first packet of a request or response is parsed: the request line and the status line.
+type httpParser struct {
+	cache *ttlcache.Cache[httpKey, *httpRequest]
+}
+
+func newHTTPParser() *httpParser {
+	cache := ttlcache.New(
+		ttlcache.WithTTL[httpKey, *httpRequest](httpRequestTimeout),
+		ttlcache.WithCapacity[httpKey, *httpRequest](httpCacheCapacity),
+		ttlcache.WithDisableTouchOnHit[httpKey, *httpRequest](),
+	)
+	go cache.Start()
+	return &httpParser{cache: cache}
+}
+
+func (h *httpParser) stop() {
+	h.cache.Stop()
+}
+
+// process adds the HTTP request or response in the frame to the flow.
+// seq is the TCP sequence number of the packet, identifying the copies of the same request
+// seen at several observation points.
+// A response is only added to the flow when its request was seen, with the latency of the response.
+func (h *httpParser) process(fl *flow.Flow, seq uint32, frame []byte) {
+	if fl == nil || fl.GetIP() == nil || fl.GetL4().GetTCP() == nil || fl.GetTime() == nil {
+		return
+	}
+	payload := tcpPayload(frame)
+	if len(payload) == 0 {
+		return
+	}
+	tcp := fl.GetL4().GetTCP()
+
+	if method, url, protocol, ok := parseHTTPRequest(payload); ok {
+		k := httpKey{
+			clientIP:   fl.GetIP().GetSource(),
+			clientPort: tcp.GetSourcePort(),
+			serverIP:   fl.GetIP().GetDestination(),
+			serverPort: tcp.GetDestinationPort(),
+		}
+		// The request is seen at the endpoint and at the network. Keep the first one.
+		if item := h.cache.Get(k); item != nil && item.Value().seq == seq {
+			return
+		}
+		h.cache.Set(k, &httpRequest{
+			t:        fl.GetTime().AsTime(),
+			seq:      seq,
+			method:   method,
+			url:      url,
+			protocol: protocol,
+		}, ttlcache.DefaultTTL)
+		utils.AddHTTPInfo(fl, flow.L7FlowType_REQUEST, method, url, protocol, 0, 0)
+		return
+	}
+
+	if code, ok := parseHTTPResponse(payload); ok {
+		k := httpKey{
+			clientIP:   fl.GetIP().GetDestination(),
+			clientPort: tcp.GetDestinationPort(),
+			serverIP:   fl.GetIP().GetSource(),
+			serverPort: tcp.GetSourcePort(),
+		}
+		item, found := h.cache.GetAndDelete(k)
+		if !found {
+			return
+		}
+		req := item.Value()
+		latency := fl.GetTime().AsTime().Sub(req.t)
+		if latency < 0 {
+			return
+		}
+		utils.AddHTTPInfo(fl, flow.L7FlowType_RESPONSE, req.method, req.url, req.protocol, code, uint64(latency.Nanoseconds()))
+	}
+}
+
+// tcpPayload returns the TCP payload of the ethernet frame, nil if the frame is not an IPv4 TCP packet.
+// The payload of the encapsulated packets is the payload of their inner packet.
+func tcpPayload(frame []byte) []byte {
+	ip := ipv4Packet(frame)
+	if ip == nil {
+		return nil
+	}
+	ihl := int(ip[0]&0x0f) * 4 //nolint:gomnd // IHL in 4-byte words
+
+	if ihl < ipv4HeaderLen || ip[9] != 6 || len(ip) < ihl+tcpHeaderLen { //nolint:gomnd // IPPROTO_TCP
+		return nil
+	}
+	// The frame may be padded past the end of the IP packet.
+	if total := int(binary.BigEndian.Uint16(ip[2:4])); total < len(ip) {
+		ip = ip[:total]
+	}
+	if len(ip) < ihl+tcpHeaderLen {
+		return nil
+	}
+	tcp := ip[ihl:]
+	doff := int(tcp[12]>>4) * 4 //nolint:gomnd // data offset in 4-byte words
+	if doff < tcpHeaderLen || len(tcp) < doff {
+		return nil
+	}
+	// The perf sample is padded with zeros after a partial capture.
+	return bytes.TrimRight(tcp[doff:], "\x00")
+}
+
+// firstLine returns the first line of the payload, or the whole payload if it is cut before the end of the line.
+func firstLine(payload []byte) string {
+	if i := bytes.Index(payload, []byte("\r\n")); i >= 0 {
+		return string(payload[:i])
+	}
+	return string(payload)
+}
+
+// parseHTTPRequest parses the request line "METHOD target HTTP/1.x".
+func parseHTTPRequest(payload []byte) (method, url, protocol string, ok bool) {
+	fields := strings.Split(firstLine(payload), " ")
+	if len(fields) < 2 || len(fields) > 3 {
+		return "", "", "", false
+	}
+	if _, known := httpMethods[fields[0]]; !known {
+		return "", "", "", false
+	}
+	protocol = httpDefaultProtocol
+	if len(fields) == 3 { //nolint:gomnd // method, target and protocol
+		if !strings.HasPrefix(fields[2], "HTTP/1.") {
+			return "", "", "", false
+		}
+		protocol = fields[2]
+	}
+	url, ok = templatePath(fields[1])
+	if !ok {
+		return "", "", "", false
+	}
+	return fields[0], url, protocol, true
+}
+
+// parseHTTPResponse parses the status code of the status line "HTTP/1.x code reason".
+func parseHTTPResponse(payload []byte) (uint32, bool) {
+	line := firstLine(payload)
+	if !strings.HasPrefix(line, "HTTP/1.") {
+		return 0, false
+	}
+	fields := strings.SplitN(line, " ", 3) //nolint:gomnd // protocol, code and reason
+	if len(fields) < 2 || len(fields[1]) != 3 {
+		return 0, false
+	}
+	code, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil || code < 100 || code > 599 {
+		return 0, false
+	}
+	return uint32(code), true
+}
+
+// templatePath returns the path of the request target without its query, with the segments
+// looking like IDs replaced by {id} to bound the cardinality of the paths.
+func templatePath(target string) (string, bool) {
+	// Absolute form, sent to proxies.
+	if i := strings.Index(target, "://"); i >= 0 {
+		target = target[i+3:]
+		j := strings.IndexByte(target, '/')
+		if j < 0 {
+			return "/", true
+		}
+		target = target[j:]
+	}
+	if !strings.HasPrefix(target, "/") {
+		// Asterisk form of OPTIONS. The authority form of CONNECT has no path.
+		if target == "*" {
+			return target, true
+		}
+		return "", false
+	}
+	if i := strings.IndexAny(target, "?#"); i >= 0 {
+		target = target[:i]
+	}
+
+	segments := strings.Split(target, "/")
+	for i, s := range segments {
+		if isIDSegment(s) {
+			segments[i] = httpPathIDSegment
+		}
+	}
+	return strings.Join(segments, "/"), true
+}
+
+// isIDSegment returns true for numbers, UUIDs and long hexadecimal strings.
+func isIDSegment(s string) bool {
+	if s == "" {
+		return false
+	}
+	digits := true
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+		case (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F') || c == '-':
+			digits = false
+		default:
+			return false
+		}
+	}
+	if digits {
+		return true
+	}
+	// UUID.
+	if len(s) == 36 && strings.Count(s, "-") == 4 { //nolint:gomnd // UUID length and dashes
+		return true
+	}
+	// Hashes, object IDs.
+	return len(s) >= 16 && !strings.Contains(s, "-") //nolint:gomnd // minimum length of a hexadecimal ID
+}
@@ -0,0 +1,183 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package packetparser
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// httpFrame returns the ethernet frame of a TCP packet with the payload.
+func httpFrame(t *testing.T, srcIP, dstIP string, srcPort, dstPort uint16, payload string) []byte {
+	t.Helper()
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+		DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP(srcIP).To4(),
+		DstIP:    net.ParseIP(dstIP).To4(),
+	}
+	tcp := &layers.TCP{SrcPort: layers.TCPPort(srcPort), DstPort: layers.TCPPort(dstPort), PSH: true, ACK: true, Window: 1024}
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	require.NoError(t, gopacket.SerializeLayers(buf, opts, eth, ip, tcp, gopacket.Payload(payload)))
+	return buf.Bytes()
+}
+
+func httpTestFlow(t *testing.T, ts time.Time, srcIP, dstIP string, srcPort, dstPort uint16, obs uint8) *flow.Flow {
+	t.Helper()
+	fl := utils.ToFlow(log.Logger(), ts.UnixNano(), net.ParseIP(srcIP).To4(), net.ParseIP(dstIP).To4(), uint32(srcPort), uint32(dstPort), 6, obs, flow.Verdict_FORWARDED)
+	require.NotNil(t, fl)
+	return fl
+}
+
+func TestHTTPParserProcess(t *testing.T) {
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	h := newHTTPParser()
+	defer h.stop()
+
+	t0 := time.Unix(1700000000, 0)
+	req := httpFrame(t, "10.0.0.1", "10.0.0.2", 40000, 8080, "GET /users/42/orders?limit=10 HTTP/1.1\r\nHost: api\r\n\r\n")
+	resp := httpFrame(t, "10.0.0.2", "10.0.0.1", 8080, 40000, "HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\n\r\n")
+
+	// The request leaves the client pod, and is seen again when it arrives at the server pod.
+	reqFlow := httpTestFlow(t, t0, "10.0.0.1", "10.0.0.2", 40000, 8080, uint8(2)) // FROM_ENDPOINT
+	h.process(reqFlow, 1, req)
+	assert.Equal(t, flow.FlowType_L7, reqFlow.GetType())
+	assert.Equal(t, flow.L7FlowType_REQUEST, reqFlow.GetL7().GetType())
+	assert.Equal(t, "GET", reqFlow.GetL7().GetHttp().GetMethod())
+	assert.Equal(t, "/users/{id}/orders", reqFlow.GetL7().GetHttp().GetUrl())
+	assert.Equal(t, "HTTP/1.1", reqFlow.GetL7().GetHttp().GetProtocol())
+
+	dupFlow := httpTestFlow(t, t0.Add(time.Millisecond), "10.0.0.1", "10.0.0.2", 40000, 8080, uint8(0)) // TO_ENDPOINT
+	h.process(dupFlow, 1, req)
+	assert.Nil(t, dupFlow.GetL7())
+
+	// The response is paired with the first copy of the request.
+	respFlow := httpTestFlow(t, t0.Add(25*time.Millisecond), "10.0.0.2", "10.0.0.1", 8080, 40000, uint8(2))
+	h.process(respFlow, 7, resp)
+	require.NotNil(t, respFlow.GetL7())
+	assert.Equal(t, flow.L7FlowType_RESPONSE, respFlow.GetL7().GetType())
+	assert.EqualValues(t, 404, respFlow.GetL7().GetHttp().GetCode())
+	assert.Equal(t, "GET", respFlow.GetL7().GetHttp().GetMethod())
+	assert.Equal(t, "/users/{id}/orders", respFlow.GetL7().GetHttp().GetUrl())
+	assert.Equal(t, uint64(25*time.Millisecond), respFlow.GetL7().GetLatencyNs())
+	assert.True(t, respFlow.GetIsReply().GetValue())
+
+	// The copy of the response is not paired again.
+	dupResp := httpTestFlow(t, t0.Add(26*time.Millisecond), "10.0.0.2", "10.0.0.1", 8080, 40000, uint8(0))
+	h.process(dupResp, 7, resp)
+	assert.Nil(t, dupResp.GetL7())
+	assert.Equal(t, 0, h.cache.Len())
+
+	// The next request on the same connection replaces the unanswered one.
+	h.process(httpTestFlow(t, t0, "10.0.0.1", "10.0.0.2", 40000, 8080, uint8(2)), 10, req)
+	post := httpFrame(t, "10.0.0.1", "10.0.0.2", 40000, 8080, "POST /items HTTP/1.0\r\n")
+	h.process(httpTestFlow(t, t0.Add(time.Second), "10.0.0.1", "10.0.0.2", 40000, 8080, uint8(2)), 20, post)
+	respFlow = httpTestFlow(t, t0.Add(time.Second+time.Millisecond), "10.0.0.2", "10.0.0.1", 8080, 40000, uint8(2))
+	h.process(respFlow, 30, httpFrame(t, "10.0.0.2", "10.0.0.1", 8080, 40000, "HTTP/1.0 201 Created\r\n"))
+	assert.Equal(t, "POST", respFlow.GetL7().GetHttp().GetMethod())
+	assert.Equal(t, uint64(time.Millisecond), respFlow.GetL7().GetLatencyNs())
+
+	// Responses without a request and other payloads are ignored.
+	other := httpTestFlow(t, t0, "10.0.0.3", "10.0.0.1", 8080, 40001, uint8(2))
+	h.process(other, 1, httpFrame(t, "10.0.0.3", "10.0.0.1", 8080, 40001, "HTTP/1.1 200 OK\r\n"))
+	assert.Nil(t, other.GetL7())
+	other = httpTestFlow(t, t0, "10.0.0.1", "10.0.0.3", 40001, 8080, uint8(2))
+	h.process(other, 1, httpFrame(t, "10.0.0.1", "10.0.0.3", 40001, 8080, "\x16\x03\x01\x02\x00"))
+	assert.Nil(t, other.GetL7())
+	h.process(other, 1, nil)
+	assert.Nil(t, other.GetL7())
+}
+
+func TestTCPPayload(t *testing.T) {
+	frame := httpFrame(t, "10.0.0.1", "10.0.0.2", 40000, 80, "GET / HTTP/1.1\r\n")
+	assert.Equal(t, []byte("GET / HTTP/1.1\r\n"), tcpPayload(frame))
+
+	// Ethernet padding and the zeros padding the perf sample are not part of the payload.
+	assert.Equal(t, []byte("GET / HTTP/1.1\r\n"), tcpPayload(append(frame, 0, 0, 0, 0)))
+
+	// Truncated headers.
+	assert.Nil(t, tcpPayload(frame[:ethHeaderLen+ipv4HeaderLen+10]))
+
+	// Not IPv4.
+	ipv6 := append([]byte{}, frame...)
+	ipv6[12], ipv6[13] = 0x86, 0xdd
+	assert.Nil(t, tcpPayload(ipv6))
+}
+
+func TestParseHTTPRequest(t *testing.T) {
+	tests := []struct {
+		payload  string
+		ok       bool
+		method   string
+		url      string
+		protocol string
+	}{
+		{payload: "GET / HTTP/1.1\r\n", ok: true, method: "GET", url: "/", protocol: "HTTP/1.1"},
+		{payload: "DELETE /v1/objects/3f2b8c1e9a7d4e6f HTTP/1.1\r\n", ok: true, method: "DELETE", url: "/v1/objects/{id}", protocol: "HTTP/1.1"},
+		{payload: "PUT /a/123e4567-e89b-12d3-a456-426614174000/b#frag HTTP/1.0\r\n", ok: true, method: "PUT", url: "/a/{id}/b", protocol: "HTTP/1.0"},
+		{payload: "GET http://example.com/users/7 HTTP/1.1\r\n", ok: true, method: "GET", url: "/users/{id}", protocol: "HTTP/1.1"},
+		{payload: "OPTIONS * HTTP/1.1\r\n", ok: true, method: "OPTIONS", url: "*", protocol: "HTTP/1.1"},
+		// The request line is cut by the capture.
+		{payload: "GET /cafe/beef/very/long", ok: true, method: "GET", url: "/cafe/beef/very/long", protocol: "HTTP/1.1"},
+		{payload: "CONNECT example.com:443 HTTP/1.1\r\n", ok: false},
+		{payload: "GET / HTTP/2.0\r\n", ok: false},
+		{payload: "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n", ok: false},
+		{payload: "get / HTTP/1.1\r\n", ok: false},
+		{payload: "GET\r\n", ok: false},
+		{payload: "HTTP/1.1 200 OK\r\n", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			method, url, protocol, ok := parseHTTPRequest([]byte(tt.payload))
+			require.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.method, method)
+			assert.Equal(t, tt.url, url)
+			assert.Equal(t, tt.protocol, protocol)
+		})
+	}
+}
+
+func TestParseHTTPResponse(t *testing.T) {
+	tests := []struct {
+		payload string
+		ok      bool
+		code    uint32
+	}{
+		{payload: "HTTP/1.1 200 OK\r\n", ok: true, code: 200},
+		{payload: "HTTP/1.0 503 Service Unavailable\r\n", ok: true, code: 503},
+		{payload: "HTTP/1.1 204\r\n", ok: true, code: 204},
+		{payload: "HTTP/1.1 99 Weird\r\n", ok: false},
+		{payload: "HTTP/1.1 600 Weird\r\n", ok: false},
+		{payload: "HTTP/1.1 2x0 OK\r\n", ok: false},
+		{payload: "HTTP/2 200\r\n", ok: false},
+		{payload: "GET / HTTP/1.1\r\n", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			code, ok := parseHTTPResponse([]byte(tt.payload))
+			require.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.code, code)
+		})
+	}
+}
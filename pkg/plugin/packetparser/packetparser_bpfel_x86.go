@@ -67,6 +67,18 @@ type packetparserPacket struct {
 		PacketsForwardCount uint32
 		PacketsReplyCount   uint32
 	}
+	TunnelMetadata struct {
+		SrcIp      uint32
+		DstIp      uint32
+		Vni        uint32
+		TunnelType uint8
+		_          [3]byte
+	}
+	IcmpMetadata struct {
+		Type uint8
+		Code uint8
+	}
+	_ [6]byte
 }
 
 // loadPacketparser returns the embedded CollectionSpec for packetparser.
@@ -120,9 +132,10 @@ type packetparserProgramSpecs struct {
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type packetparserMapSpecs struct {
-	RetinaConntrack          *ebpf.MapSpec `ebpf:"retina_conntrack"`
-	RetinaFilter             *ebpf.MapSpec `ebpf:"retina_filter"`
-	RetinaPacketparserEvents *ebpf.MapSpec `ebpf:"retina_packetparser_events"`
+	RetinaConntrack              *ebpf.MapSpec `ebpf:"retina_conntrack"`
+	RetinaFilter                 *ebpf.MapSpec `ebpf:"retina_filter"`
+	RetinaPacketparserEvents     *ebpf.MapSpec `ebpf:"retina_packetparser_events"`
+	RetinaPacketparserPayloadDir *ebpf.MapSpec `ebpf:"retina_packetparser_payload_dir"`
 }
 
 // packetparserObjects contains all objects after they have been loaded into the kernel.
@@ -144,9 +157,10 @@ func (o *packetparserObjects) Close() error {
 //
 // It can be passed to loadPacketparserObjects or ebpf.CollectionSpec.LoadAndAssign.
 type packetparserMaps struct {
-	RetinaConntrack          *ebpf.Map `ebpf:"retina_conntrack"`
-	RetinaFilter             *ebpf.Map `ebpf:"retina_filter"`
-	RetinaPacketparserEvents *ebpf.Map `ebpf:"retina_packetparser_events"`
+	RetinaConntrack              *ebpf.Map `ebpf:"retina_conntrack"`
+	RetinaFilter                 *ebpf.Map `ebpf:"retina_filter"`
+	RetinaPacketparserEvents     *ebpf.Map `ebpf:"retina_packetparser_events"`
+	RetinaPacketparserPayloadDir *ebpf.Map `ebpf:"retina_packetparser_payload_dir"`
 }
 
 func (m *packetparserMaps) Close() error {
@@ -154,6 +168,7 @@ func (m *packetparserMaps) Close() error {
 		m.RetinaConntrack,
 		m.RetinaFilter,
 		m.RetinaPacketparserEvents,
+		m.RetinaPacketparserPayloadDir,
 	)
 }
 
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"regexp"
 	"runtime"
 	"sync"
 
@@ -44,6 +45,7 @@ import (
 	"github.com/microsoft/retina/pkg/pubsub"
 	"github.com/microsoft/retina/pkg/utils"
 	"github.com/microsoft/retina/pkg/watchers/endpoint"
+	"github.com/microsoft/retina/pkg/watchers/link"
 	"github.com/vishvananda/netlink"
 	vnl "github.com/vishvananda/netlink/nl"
 	"go.uber.org/zap"
@@ -79,7 +81,37 @@ func generateDynamicHeaderPath() (string, error) {
 	return fmt.Sprintf("%s/%s/%s", dir, bpfSourceDir, dynamicHeaderFileName), nil
 }
 
+// constants returns the settings of the eBPF program, rewritten in its volatile const globals.
+func (p *packetParser) constants() map[string]interface{} {
+	consts := map[string]interface{}{
+		"bypass_lookup_ip_of_interest": uint8(0),
+		"enable_conntrack_metrics":     uint8(0),
+		"enable_http_parser":           uint8(0),
+		"data_aggregation_level":       uint8(p.cfg.DataAggregationLevel),
+	}
+	if p.cfg.BypassLookupIPOfInterest {
+		consts["bypass_lookup_ip_of_interest"] = uint8(1)
+	}
+	if p.cfg.EnableConntrackMetrics {
+		consts["enable_conntrack_metrics"] = uint8(1)
+	}
+	if p.cfg.EnableHTTPParser {
+		consts["enable_http_parser"] = uint8(1)
+	}
+	return consts
+}
+
+// Generate uses the object precompiled with the agent, with the settings of the plugin rewritten in its constants.
+// If the precompiled object cannot be used, it generates the dynamic header to compile the eBPF program at runtime.
 func (p *packetParser) Generate(ctx context.Context) error {
+	var err error
+	p.spec, err = loader.LoadPrecompiled(loadPrecompiled, p.constants())
+	if err == nil {
+		p.l.Info("Using precompiled PacketParser object")
+		return nil
+	}
+	p.l.Warn("Cannot use precompiled PacketParser object, falling back to runtime compilation", zap.Error(err))
+
 	// Variable to store content of dynamic header.
 	var st string
 
@@ -113,6 +145,12 @@ func (p *packetParser) Generate(ctx context.Context) error {
 		st += fmt.Sprintf("#define ENABLE_CONNTRACK_METRICS %d\n", conntrackMetrics)
 	}
 
+	// Check if packetparser appends the payload of TCP packets for the HTTP parser.
+	if p.cfg.EnableHTTPParser {
+		p.l.Info("HTTP parser enabled")
+		st += "#define ENABLE_HTTP_PARSER 1\n"
+	}
+
 	// Process packetparser data aggregation level.
 	p.l.Info("data aggregation level", zap.String("level", p.cfg.DataAggregationLevel.String()))
 	st += fmt.Sprintf("#define DATA_AGGREGATION_LEVEL %d\n", p.cfg.DataAggregationLevel)
@@ -127,6 +165,11 @@ func (p *packetParser) Generate(ctx context.Context) error {
 }
 
 func (p *packetParser) Compile(ctx context.Context) error {
+	// The precompiled object is used, there is nothing to compile.
+	if p.spec != nil {
+		return nil
+	}
+
 	// Get the absolute path to this file during runtime.
 	dir, err := absPath()
 	if err != nil {
@@ -170,19 +213,27 @@ func (p *packetParser) Init() error {
 		p.l.Warn("packet parser and latency plugin will not init because pod level is disabled")
 		return nil
 	}
-	// Get the absolute path to this file during runtime.
-	dir, err := absPath()
-	if err != nil {
-		return err
-	}
+	spec := p.spec
+	if spec == nil {
+		// Load the object compiled at runtime.
+		// Get the absolute path to this file during runtime.
+		var dir string
+		dir, err = absPath()
+		if err != nil {
+			return err
+		}
 
-	bpfOutputFile := fmt.Sprintf("%s/%s", dir, bpfObjectFileName)
+		bpfOutputFile := fmt.Sprintf("%s/%s", dir, bpfObjectFileName)
+		spec, err = ebpf.LoadCollectionSpec(bpfOutputFile)
+		if err != nil {
+			return err
+		}
+		if err = spec.RewriteConstants(p.constants()); err != nil {
+			return errors.Wrap(err, "failed to rewrite constants")
+		}
+	}
 
 	objs := &packetparserObjects{}
-	spec, err := ebpf.LoadCollectionSpec(bpfOutputFile)
-	if err != nil {
-		return err
-	}
 	//nolint:typecheck
 	if err := spec.LoadAndAssign(objs, &ebpf.CollectionOptions{ //nolint:typecheck
 		Maps: ebpf.MapOptions{
@@ -227,6 +278,13 @@ func (p *packetParser) Init() error {
 	p.tcMap = &sync.Map{}
 	p.interfaceLockMap = &sync.Map{}
 
+	if p.cfg.PacketParserInterfaceRegex != "" {
+		p.interfaceRegex, err = regexp.Compile(p.cfg.PacketParserInterfaceRegex)
+		if err != nil {
+			return errors.Wrap(err, "invalid packetParserInterfaceRegex")
+		}
+	}
+
 	return nil
 }
 
@@ -258,23 +316,20 @@ func (p *packetParser) Start(ctx context.Context) error {
 	}
 
 	if p.cfg.DataAggregationLevel == kcfg.Low {
-		p.l.Info("Attaching bpf program to default interface of k8s Node in node namespace")
-		outgoingLinks, err := utils.GetDefaultOutgoingLinks()
+		p.l.Info("Attaching bpf program to default interfaces of k8s Node in node namespace")
+		attached, err := p.attachHostLinks()
 		if err != nil {
-			return errors.Wrap(err, "could not get default outgoing links")
+			return err
 		}
-		if len(outgoingLinks) == 0 {
+		if attached == 0 {
 			return errNoOutgoingLinks
 		}
-		outgoingLink := outgoingLinks[0] // Take first link until multi-link support is implemented
-
-		outgoingLinkAttributes := outgoingLink.Attrs()
-		p.l.Info("Attaching Packetparser",
-			zap.Int("outgoingLink.Index", outgoingLinkAttributes.Index),
-			zap.String("outgoingLink.Name", outgoingLinkAttributes.Name),
-			zap.Stringer("outgoingLink.HardwareAddr", outgoingLinkAttributes.HardwareAddr),
-		)
-		p.createQdiscAndAttach(*outgoingLink.Attrs(), Device)
+
+		// Every time a link is created or goes up, we will attach to it if it is a host link.
+		linkFn := pubsub.CallBackFunc(p.linkWatcherCallbackFn)
+		if p.linkCallbackID == "" {
+			p.linkCallbackID = ps.Subscribe(common.PubSubLinks, &linkFn)
+		}
 	} else {
 		p.l.Info("Skipping attaching bpf program to default interface of k8s Node in node namespace")
 	}
@@ -283,6 +338,10 @@ func (p *packetParser) Start(ctx context.Context) error {
 	p.recordsChannel = make(chan perf.Record, buffer)
 	p.l.Debug("Created records channel")
 
+	if p.cfg.EnableHTTPParser {
+		p.http = newHTTPParser()
+	}
+
 	return p.run(ctx)
 }
 
@@ -307,6 +366,11 @@ func (p *packetParser) Stop() error {
 		p.l.Debug("Closed records channel")
 	}
 
+	if p.http != nil {
+		p.http.stop()
+		p.http = nil
+	}
+
 	// Stop map and progs.
 	if p.objs != nil {
 		if err := p.objs.Close(); err != nil {
@@ -323,6 +387,12 @@ func (p *packetParser) Stop() error {
 		// Reset callback ID.
 		p.callbackID = ""
 	}
+	if p.linkCallbackID != "" {
+		if err := ps.Unsubscribe(common.PubSubLinks, p.linkCallbackID); err != nil {
+			p.l.Error("Error unregistering link callback for packetParser", zap.Error(err))
+		}
+		p.linkCallbackID = ""
+	}
 
 	if err := p.cleanAll(); err != nil {
 		p.l.Error("Error cleaning", zap.Error(err))
@@ -408,6 +478,123 @@ func (p *packetParser) endpointWatcherCallbackFn(obj interface{}) {
 	}
 }
 
+// hostLinks returns the links of the node to attach to: the links of the default routes, and the links whose name
+// matches interfaceRegex. Veths are attached to by the endpoint watcher callback. The links which are down are skipped,
+// and so are the links enslaved to another link, e.g. the slaves of a bond, as their packets are seen on the master.
+func (p *packetParser) hostLinks() ([]netlink.Link, error) {
+	links, err := getDefaultOutgoingLinks()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get default outgoing links")
+	}
+	if p.interfaceRegex != nil {
+		var all []netlink.Link
+		all, err = listLinks()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list links")
+		}
+		for _, l := range all {
+			if l.Type() != "veth" && p.interfaceRegex.MatchString(l.Attrs().Name) {
+				links = append(links, l)
+			}
+		}
+	}
+
+	hostLinks := make([]netlink.Link, 0, len(links))
+	seen := make(map[int]struct{}, len(links))
+	for _, l := range links {
+		attrs := l.Attrs()
+		if _, ok := seen[attrs.Index]; ok || attrs.MasterIndex != 0 || attrs.OperState == netlink.OperDown {
+			continue
+		}
+		seen[attrs.Index] = struct{}{}
+		hostLinks = append(hostLinks, l)
+	}
+	return hostLinks, nil
+}
+
+// attachHostLinks attaches to the host links not attached to yet, detaches from the links which are no longer host
+// links, e.g. renamed or down, and returns the number of host links.
+func (p *packetParser) attachHostLinks() (int, error) {
+	links, err := p.hostLinks()
+	if err != nil {
+		return 0, err
+	}
+
+	hostKeys := make(map[tcKey]struct{}, len(links))
+	for _, l := range links {
+		attrs := *l.Attrs()
+		ifaceKey := ifaceToKey(attrs)
+		hostKeys[ifaceKey] = struct{}{}
+		lockMapVal, _ := p.interfaceLockMap.LoadOrStore(ifaceKey, &sync.Mutex{})
+		mu := lockMapVal.(*sync.Mutex)
+		mu.Lock()
+		if _, ok := p.tcMap.Load(ifaceKey); !ok {
+			p.l.Info("Attaching Packetparser",
+				zap.Int("outgoingLink.Index", attrs.Index),
+				zap.String("outgoingLink.Name", attrs.Name),
+				zap.Stringer("outgoingLink.HardwareAddr", attrs.HardwareAddr),
+			)
+			p.createQdiscAndAttach(attrs, Device)
+		}
+		mu.Unlock()
+	}
+
+	p.tcMap.Range(func(key, value interface{}) bool {
+		ifaceKey := key.(tcKey)
+		if _, ok := hostKeys[ifaceKey]; ok || value.(*tcValue).ifaceType != Device {
+			return true
+		}
+		p.l.Info("Detaching Packetparser from link which is no longer a host link", zap.String("name", ifaceKey.name))
+		p.detach(ifaceKey)
+		return true
+	})
+	return len(links), nil
+}
+
+// detach detaches from the link, if attached to.
+func (p *packetParser) detach(ifaceKey tcKey) {
+	lockMapVal, _ := p.interfaceLockMap.LoadOrStore(ifaceKey, &sync.Mutex{})
+	mu := lockMapVal.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+	if value, ok := p.tcMap.Load(ifaceKey); ok {
+		v := value.(*tcValue)
+		p.clean(v.tc, v.qdisc)
+		p.tcMap.Delete(ifaceKey)
+	}
+	p.interfaceLockMap.Delete(ifaceKey)
+}
+
+func (p *packetParser) linkWatcherCallbackFn(obj interface{}) {
+	// Contract is that we will receive a link event pointer.
+	event := obj.(*link.LinkEvent)
+	if event == nil {
+		return
+	}
+
+	l := event.Obj.(netlink.Link)
+	if l.Type() == "veth" {
+		// Handled by endpointWatcherCallbackFn.
+		return
+	}
+
+	switch event.Type {
+	case link.LinkCreated, link.LinkUpdated:
+		// The link may have become a host link, e.g. a route through it was added when it went up, or may no longer be
+		// one, e.g. it was renamed, went down or was enslaved to a bond.
+		p.l.Debug("Link created or updated", zap.String("name", l.Attrs().Name))
+		if _, err := p.attachHostLinks(); err != nil {
+			p.l.Error("could not attach to host links", zap.Error(err))
+		}
+	case link.LinkDeleted:
+		p.l.Debug("Link deleted", zap.String("name", l.Attrs().Name))
+		p.detach(ifaceToKey(*l.Attrs()))
+	default:
+		// Unknown.
+		p.l.Debug("Unknown event", zap.String("type", event.Type.String()))
+	}
+}
+
 // createQdiscAndAttach creates a qdisc of type clsact on the interface and attaches the ingress and egress bpf filter programs to it.
 // Only support interfaces of type veth and device.
 func (p *packetParser) createQdiscAndAttach(iface netlink.LinkAttrs, ifaceType interfaceType) {
@@ -520,8 +707,9 @@ func (p *packetParser) createQdiscAndAttach(iface netlink.LinkAttrs, ifaceType i
 	// Cache.
 	ifaceKey := ifaceToKey(iface)
 	tcValue := &tcValue{
-		tc:    rtnl,
-		qdisc: clsactQdisc,
+		tc:        rtnl,
+		qdisc:     clsactQdisc,
+		ifaceType: ifaceType,
 	}
 	p.tcMap.Store(ifaceKey, tcValue)
 
@@ -607,6 +795,13 @@ func (p *packetParser) processRecord(ctx context.Context, id int) {
 			// Add packet size to the flow's metadata.
 			utils.AddPacketSize(meta, bpfEvent.Bytes)
 
+			// Add the outer headers of the packets encapsulated in an overlay tunnel, the flow reports the inner packet.
+			tunnel := bpfEvent.TunnelMetadata
+			utils.AddTunnel(meta, utils.TunnelType(tunnel.TunnelType), utils.Int2ip(tunnel.SrcIp).To4(), utils.Int2ip(tunnel.DstIp).To4(), tunnel.Vni)
+
+			// Add the ICMP type and code to the flow.
+			utils.AddICMP(fl, uint32(bpfEvent.IcmpMetadata.Type), uint32(bpfEvent.IcmpMetadata.Code))
+
 			// Add the TCP metadata to the flow.
 			tcpMetadata := bpfEvent.TcpMetadata
 			utils.AddTCPFlags(
@@ -619,17 +814,25 @@ func (p *packetParser) processRecord(ctx context.Context, id int) {
 				uint16((bpfEvent.Flags&TCPFlagURG)>>5), // nolint:gomnd // 5 is the offset for URG.
 			)
 
-			// For packets originating from node, we use tsval as the tcpID.
+			// For packets leaving the node or a pod, we use tsval as the tcpID.
 			// Packets coming back has the tsval echoed in tsecr.
-			if fl.GetTraceObservationPoint() == flow.TraceObservationPoint_TO_NETWORK {
+			switch fl.GetTraceObservationPoint() {
+			case flow.TraceObservationPoint_TO_NETWORK, flow.TraceObservationPoint_FROM_ENDPOINT:
 				utils.AddTCPID(meta, uint64(tcpMetadata.Tsval))
-			} else if fl.GetTraceObservationPoint() == flow.TraceObservationPoint_FROM_NETWORK {
+			case flow.TraceObservationPoint_FROM_NETWORK, flow.TraceObservationPoint_TO_ENDPOINT:
 				utils.AddTCPID(meta, uint64(tcpMetadata.Tsecr))
 			}
 
 			// Add metadata to the flow.
 			utils.AddRetinaMetadata(fl, meta)
 
+			// Add the HTTP request or response from the beginning of the packet appended to the event.
+			if p.http != nil {
+				if n := binary.Size(bpfEvent); len(record.RawSample) > n {
+					p.http.process(fl, tcpMetadata.Seq, record.RawSample[n:])
+				}
+			}
+
 			// Write the event to the enricher.
 			ev := &v1.Event{
 				Event:     fl,
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"regexp"
 	"runtime"
 	"sync"
 	"testing"
@@ -25,7 +26,9 @@ import (
 	"github.com/microsoft/retina/pkg/log"
 	"github.com/microsoft/retina/pkg/metrics"
 	"github.com/microsoft/retina/pkg/plugin/packetparser/mocks"
+	"github.com/microsoft/retina/pkg/utils"
 	"github.com/microsoft/retina/pkg/watchers/endpoint"
+	"github.com/microsoft/retina/pkg/watchers/link"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -50,6 +53,10 @@ var (
 		EnablePodLevel:       true,
 		DataAggregationLevel: kcfg.High,
 	}
+	cfgHTTPParserEnabled = &kcfg.Config{
+		EnablePodLevel:   true,
+		EnableHTTPParser: true,
+	}
 	cfgConntrackMetricsEnabled = &kcfg.Config{
 		EnablePodLevel:           true,
 		DataAggregationLevel:     kcfg.High,
@@ -85,8 +92,8 @@ func TestCleanAll(t *testing.T) {
 		return mq
 	}
 
-	p.tcMap.Store(tcKey{"test", "test", 1}, &tcValue{mrtnl, &tc.Object{}})
-	p.tcMap.Store(tcKey{"test2", "test2", 2}, &tcValue{mrtnl, &tc.Object{}})
+	p.tcMap.Store(tcKey{"test", "test", 1}, &tcValue{mrtnl, &tc.Object{}, Veth})
+	p.tcMap.Store(tcKey{"test2", "test2", 2}, &tcValue{mrtnl, &tc.Object{}, Veth})
 
 	assert.Nil(t, p.cleanAll())
 
@@ -180,7 +187,7 @@ func TestEndpointWatcherCallbackFn_EndpointDeleted(t *testing.T) {
 
 	// Pre-populate both maps to simulate existing interface
 	p.interfaceLockMap.Store(key, &sync.Mutex{})
-	p.tcMap.Store(key, &tcValue{nil, &tc.Object{}})
+	p.tcMap.Store(key, &tcValue{nil, &tc.Object{}, Veth})
 
 	// Create EndpointDeleted event.
 	e := &endpoint.EndpointEvent{
@@ -281,6 +288,119 @@ func TestCreateQdiscAndAttach(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestAttachHostLinks(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mfilter := mocks.NewMockfilter(ctrl)
+	mq := mocks.NewMockqdisc(ctrl)
+	mrtnl := mocks.NewMocknltc(ctrl)
+	mrtnl.EXPECT().SetOption(nl.ExtendedAcknowledge, true).Return(nil).AnyTimes()
+
+	// One qdisc and two filters for each of eth0 and bond0, attached only once, then for bond0 once renamed.
+	mq.EXPECT().Add(gomock.Any()).Return(nil).Times(3)
+	mfilter.EXPECT().Add(gomock.Any()).Return(nil).Times(6)
+
+	getQdisc = func(nltc) qdisc {
+		return mq
+	}
+	getFilter = func(nltc) filter {
+		return mfilter
+	}
+	tcOpen = func(*tc.Config) (nltc, error) {
+		return mrtnl, nil
+	}
+	getFD = func(*ebpf.Program) int {
+		return 1
+	}
+
+	eth0 := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 2, Name: "eth0"}}
+	bond0 := &netlink.Bond{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "bond0"}}
+	// Slave of bond0, its packets are seen on bond0.
+	eth1 := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 6, Name: "eth1", MasterIndex: 3}}
+	docker0 := &netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Index: 4, Name: "docker0"}}
+	veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Index: 5, Name: "bond-veth"}}
+	getDefaultOutgoingLinks = func() ([]netlink.Link, error) {
+		return []netlink.Link{eth0}, nil
+	}
+	listLinks = func() ([]netlink.Link, error) {
+		return []netlink.Link{eth0, bond0, eth1, docker0, veth}, nil
+	}
+	defer func() {
+		getDefaultOutgoingLinks = utils.GetDefaultOutgoingLinks
+		listLinks = netlink.LinkList
+	}()
+
+	pObj := &packetparserObjects{} //nolint:typecheck
+	pObj.HostIngressFilter = &ebpf.Program{}
+	pObj.HostEgressFilter = &ebpf.Program{}
+	p := &packetParser{
+		cfg:              cfgDataAggregationLevelLow,
+		l:                log.Logger().Named("test"),
+		objs:             pObj,
+		interfaceRegex:   regexp.MustCompile("^(bond|eth)"),
+		interfaceLockMap: &sync.Map{},
+		hostIngressInfo: &ebpf.ProgramInfo{
+			Name: "ingress",
+		},
+		hostEgressInfo: &ebpf.ProgramInfo{
+			Name: "egress",
+		},
+		tcMap: &sync.Map{},
+	}
+
+	attached, err := p.attachHostLinks()
+	require.NoError(t, err)
+	assert.Equal(t, 2, attached)
+	for _, l := range []netlink.Link{eth0, bond0} {
+		_, ok := p.tcMap.Load(ifaceToKey(*l.Attrs()))
+		assert.True(t, ok, "Expected %s to be attached", l.Attrs().Name)
+	}
+	for _, l := range []netlink.Link{eth1, docker0} {
+		_, ok := p.tcMap.Load(ifaceToKey(*l.Attrs()))
+		assert.False(t, ok, "Expected %s not to be attached", l.Attrs().Name)
+	}
+
+	// A link going up does not attach to the links already attached to.
+	p.linkWatcherCallbackFn(link.NewLinkEvent(link.LinkUpdated, bond0))
+
+	// A link going down is detached from.
+	mq.EXPECT().Delete(gomock.Any()).Return(nil).Times(3)
+	mrtnl.EXPECT().Close().Return(nil).Times(3)
+	bond0Down := &netlink.Bond{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "bond0", OperState: netlink.OperDown}}
+	listLinks = func() ([]netlink.Link, error) {
+		return []netlink.Link{eth0, bond0Down, eth1, docker0, veth}, nil
+	}
+	p.linkWatcherCallbackFn(link.NewLinkEvent(link.LinkUpdated, bond0Down))
+	_, ok := p.tcMap.Load(ifaceToKey(*bond0.Attrs()))
+	assert.False(t, ok, "Expected bond0 to be detached")
+
+	// A link renamed is detached from under its previous name, and attached to under its new name if it matches.
+	bond1 := &netlink.Bond{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "bond1"}}
+	listLinks = func() ([]netlink.Link, error) {
+		return []netlink.Link{eth0, bond1, eth1, docker0, veth}, nil
+	}
+	p.linkWatcherCallbackFn(link.NewLinkEvent(link.LinkUpdated, bond1))
+	_, ok = p.tcMap.Load(ifaceToKey(*bond1.Attrs()))
+	assert.True(t, ok, "Expected bond1 to be attached")
+
+	bond1Renamed := &netlink.Bond{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "team0"}}
+	listLinks = func() ([]netlink.Link, error) {
+		return []netlink.Link{eth0, bond1Renamed, eth1, docker0, veth}, nil
+	}
+	p.linkWatcherCallbackFn(link.NewLinkEvent(link.LinkUpdated, bond1Renamed))
+	_, ok = p.tcMap.Load(ifaceToKey(*bond1.Attrs()))
+	assert.False(t, ok, "Expected bond1 to be detached")
+	_, ok = p.tcMap.Load(ifaceToKey(*bond1Renamed.Attrs()))
+	assert.False(t, ok, "Expected team0 not to be attached")
+
+	// A deleted link is detached from.
+	p.linkWatcherCallbackFn(link.NewLinkEvent(link.LinkDeleted, eth0))
+	_, ok = p.tcMap.Load(ifaceToKey(*eth0.Attrs()))
+	assert.False(t, ok, "Expected eth0 to be detached")
+}
+
 func TestReadData_Error(t *testing.T) {
 	log.SetupZapLogger(log.GetDefaultLogOpts())
 	ctrl := gomock.NewController(t)
@@ -543,6 +663,14 @@ func TestPacketParseGenerate(t *testing.T) {
 	takeBackup()
 	defer restoreBackup()
 
+	// The dynamic header is generated when the precompiled object cannot be used.
+	loadPrecompiled = func() (*ebpf.CollectionSpec, error) {
+		return &ebpf.CollectionSpec{}, nil
+	}
+	defer func() {
+		loadPrecompiled = loadPacketparser
+	}()
+
 	log.SetupZapLogger(log.GetDefaultLogOpts())
 	// Get the directory of the current test file.
 	_, filename, _, ok := runtime.Caller(0)
@@ -567,6 +695,11 @@ func TestPacketParseGenerate(t *testing.T) {
 			cfg:              cfgConntrackMetricsEnabled,
 			expectedContents: "#define BYPASS_LOOKUP_IP_OF_INTEREST 1\n#define ENABLE_CONNTRACK_METRICS 1\n#define DATA_AGGREGATION_LEVEL 1\n",
 		},
+		{
+			name:             "HTTPParserEnabled",
+			cfg:              cfgHTTPParserEnabled,
+			expectedContents: "#define ENABLE_HTTP_PARSER 1\n#define DATA_AGGREGATION_LEVEL 0\n",
+		},
 		{
 			name:             "DataAggregationLevelLow",
 			cfg:              cfgDataAggregationLevelLow,
@@ -592,6 +725,7 @@ func TestPacketParseGenerate(t *testing.T) {
 			if err := p.Generate(ctx); err != nil {
 				t.Fatalf("failed to generate PacketParser header: %v", err)
 			}
+			require.Nil(t, p.spec)
 
 			// Verify that the dynamic header file was created in the expected location and contains the expected contents.
 			if _, err := os.Stat(dynamicHeaderPath); os.IsNotExist(err) {
@@ -609,10 +743,77 @@ func TestPacketParseGenerate(t *testing.T) {
 	}
 }
 
+func TestConstants(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *kcfg.Config
+		want map[string]interface{}
+	}{
+		{
+			name: "PodLevelEnabled",
+			cfg:  cfgPodLevelEnabled,
+			want: map[string]interface{}{
+				"bypass_lookup_ip_of_interest": uint8(1),
+				"enable_conntrack_metrics":     uint8(0),
+				"enable_http_parser":           uint8(0),
+				"data_aggregation_level":       uint8(0),
+			},
+		},
+		{
+			name: "ConntrackMetricsEnabled",
+			cfg:  cfgConntrackMetricsEnabled,
+			want: map[string]interface{}{
+				"bypass_lookup_ip_of_interest": uint8(1),
+				"enable_conntrack_metrics":     uint8(1),
+				"enable_http_parser":           uint8(0),
+				"data_aggregation_level":       uint8(1),
+			},
+		},
+		{
+			name: "HTTPParserEnabled",
+			cfg:  cfgHTTPParserEnabled,
+			want: map[string]interface{}{
+				"bypass_lookup_ip_of_interest": uint8(0),
+				"enable_conntrack_metrics":     uint8(0),
+				"enable_http_parser":           uint8(1),
+				"data_aggregation_level":       uint8(0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &packetParser{cfg: tt.cfg}
+			assert.Equal(t, tt.want, p.constants())
+		})
+	}
+}
+
+// TestPrecompiled checks that the objects embedded in the agent declare all the settings of the plugin, so that it
+// does not need to compile the eBPF program at runtime.
+func TestPrecompiled(t *testing.T) {
+	for _, cfg := range []*kcfg.Config{cfgPodLevelEnabled, cfgConntrackMetricsEnabled} {
+		p := &packetParser{cfg: cfg}
+		for _, obj := range []string{"packetparser_bpfel_x86.o", "packetparser_bpfel_arm64.o"} {
+			spec, err := ebpf.LoadCollectionSpec(obj)
+			require.NoError(t, err, obj)
+			require.NotEmpty(t, spec.Programs, obj)
+			require.NoError(t, spec.RewriteConstants(p.constants()), obj)
+		}
+	}
+}
+
 func TestCompile(t *testing.T) {
 	takeBackup()
 	defer restoreBackup()
 
+	loadPrecompiled = func() (*ebpf.CollectionSpec, error) {
+		return nil, errors.New("no precompiled object")
+	}
+	defer func() {
+		loadPrecompiled = loadPacketparser
+	}()
+
 	log.SetupZapLogger(log.GetDefaultLogOpts())
 	p := &packetParser{
 		cfg: cfgPodLevelEnabled,
@@ -0,0 +1,90 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package packetparser
+
+import (
+	"encoding/binary"
+)
+
+// Ref: pkg/plugin/packetparser/_cprog/packetparser.h.
+const (
+	udpHeaderLen    = 8
+	vxlanHeaderLen  = 8
+	geneveHeaderLen = 8
+
+	vxlanPort      = 4789
+	vxlanLinuxPort = 8472
+	genevePort     = 6081
+	vxlanFlagVNI   = 0x08
+
+	ethPIP      = 0x0800
+	ethPTEB     = 0x6558
+	ipProtoIPIP = 4
+	ipProtoUDP  = 17
+)
+
+// ipv4Packet returns the IPv4 packet of the ethernet frame, nil if the frame is not an IPv4 packet. The inner packet
+// of the VXLAN, Geneve and IPIP packets is returned, like the eBPF program reports the inner packet.
+func ipv4Packet(frame []byte) []byte {
+	if len(frame) < ethHeaderLen+ipv4HeaderLen || binary.BigEndian.Uint16(frame[12:14]) != ethPIP {
+		return nil
+	}
+	ip := frame[ethHeaderLen:]
+	if inner := decapsulate(ip); inner != nil {
+		return inner
+	}
+	return ip
+}
+
+// decapsulate returns the inner IPv4 packet of an IPv4 packet encapsulated in VXLAN, Geneve or IPIP, nil if the packet
+// is not encapsulated or is truncated before the inner IPv4 header.
+func decapsulate(ip []byte) []byte {
+	ihl := int(ip[0]&0x0f) * 4 //nolint:gomnd // IHL in 4-byte words
+	if ihl < ipv4HeaderLen || len(ip) < ihl {
+		return nil
+	}
+
+	var inner []byte
+	switch ip[9] {
+	case ipProtoIPIP:
+		inner = ip[ihl:]
+	case ipProtoUDP:
+		udp := ip[ihl:]
+		if len(udp) < udpHeaderLen {
+			return nil
+		}
+		var frame []byte
+		switch binary.BigEndian.Uint16(udp[2:4]) {
+		case vxlanPort, vxlanLinuxPort:
+			vxlan := udp[udpHeaderLen:]
+			if len(vxlan) < vxlanHeaderLen || vxlan[0]&vxlanFlagVNI == 0 {
+				return nil
+			}
+			frame = vxlan[vxlanHeaderLen:]
+		case genevePort:
+			geneve := udp[udpHeaderLen:]
+			if len(geneve) < geneveHeaderLen || binary.BigEndian.Uint16(geneve[2:4]) != ethPTEB {
+				return nil
+			}
+			optLen := int(geneve[0]&0x3f) * 4 //nolint:gomnd // options length in 4-byte words
+			if len(geneve) < geneveHeaderLen+optLen {
+				return nil
+			}
+			frame = geneve[geneveHeaderLen+optLen:]
+		default:
+			return nil
+		}
+		if len(frame) < ethHeaderLen || binary.BigEndian.Uint16(frame[12:14]) != ethPIP {
+			return nil
+		}
+		inner = frame[ethHeaderLen:]
+	default:
+		return nil
+	}
+
+	if len(inner) < ipv4HeaderLen {
+		return nil
+	}
+	return inner
+}
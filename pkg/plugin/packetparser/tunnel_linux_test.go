@@ -0,0 +1,170 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package packetparser
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/ebpf/perf"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// outerFrame returns the ethernet frame of an IPv4 packet between two nodes, with the protocol and the payload.
+func outerFrame(t *testing.T, protocol layers.IPProtocol, payload ...gopacket.SerializableLayer) []byte {
+	t.Helper()
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+		DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: protocol,
+		SrcIP:    net.ParseIP("192.168.0.4").To4(),
+		DstIP:    net.ParseIP("192.168.0.5").To4(),
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true}
+	require.NoError(t, gopacket.SerializeLayers(buf, opts, append([]gopacket.SerializableLayer{eth, ip}, payload...)...))
+	return buf.Bytes()
+}
+
+// udpTunnel returns the UDP header and the tunnel header of a VXLAN or Geneve packet.
+func udpTunnel(dstPort uint16, header []byte) []gopacket.SerializableLayer {
+	udp := &layers.UDP{SrcPort: 50000, DstPort: layers.UDPPort(dstPort)}
+	return []gopacket.SerializableLayer{udp, gopacket.Payload(header)}
+}
+
+func TestTCPPayloadEncapsulated(t *testing.T) {
+	inner := httpFrame(t, "10.244.1.5", "10.244.2.7", 40000, 80, "GET / HTTP/1.1\r\n")
+	vxlanHeader := []byte{vxlanFlagVNI, 0, 0, 0, 0, 0, 1, 0}
+	geneveHeader := []byte{0, 0, 0x65, 0x58, 0, 0, 2, 0}
+	// Geneve header with 8 bytes of options.
+	geneveOptionsHeader := append([]byte{2, 0, 0x65, 0x58, 0, 0, 2, 0}, make([]byte, 8)...)
+
+	tests := []struct {
+		name  string
+		frame []byte
+		want  []byte
+	}{
+		{
+			name:  "VXLAN",
+			frame: outerFrame(t, layers.IPProtocolUDP, udpTunnel(vxlanPort, append(vxlanHeader, inner...))...),
+			want:  []byte("GET / HTTP/1.1\r\n"),
+		},
+		{
+			name:  "VXLAN on the port of Linux",
+			frame: outerFrame(t, layers.IPProtocolUDP, udpTunnel(vxlanLinuxPort, append(vxlanHeader, inner...))...),
+			want:  []byte("GET / HTTP/1.1\r\n"),
+		},
+		{
+			name:  "Geneve",
+			frame: outerFrame(t, layers.IPProtocolUDP, udpTunnel(genevePort, append(geneveHeader, inner...))...),
+			want:  []byte("GET / HTTP/1.1\r\n"),
+		},
+		{
+			name:  "Geneve with options",
+			frame: outerFrame(t, layers.IPProtocolUDP, udpTunnel(genevePort, append(geneveOptionsHeader, inner...))...),
+			want:  []byte("GET / HTTP/1.1\r\n"),
+		},
+		{
+			name:  "IPIP",
+			frame: outerFrame(t, layers.IPProtocolIPv4, gopacket.Payload(inner[ethHeaderLen:])),
+			want:  []byte("GET / HTTP/1.1\r\n"),
+		},
+		{
+			name:  "VXLAN without VNI",
+			frame: outerFrame(t, layers.IPProtocolUDP, udpTunnel(vxlanPort, append([]byte{0, 0, 0, 0, 0, 0, 1, 0}, inner...))...),
+		},
+		{
+			name:  "truncated inner header",
+			frame: outerFrame(t, layers.IPProtocolUDP, udpTunnel(vxlanPort, append(vxlanHeader, inner[:ethHeaderLen+10]...))...),
+		},
+		{
+			name:  "UDP not encapsulated",
+			frame: outerFrame(t, layers.IPProtocolUDP, udpTunnel(53, inner)...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tcpPayload(tt.frame))
+		})
+	}
+}
+
+func TestIPv4Packet(t *testing.T) {
+	inner := httpFrame(t, "10.244.1.5", "10.244.2.7", 40000, 80, "")
+	frame := outerFrame(t, layers.IPProtocolUDP, udpTunnel(vxlanPort, append([]byte{vxlanFlagVNI, 0, 0, 0, 0, 0, 1, 0}, inner...))...)
+
+	ip := ipv4Packet(frame)
+	require.NotNil(t, ip)
+	assert.Equal(t, net.ParseIP("10.244.1.5").To4(), net.IP(ip[12:16]))
+	assert.Equal(t, uint16(80), binary.BigEndian.Uint16(ip[ipv4HeaderLen+2:ipv4HeaderLen+4]))
+
+	// The packets not encapsulated are returned as is.
+	ip = ipv4Packet(inner)
+	require.NotNil(t, ip)
+	assert.Equal(t, inner[ethHeaderLen:], ip)
+}
+
+func TestProcessRecordEncapsulated(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	bpfEvent := packetparserPacket{ //nolint:typecheck
+		SrcIp:            uint32(84014090),  // 10.244.1.5
+		DstIp:            uint32(117634058), // 10.244.2.7
+		Proto:            uint8(6),          // TCP
+		ObservationPoint: uint8(2),          // FROM_NETWORK
+		SrcPort:          uint16(40000),
+		DstPort:          uint16(80),
+	}
+	bpfEvent.TunnelMetadata.SrcIp = uint32(67152064) // 192.168.0.4
+	bpfEvent.TunnelMetadata.DstIp = uint32(83929280) // 192.168.0.5
+	bpfEvent.TunnelMetadata.Vni = 1
+	bpfEvent.TunnelMetadata.TunnelType = uint8(utils.TunnelType_TUNNEL_VXLAN)
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, &bpfEvent))
+
+	p := &packetParser{
+		cfg:             cfgPodLevelEnabled,
+		l:               log.Logger().Named("test"),
+		recordsChannel:  make(chan perf.Record, 1),
+		externalChannel: make(chan *v1.Event, 1),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p.wg.Add(1)
+	go p.processRecord(ctx, 0)
+	p.recordsChannel <- perf.Record{RawSample: buf.Bytes()}
+
+	select {
+	case ev := <-p.externalChannel:
+		fl := ev.GetFlow()
+		assert.Equal(t, "10.244.1.5", fl.GetIP().GetSource())
+		assert.Equal(t, "10.244.2.7", fl.GetIP().GetDestination())
+		tunnelType, src, dst, vni := utils.Tunnel(fl)
+		assert.Equal(t, utils.TunnelType_TUNNEL_VXLAN, tunnelType)
+		assert.Equal(t, "192.168.0.4", src)
+		assert.Equal(t, "192.168.0.5", dst)
+		assert.EqualValues(t, 1, vni)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a flow")
+	}
+	cancel()
+	p.wg.Wait()
+}
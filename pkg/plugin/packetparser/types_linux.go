@@ -4,6 +4,7 @@
 package packetparser
 
 import (
+	"regexp"
 	"sync"
 
 	kcfg "github.com/microsoft/retina/pkg/config"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/microsoft/retina/pkg/enricher"
 	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
 )
 
 const (
@@ -63,6 +65,9 @@ var (
 	getFD = func(e *ebpf.Program) int {
 		return e.FD()
 	}
+	getDefaultOutgoingLinks = utils.GetDefaultOutgoingLinks
+	listLinks               = netlink.LinkList
+	loadPrecompiled         = loadPacketparser //nolint:typecheck
 	// Determined via testing on a large cluster.
 	// Actual buffer size will be 32 * pagesize.
 	perCPUBuffer = 32
@@ -75,8 +80,9 @@ type tcKey struct {
 }
 
 type tcValue struct {
-	tc    nltc
-	qdisc *tc.Object
+	tc        nltc
+	qdisc     *tc.Object
+	ifaceType interfaceType
 }
 
 //go:generate go run go.uber.org/mock/mockgen@v0.4.0 -source=types_linux.go -destination=mocks/mock_types.go -package=mocks
@@ -109,7 +115,15 @@ type packetParser struct {
 	cfg        *kcfg.Config
 	l          *log.ZapLogger
 	callbackID string
-	objs       *packetparserObjects //nolint:typecheck
+	// linkCallbackID is the ID of the callback of the link watcher, subscribed when attaching to the links of the node.
+	linkCallbackID string
+	// interfaceRegex matches the names of the links of the node to attach to, in addition to the default outgoing
+	// links. It is nil if not configured.
+	interfaceRegex *regexp.Regexp
+	objs           *packetparserObjects //nolint:typecheck
+	// spec is the spec of the precompiled object with the settings of the plugin, nil if the object must be compiled
+	// at runtime.
+	spec *ebpf.CollectionSpec
 	// tcMap is a map of key to *val.
 	tcMap    *sync.Map
 	reader   perfReader
@@ -123,6 +137,8 @@ type packetParser struct {
 	wg                  sync.WaitGroup
 	recordsChannel      chan perf.Record
 	externalChannel     chan *v1.Event
+	// http is nil unless the HTTP parser is enabled.
+	http *httpParser
 }
 
 func ifaceToKey(iface netlink.LinkAttrs) tcKey {
@@ -16,10 +16,11 @@ const (
 type Plugin interface {
 	// Name returns the name of the plugin.
 	Name() string
-	// Generate generates the plugin specific header files.
+	// Generate prepares the precompiled bpf object with the plugin specific settings, or generates the plugin
+	// specific header files to compile the eBPF code at runtime if the precompiled object cannot be used.
 	// This may be no-op for plugins that don't use eBPF.
 	Generate(ctx context.Context) error
-	// Compile compiles the eBPF code to generate bpf object.
+	// Compile compiles the eBPF code to generate bpf object, if the precompiled object cannot be used.
 	// This may be no-op for plugins that don't use eBPF.
 	Compile(ctx context.Context) error
 	// Init initializes plugin specific objects. Depend on a given configuration, it may initialize eBPF maps, etc.
@@ -19,8 +19,9 @@ import (
 )
 
 type Server struct {
-	l   *log.ZapLogger
-	mux *chi.Mux
+	l      *log.ZapLogger
+	mux    *chi.Mux
+	traces *traceStore
 }
 
 func New(logger *log.ZapLogger) *Server {
@@ -33,8 +34,9 @@ func New(logger *log.ZapLogger) *Server {
 	)
 
 	return &Server{
-		l:   logger,
-		mux: r,
+		l:      logger,
+		mux:    r,
+		traces: newTraceStore(logger, enricherFlowReader),
 	}
 }
 
@@ -44,6 +46,9 @@ func (rt *Server) SetupHandlers() {
 	exporter.RegisterMetricsServeCallback(func() {
 		rt.servePrometheusMetrics()
 	})
+	rt.mux.Post("/trace", rt.startTrace)
+	rt.mux.Get("/trace/{operationid}", rt.getTrace)
+	rt.mux.Post("/trace/{operationid}", rt.stopTrace)
 	rt.mux.HandleFunc("/debug/pprof/", pprof.Index)
 	rt.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
 	rt.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
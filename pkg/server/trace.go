@@ -0,0 +1,373 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/container"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/microsoft/retina/pkg/api"
+	"github.com/microsoft/retina/pkg/enricher"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultTraceDuration = 30 * time.Second
+	maxTraceDuration     = 10 * time.Minute
+
+	// maxTraceFlows bounds the flows kept per operation, flows past it are dropped.
+	maxTraceFlows = 10000
+
+	// maxTraceOperations bounds the operations kept in memory,
+	// the oldest finished operation is evicted to make room for a new one.
+	maxTraceOperations = 32
+)
+
+var (
+	errTraceUnavailable   = errors.New("enricher is not initialized, enable pod level metrics to trace flows")
+	errTooManyOperations  = errors.New("too many trace operations are running")
+	errOperationExists    = errors.New("trace operation already exists")
+	errOperationNotFound  = errors.New("trace operation not found")
+	errInvalidTraceFilter = errors.New("invalid trace filter")
+)
+
+// flowReaderFunc returns a new reader of the enriched flows.
+type flowReaderFunc func() (*container.RingReader, error)
+
+func enricherFlowReader() (*container.RingReader, error) {
+	if !enricher.IsInitialized() {
+		return nil, errTraceUnavailable
+	}
+	return enricher.Instance().ExportReader(), nil
+}
+
+// traceOperation collects the flows matching its filter until its duration elapses or it is stopped.
+type traceOperation struct {
+	sync.Mutex
+	id      string
+	filter  string
+	match   flowFilter
+	result  string
+	flows   []json.RawMessage
+	cancel  context.CancelFunc
+	created time.Time
+}
+
+func (op *traceOperation) trace() *api.Trace {
+	op.Lock()
+	defer op.Unlock()
+
+	flows := make([]json.RawMessage, len(op.flows))
+	copy(flows, op.flows)
+	return &api.Trace{
+		Operation:   api.OperationStart,
+		OperationID: op.id,
+		Filter:      op.filter,
+		Result:      op.result,
+		Flows:       flows,
+	}
+}
+
+func (op *traceOperation) collect(ctx context.Context, reader *container.RingReader, l *log.ZapLogger) {
+	for {
+		ev := reader.NextFollow(ctx)
+		if ev == nil {
+			break
+		}
+
+		f, ok := ev.Event.(*flow.Flow)
+		if !ok || !op.match(f) {
+			continue
+		}
+
+		b, err := protojson.Marshal(f)
+		if err != nil {
+			l.Error("failed to marshal traced flow", zap.String("operationID", op.id), zap.Error(err))
+			continue
+		}
+
+		op.Lock()
+		if len(op.flows) < maxTraceFlows {
+			op.flows = append(op.flows, b)
+		}
+		op.Unlock()
+	}
+
+	if err := reader.Close(); err != nil {
+		l.Error("failed to close trace reader", zap.String("operationID", op.id), zap.Error(err))
+	}
+
+	op.Lock()
+	if op.result == api.TraceRunning {
+		op.result = api.TraceCompleted
+	}
+	op.Unlock()
+}
+
+func (op *traceOperation) stop() {
+	op.Lock()
+	if op.result == api.TraceRunning {
+		op.result = api.TraceStopped
+	}
+	op.Unlock()
+	op.cancel()
+}
+
+func (op *traceOperation) running() bool {
+	op.Lock()
+	defer op.Unlock()
+	return op.result == api.TraceRunning
+}
+
+// traceStore keeps the trace operations of the agent in memory.
+type traceStore struct {
+	sync.Mutex
+	l         *log.ZapLogger
+	ops       map[string]*traceOperation
+	newReader flowReaderFunc
+}
+
+func newTraceStore(l *log.ZapLogger, newReader flowReaderFunc) *traceStore {
+	return &traceStore{
+		l:         l,
+		ops:       make(map[string]*traceOperation),
+		newReader: newReader,
+	}
+}
+
+func (s *traceStore) start(req *api.TraceOperation) (*traceOperation, error) {
+	match, err := parseFlowFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := defaultTraceDuration
+	if req.Duration != "" {
+		duration, err = time.ParseDuration(req.Duration)
+		if err != nil || duration <= 0 || duration > maxTraceDuration {
+			return nil, fmt.Errorf("invalid duration %q, must be a positive duration up to %s", req.Duration, maxTraceDuration)
+		}
+	}
+
+	id := req.OperationID
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.ops[id]; ok {
+		return nil, errOperationExists
+	}
+	if len(s.ops) >= maxTraceOperations && !s.evictOldest() {
+		return nil, errTooManyOperations
+	}
+
+	reader, err := s.newReader()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	op := &traceOperation{
+		id:      id,
+		filter:  req.Filter,
+		match:   match,
+		result:  api.TraceRunning,
+		cancel:  cancel,
+		created: time.Now(),
+	}
+	s.ops[id] = op
+
+	s.l.Info("starting trace", zap.String("operationID", id), zap.String("filter", req.Filter), zap.Duration("duration", duration))
+	go op.collect(ctx, reader, s.l)
+	return op, nil
+}
+
+// evictOldest removes the oldest finished operation. Caller must hold the lock.
+func (s *traceStore) evictOldest() bool {
+	var oldest *traceOperation
+	for _, op := range s.ops {
+		if op.running() {
+			continue
+		}
+		if oldest == nil || op.created.Before(oldest.created) {
+			oldest = op
+		}
+	}
+	if oldest == nil {
+		return false
+	}
+	delete(s.ops, oldest.id)
+	return true
+}
+
+func (s *traceStore) get(id string) (*traceOperation, bool) {
+	s.Lock()
+	defer s.Unlock()
+	op, ok := s.ops[id]
+	return op, ok
+}
+
+func (rt *Server) startTrace(w http.ResponseWriter, r *http.Request) {
+	req := &api.TraceOperation{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if req.Operation != api.OperationStart {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported operation %q", req.Operation))
+		return
+	}
+
+	op, err := rt.traces.start(req)
+	switch {
+	case err == nil:
+		writeJSON(w, http.StatusAccepted, op.trace())
+	case errors.Is(err, errOperationExists):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, errTraceUnavailable), errors.Is(err, errTooManyOperations):
+		writeError(w, http.StatusInternalServerError, err.Error())
+	default:
+		writeError(w, http.StatusBadRequest, err.Error())
+	}
+}
+
+func (rt *Server) getTrace(w http.ResponseWriter, r *http.Request) {
+	op, ok := rt.traces.get(chi.URLParam(r, "operationid"))
+	if !ok {
+		writeError(w, http.StatusNotFound, errOperationNotFound.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, op.trace())
+}
+
+func (rt *Server) stopTrace(w http.ResponseWriter, r *http.Request) {
+	op, ok := rt.traces.get(chi.URLParam(r, "operationid"))
+	if !ok {
+		writeError(w, http.StatusNotFound, errOperationNotFound.Error())
+		return
+	}
+	op.stop()
+
+	t := op.trace()
+	t.Operation = api.OperationStop
+	writeJSON(w, http.StatusOK, t)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, &api.Error{Code: int32(code), Message: msg})
+}
+
+// flowFilter returns true if the flow should be collected.
+type flowFilter func(f *flow.Flow) bool
+
+// parseFlowFilter parses a filter made of space separated key=value terms that must all match.
+// An empty filter matches every flow.
+func parseFlowFilter(filter string) (flowFilter, error) {
+	matchers := make([]flowFilter, 0)
+	for _, term := range strings.Fields(filter) {
+		key, value, ok := strings.Cut(term, "=")
+		if !ok || value == "" {
+			return nil, errors.Wrapf(errInvalidTraceFilter, "term %q is not key=value", term)
+		}
+
+		var m flowFilter
+		switch strings.ToLower(key) {
+		case "ip":
+			m = func(f *flow.Flow) bool {
+				return f.GetIP().GetSource() == value || f.GetIP().GetDestination() == value
+			}
+		case "srcip":
+			m = func(f *flow.Flow) bool { return f.GetIP().GetSource() == value }
+		case "dstip":
+			m = func(f *flow.Flow) bool { return f.GetIP().GetDestination() == value }
+		case "port", "srcport", "dstport":
+			port, err := strconv.ParseUint(value, 10, 16)
+			if err != nil {
+				return nil, errors.Wrapf(errInvalidTraceFilter, "invalid port %q", value)
+			}
+			p := uint32(port)
+			switch strings.ToLower(key) {
+			case "srcport":
+				m = func(f *flow.Flow) bool { src, _ := ports(f); return src == p }
+			case "dstport":
+				m = func(f *flow.Flow) bool { _, dst := ports(f); return dst == p }
+			default:
+				m = func(f *flow.Flow) bool { src, dst := ports(f); return src == p || dst == p }
+			}
+		case "protocol":
+			m = func(f *flow.Flow) bool { return strings.EqualFold(protocol(f), value) }
+		case "namespace":
+			m = func(f *flow.Flow) bool {
+				return f.GetSource().GetNamespace() == value || f.GetDestination().GetNamespace() == value
+			}
+		case "pod":
+			m = func(f *flow.Flow) bool {
+				return f.GetSource().GetPodName() == value || f.GetDestination().GetPodName() == value
+			}
+		default:
+			return nil, errors.Wrapf(errInvalidTraceFilter, "unknown key %q", key)
+		}
+		matchers = append(matchers, m)
+	}
+
+	return func(f *flow.Flow) bool {
+		for _, m := range matchers {
+			if !m(f) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func ports(f *flow.Flow) (src, dst uint32) {
+	switch {
+	case f.GetL4().GetTCP() != nil:
+		return f.GetL4().GetTCP().GetSourcePort(), f.GetL4().GetTCP().GetDestinationPort()
+	case f.GetL4().GetUDP() != nil:
+		return f.GetL4().GetUDP().GetSourcePort(), f.GetL4().GetUDP().GetDestinationPort()
+	case f.GetL4().GetSCTP() != nil:
+		return f.GetL4().GetSCTP().GetSourcePort(), f.GetL4().GetSCTP().GetDestinationPort()
+	default:
+		return 0, 0
+	}
+}
+
+func protocol(f *flow.Flow) string {
+	switch {
+	case f.GetL4().GetTCP() != nil:
+		return "TCP"
+	case f.GetL4().GetUDP() != nil:
+		return "UDP"
+	case f.GetL4().GetSCTP() != nil:
+		return "SCTP"
+	case f.GetL4().GetICMPv4() != nil:
+		return "ICMP"
+	case f.GetL4().GetICMPv6() != nil:
+		return "ICMPv6"
+	default:
+		return ""
+	}
+}
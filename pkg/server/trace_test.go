@@ -0,0 +1,235 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/container"
+	"github.com/microsoft/retina/pkg/api"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTraceTestServer(t *testing.T, newReader flowReaderFunc) *httptest.Server {
+	t.Helper()
+	_, err := log.SetupZapLogger(log.GetDefaultLogOpts())
+	require.NoError(t, err)
+
+	s := New(log.Logger().Named("http-server"))
+	s.traces = newTraceStore(s.l, newReader)
+	s.SetupHandlers()
+
+	ts := httptest.NewServer(s.mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func doTrace(t *testing.T, method, url string, body interface{}) (int, *api.Trace, *api.Error) {
+	t.Helper()
+	var b []byte
+	if body != nil {
+		var err error
+		b, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+	req, err := http.NewRequest(method, url, bytes.NewReader(b))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		apiErr := &api.Error{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(apiErr))
+		return resp.StatusCode, nil, apiErr
+	}
+	tr := &api.Trace{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(tr))
+	return resp.StatusCode, tr, nil
+}
+
+func tcpFlow(src, dst string, dstPort uint32) *v1.Event {
+	return &v1.Event{Event: &flow.Flow{
+		IP: &flow.IP{Source: src, Destination: dst},
+		L4: &flow.Layer4{Protocol: &flow.Layer4_TCP{TCP: &flow.TCP{SourcePort: 40000, DestinationPort: dstPort}}},
+	}}
+}
+
+func TestTraceLifecycle(t *testing.T) {
+	ring := container.NewRing(container.Capacity63)
+	ts := newTraceTestServer(t, func() (*container.RingReader, error) {
+		return container.NewRingReader(ring, ring.OldestWrite()), nil
+	})
+
+	code, tr, _ := doTrace(t, http.MethodPost, ts.URL+"/trace", &api.TraceOperation{
+		Operation:   api.OperationStart,
+		OperationID: "op1",
+		Filter:      "ip=10.0.0.1 protocol=tcp dstport=80",
+		Duration:    "1m",
+	})
+	require.Equal(t, http.StatusAccepted, code)
+	assert.Equal(t, "op1", tr.OperationID)
+	assert.Equal(t, api.TraceRunning, tr.Result)
+
+	ring.Write(tcpFlow("10.0.0.1", "10.0.0.2", 80))
+	ring.Write(tcpFlow("10.0.0.1", "10.0.0.2", 443))
+	ring.Write(tcpFlow("10.0.0.3", "10.0.0.2", 80))
+	// The last write of the ring is not readable until the next one.
+	ring.Write(tcpFlow("10.0.0.3", "10.0.0.2", 80))
+
+	require.Eventually(t, func() bool {
+		_, tr, _ = doTrace(t, http.MethodGet, ts.URL+"/trace/op1", nil)
+		return len(tr.Flows) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	f := &flow.Flow{}
+	require.NoError(t, json.Unmarshal(tr.Flows[0], f))
+	assert.Equal(t, "10.0.0.1", f.GetIP().GetSource())
+	assert.Equal(t, uint32(80), f.GetL4().GetTCP().GetDestinationPort())
+
+	code, tr, _ = doTrace(t, http.MethodPost, ts.URL+"/trace/op1", nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, api.OperationStop, tr.Operation)
+	assert.Equal(t, api.TraceStopped, tr.Result)
+	assert.Len(t, tr.Flows, 1)
+
+	code, _, _ = doTrace(t, http.MethodPost, ts.URL+"/trace", &api.TraceOperation{Operation: api.OperationStart, OperationID: "op1"})
+	assert.Equal(t, http.StatusConflict, code)
+}
+
+func TestTraceCompletes(t *testing.T) {
+	ring := container.NewRing(container.Capacity63)
+	ts := newTraceTestServer(t, func() (*container.RingReader, error) {
+		return container.NewRingReader(ring, ring.OldestWrite()), nil
+	})
+
+	code, tr, _ := doTrace(t, http.MethodPost, ts.URL+"/trace", &api.TraceOperation{
+		Operation: api.OperationStart,
+		Duration:  "100ms",
+	})
+	require.Equal(t, http.StatusAccepted, code)
+	require.NotEmpty(t, tr.OperationID, "operation ID should be generated")
+
+	require.Eventually(t, func() bool {
+		_, tr, _ = doTrace(t, http.MethodGet, ts.URL+"/trace/"+tr.OperationID, nil)
+		return tr.Result == api.TraceCompleted
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestTraceErrors(t *testing.T) {
+	ring := container.NewRing(container.Capacity63)
+	ts := newTraceTestServer(t, func() (*container.RingReader, error) {
+		return container.NewRingReader(ring, ring.OldestWrite()), nil
+	})
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     interface{}
+		wantCode int
+	}{
+		{
+			name:     "unknown operation",
+			method:   http.MethodGet,
+			path:     "/trace/unknown",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "stop unknown operation",
+			method:   http.MethodPost,
+			path:     "/trace/unknown",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "unsupported operation",
+			method:   http.MethodPost,
+			path:     "/trace",
+			body:     &api.TraceOperation{Operation: "pause"},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid filter key",
+			method:   http.MethodPost,
+			path:     "/trace",
+			body:     &api.TraceOperation{Operation: api.OperationStart, Filter: "host=foo"},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid filter port",
+			method:   http.MethodPost,
+			path:     "/trace",
+			body:     &api.TraceOperation{Operation: api.OperationStart, Filter: "port=http"},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid duration",
+			method:   http.MethodPost,
+			path:     "/trace",
+			body:     &api.TraceOperation{Operation: api.OperationStart, Duration: "1h"},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, apiErr := doTrace(t, tt.method, ts.URL+tt.path, tt.body)
+			assert.Equal(t, tt.wantCode, code)
+			require.NotNil(t, apiErr)
+			assert.Equal(t, int32(tt.wantCode), apiErr.Code)
+			assert.NotEmpty(t, apiErr.Message)
+		})
+	}
+}
+
+func TestTraceUnavailable(t *testing.T) {
+	ts := newTraceTestServer(t, func() (*container.RingReader, error) {
+		return nil, errTraceUnavailable
+	})
+
+	code, _, apiErr := doTrace(t, http.MethodPost, ts.URL+"/trace", &api.TraceOperation{Operation: api.OperationStart})
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, errTraceUnavailable.Error(), apiErr.Message)
+}
+
+func TestParseFlowFilter(t *testing.T) {
+	f := &flow.Flow{
+		IP:          &flow.IP{Source: "10.0.0.1", Destination: "fd00::2"},
+		L4:          &flow.Layer4{Protocol: &flow.Layer4_UDP{UDP: &flow.UDP{SourcePort: 5353, DestinationPort: 53}}},
+		Source:      &flow.Endpoint{Namespace: "ns1", PodName: "client"},
+		Destination: &flow.Endpoint{Namespace: "kube-system", PodName: "coredns"},
+	}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"", true},
+		{"ip=fd00::2", true},
+		{"srcip=10.0.0.1 dstip=fd00::2", true},
+		{"srcip=fd00::2", false},
+		{"port=5353", true},
+		{"dstport=53 protocol=UDP", true},
+		{"srcport=53", false},
+		{"protocol=tcp", false},
+		{"namespace=kube-system pod=client", true},
+		{"namespace=default", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			match, err := parseFlowFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, match(f))
+		})
+	}
+}
@@ -85,6 +85,12 @@ var (
 	// DNS labels.
 	DNSRequestLabels  = []string{"query_type", "query"}
 	DNSResponseLabels = []string{"return_code", "query_type", "query", "response", "num_response"}
+
+	// HTTP labels.
+	HTTPLabels = []string{"method", "status_code"}
+
+	// ICMP labels.
+	ICMPLabels = []string{"type", "code"}
 )
 
 func GetPluginEventAttributes(attrs []attribute.KeyValue, pluginName, eventName, timestamp string) []attribute.KeyValue {
@@ -24,8 +24,9 @@ const (
 
 // ToFlow returns a flow.Flow object.
 // This sets up a L3/L4 flow object.
-// sourceIP, destIP are IPv4 addresses.
-// sourcePort, destPort are TCP/UDP ports.
+// sourceIP, destIP are IPv4 or IPv6 addresses of the same family. If the families differ,
+// or either address is nil, a warning is logged and the IP version is set to IP_NOT_USED.
+// sourcePort, destPort are TCP/UDP ports, and are ignored for ICMP.
 // proto is the protocol number. Ref: https://www.iana.org/assignments/protocol-numbers/protocol-numbers.xhtml .
 // observationPoint is the observation point+direction of the flow. 0 is from n/w stack to container, 1 is from container to stack,
 // 2 is from host to network and 3 is from network to host.
@@ -59,6 +60,13 @@ func ToFlow(
 				},
 			},
 		}
+	case 1:
+		// The ICMP type and code are added by AddICMP.
+		l4 = &flow.Layer4{
+			Protocol: &flow.Layer4_ICMPv4{
+				ICMPv4: &flow.ICMPv4{},
+			},
+		}
 	}
 
 	var (
@@ -95,6 +103,15 @@ func ToFlow(
 		verdict = flow.Verdict_FORWARDED
 	}
 
+	ipVersion := ipVersionOf(sourceIP)
+	if dstVersion := ipVersionOf(destIP); ipVersion != dstVersion {
+		l.Warn("Source and destination IP families do not match",
+			zap.Stringer("sourceIP", sourceIP),
+			zap.Stringer("destIP", destIP),
+		)
+		ipVersion = flow.IPVersion_IP_NOT_USED
+	}
+
 	ext, _ := anypb.New(&RetinaMetadata{}) //nolint:typecheck
 
 	f := &flow.Flow{
@@ -106,8 +123,7 @@ func ToFlow(
 		IP: &flow.IP{
 			Source:      sourceIP.String(),
 			Destination: destIP.String(),
-			// We only support IPv4 for now.
-			IpVersion: flow.IPVersion_IPv4,
+			IpVersion:   ipVersion,
 		},
 		L4:                    l4,
 		TraceObservationPoint: checkpoint,
@@ -127,6 +143,19 @@ func ToFlow(
 	return f
 }
 
+// ipVersionOf returns the flow IP version of the given address,
+// or IP_NOT_USED if it is not a valid IPv4 or IPv6 address.
+func ipVersionOf(ip net.IP) flow.IPVersion {
+	switch {
+	case ip.To4() != nil:
+		return flow.IPVersion_IPv4
+	case ip.To16() != nil:
+		return flow.IPVersion_IPv6
+	default:
+		return flow.IPVersion_IP_NOT_USED
+	}
+}
+
 // AddRetinaMetadata adds the RetinaMetadata to the flow's extensions field.
 func AddRetinaMetadata(f *flow.Flow, meta *RetinaMetadata) {
 	ext, _ := anypb.New(meta)
@@ -163,6 +192,16 @@ func AddTCPFlagsBool(f *flow.Flow, syn, ack, fin, rst, psh, urg bool) {
 	}
 }
 
+// AddICMP adds the type and code of the ICMP message to the flow.
+func AddICMP(f *flow.Flow, icmpType, code uint32) {
+	icmp := f.GetL4().GetICMPv4()
+	if icmp == nil {
+		return
+	}
+	icmp.Type = icmpType
+	icmp.Code = code
+}
+
 // Add TSval/TSecr to the flow's metadata as TCP ID.
 // The TSval/TSecr works as ID for the flow.
 // We will use this ID to calculate latency.
@@ -182,6 +221,24 @@ func GetTCPID(f *flow.Flow) uint64 {
 	return k.TcpId
 }
 
+// AddDNSID adds the DNS transaction ID to the flow's metadata.
+func AddDNSID(meta *RetinaMetadata, id uint32) {
+	if meta == nil {
+		return
+	}
+	meta.DnsId = id
+}
+
+// GetDNSID returns the DNS transaction ID of the flow, 0 if not set.
+func GetDNSID(f *flow.Flow) uint32 {
+	if f.GetExtensions() == nil {
+		return 0
+	}
+	k := &RetinaMetadata{}      //nolint:typecheck
+	f.Extensions.UnmarshalTo(k) //nolint:errcheck
+	return k.GetDnsId()
+}
+
 // AddDNSInfo adds DNS information to the flow's metadata.
 func AddDNSInfo(f *flow.Flow, meta *RetinaMetadata, qType string, rCode uint32, query string, qTypes []string, numAnswers int, ips []string) {
 	if f == nil || meta == nil {
@@ -219,6 +276,35 @@ func AddDNSInfo(f *flow.Flow, meta *RetinaMetadata, qType string, rCode uint32,
 	meta.NumResponses = uint32(numAnswers)
 }
 
+// AddHTTPInfo adds the HTTP request or response to the flow.
+// latencyNs is the time between the request and the response, for responses only.
+func AddHTTPInfo(f *flow.Flow, l7Type flow.L7FlowType, method, url, protocol string, code uint32, latencyNs uint64) {
+	if f == nil {
+		return
+	}
+	// Set type to L7.
+	f.Type = flow.FlowType_L7
+	// Reset Eventtype if already set at L3/4 level.
+	f.EventType = &flow.CiliumEventType{
+		Type: int32(api.MessageTypeNames[api.MessageTypeNameL7]),
+	}
+	f.L7 = &flow.Layer7{
+		Type:      l7Type,
+		LatencyNs: latencyNs,
+		Record: &flow.Layer7_Http{
+			Http: &flow.HTTP{
+				Code:     code,
+				Method:   method,
+				Url:      url,
+				Protocol: protocol,
+			},
+		},
+	}
+	if l7Type == flow.L7FlowType_RESPONSE {
+		f.IsReply = &wrapperspb.BoolValue{Value: true}
+	}
+}
+
 func GetDNS(f *flow.Flow) (*flow.DNS, DNSType, uint32) {
 	if f == nil || f.L7 == nil || f.L7.GetDns() == nil {
 		return nil, DNSType_UNKNOWN, 0
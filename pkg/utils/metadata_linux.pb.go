@@ -80,27 +80,324 @@ const (
 	DropReason_TCP_CLOSE_BASIC    DropReason = 4
 	DropReason_CONNTRACK_ADD_DROP DropReason = 5
 	DropReason_UNKNOWN_DROP       DropReason = 6
+	// Values of the enum skb_drop_reason of the kernel, reported by the skb:kfree_skb tracepoint.
+	// The values of the kernel differ between kernel versions and are mapped by name.
+	// Ref: include/net/dropreason-core.h of the kernel.
+	DropReason_SKB_DROP_REASON_NOT_SPECIFIED            DropReason = 100
+	DropReason_SKB_DROP_REASON_NO_SOCKET                DropReason = 101
+	DropReason_SKB_DROP_REASON_PKT_TOO_SMALL            DropReason = 102
+	DropReason_SKB_DROP_REASON_TCP_CSUM                 DropReason = 103
+	DropReason_SKB_DROP_REASON_SOCKET_FILTER            DropReason = 104
+	DropReason_SKB_DROP_REASON_UDP_CSUM                 DropReason = 105
+	DropReason_SKB_DROP_REASON_NETFILTER_DROP           DropReason = 106
+	DropReason_SKB_DROP_REASON_OTHERHOST                DropReason = 107
+	DropReason_SKB_DROP_REASON_IP_CSUM                  DropReason = 108
+	DropReason_SKB_DROP_REASON_IP_INHDR                 DropReason = 109
+	DropReason_SKB_DROP_REASON_IP_RPFILTER              DropReason = 110
+	DropReason_SKB_DROP_REASON_UNICAST_IN_L2_MULTICAST  DropReason = 111
+	DropReason_SKB_DROP_REASON_XFRM_POLICY              DropReason = 112
+	DropReason_SKB_DROP_REASON_IP_NOPROTO               DropReason = 113
+	DropReason_SKB_DROP_REASON_SOCKET_RCVBUFF           DropReason = 114
+	DropReason_SKB_DROP_REASON_PROTO_MEM                DropReason = 115
+	DropReason_SKB_DROP_REASON_TCP_AUTH_HDR             DropReason = 116
+	DropReason_SKB_DROP_REASON_TCP_MD5NOTFOUND          DropReason = 117
+	DropReason_SKB_DROP_REASON_TCP_MD5UNEXPECTED        DropReason = 118
+	DropReason_SKB_DROP_REASON_TCP_MD5FAILURE           DropReason = 119
+	DropReason_SKB_DROP_REASON_TCP_AONOTFOUND           DropReason = 120
+	DropReason_SKB_DROP_REASON_TCP_AOUNEXPECTED         DropReason = 121
+	DropReason_SKB_DROP_REASON_TCP_AOKEYNOTFOUND        DropReason = 122
+	DropReason_SKB_DROP_REASON_TCP_AOFAILURE            DropReason = 123
+	DropReason_SKB_DROP_REASON_SOCKET_BACKLOG           DropReason = 124
+	DropReason_SKB_DROP_REASON_TCP_FLAGS                DropReason = 125
+	DropReason_SKB_DROP_REASON_TCP_ZEROWINDOW           DropReason = 126
+	DropReason_SKB_DROP_REASON_TCP_OLD_DATA             DropReason = 127
+	DropReason_SKB_DROP_REASON_TCP_OVERWINDOW           DropReason = 128
+	DropReason_SKB_DROP_REASON_TCP_OFOMERGE             DropReason = 129
+	DropReason_SKB_DROP_REASON_TCP_RFC7323_PAWS         DropReason = 130
+	DropReason_SKB_DROP_REASON_TCP_OLD_SEQUENCE         DropReason = 131
+	DropReason_SKB_DROP_REASON_TCP_INVALID_SEQUENCE     DropReason = 132
+	DropReason_SKB_DROP_REASON_TCP_INVALID_ACK_SEQUENCE DropReason = 133
+	DropReason_SKB_DROP_REASON_TCP_RESET                DropReason = 134
+	DropReason_SKB_DROP_REASON_TCP_INVALID_SYN          DropReason = 135
+	DropReason_SKB_DROP_REASON_TCP_CLOSE                DropReason = 136
+	DropReason_SKB_DROP_REASON_TCP_FASTOPEN             DropReason = 137
+	DropReason_SKB_DROP_REASON_TCP_OLD_ACK              DropReason = 138
+	DropReason_SKB_DROP_REASON_TCP_TOO_OLD_ACK          DropReason = 139
+	DropReason_SKB_DROP_REASON_TCP_ACK_UNSENT_DATA      DropReason = 140
+	DropReason_SKB_DROP_REASON_TCP_OFO_QUEUE_PRUNE      DropReason = 141
+	DropReason_SKB_DROP_REASON_TCP_OFO_DROP             DropReason = 142
+	DropReason_SKB_DROP_REASON_IP_OUTNOROUTES           DropReason = 143
+	DropReason_SKB_DROP_REASON_BPF_CGROUP_EGRESS        DropReason = 144
+	DropReason_SKB_DROP_REASON_IPV6DISABLED             DropReason = 145
+	DropReason_SKB_DROP_REASON_NEIGH_CREATEFAIL         DropReason = 146
+	DropReason_SKB_DROP_REASON_NEIGH_FAILED             DropReason = 147
+	DropReason_SKB_DROP_REASON_NEIGH_QUEUEFULL          DropReason = 148
+	DropReason_SKB_DROP_REASON_NEIGH_DEAD               DropReason = 149
+	DropReason_SKB_DROP_REASON_TC_EGRESS                DropReason = 150
+	DropReason_SKB_DROP_REASON_SECURITY_HOOK            DropReason = 151
+	DropReason_SKB_DROP_REASON_QDISC_DROP               DropReason = 152
+	DropReason_SKB_DROP_REASON_CPU_BACKLOG              DropReason = 153
+	DropReason_SKB_DROP_REASON_XDP                      DropReason = 154
+	DropReason_SKB_DROP_REASON_TC_INGRESS               DropReason = 155
+	DropReason_SKB_DROP_REASON_UNHANDLED_PROTO          DropReason = 156
+	DropReason_SKB_DROP_REASON_SKB_CSUM                 DropReason = 157
+	DropReason_SKB_DROP_REASON_SKB_GSO_SEG              DropReason = 158
+	DropReason_SKB_DROP_REASON_SKB_UCOPY_FAULT          DropReason = 159
+	DropReason_SKB_DROP_REASON_DEV_HDR                  DropReason = 160
+	DropReason_SKB_DROP_REASON_DEV_READY                DropReason = 161
+	DropReason_SKB_DROP_REASON_FULL_RING                DropReason = 162
+	DropReason_SKB_DROP_REASON_NOMEM                    DropReason = 163
+	DropReason_SKB_DROP_REASON_HDR_TRUNC                DropReason = 164
+	DropReason_SKB_DROP_REASON_TAP_FILTER               DropReason = 165
+	DropReason_SKB_DROP_REASON_TAP_TXFILTER             DropReason = 166
+	DropReason_SKB_DROP_REASON_ICMP_CSUM                DropReason = 167
+	DropReason_SKB_DROP_REASON_INVALID_PROTO            DropReason = 168
+	DropReason_SKB_DROP_REASON_IP_INADDRERRORS          DropReason = 169
+	DropReason_SKB_DROP_REASON_IP_INNOROUTES            DropReason = 170
+	DropReason_SKB_DROP_REASON_PKT_TOO_BIG              DropReason = 171
+	DropReason_SKB_DROP_REASON_DUP_FRAG                 DropReason = 172
+	DropReason_SKB_DROP_REASON_FRAG_REASM_TIMEOUT       DropReason = 173
+	DropReason_SKB_DROP_REASON_FRAG_TOO_FAR             DropReason = 174
+	DropReason_SKB_DROP_REASON_TCP_MINTTL               DropReason = 175
+	DropReason_SKB_DROP_REASON_IPV6_BAD_EXTHDR          DropReason = 176
+	DropReason_SKB_DROP_REASON_IPV6_NDISC_FRAG          DropReason = 177
+	DropReason_SKB_DROP_REASON_IPV6_NDISC_HOP_LIMIT     DropReason = 178
+	DropReason_SKB_DROP_REASON_IPV6_NDISC_BAD_CODE      DropReason = 179
+	DropReason_SKB_DROP_REASON_IPV6_NDISC_BAD_OPTIONS   DropReason = 180
+	DropReason_SKB_DROP_REASON_IPV6_NDISC_NS_OTHERHOST  DropReason = 181
+	DropReason_SKB_DROP_REASON_QUEUE_PURGE              DropReason = 182
+	DropReason_SKB_DROP_REASON_TC_COOKIE_ERROR          DropReason = 183
+	DropReason_SKB_DROP_REASON_PACKET_SOCK_ERROR        DropReason = 184
+	DropReason_SKB_DROP_REASON_TC_CHAIN_NOTFOUND        DropReason = 185
+	DropReason_SKB_DROP_REASON_TC_RECLASSIFY_LOOP       DropReason = 186
+	DropReason_SKB_DROP_REASON_VXLAN_INVALID_HDR        DropReason = 187
+	DropReason_SKB_DROP_REASON_VXLAN_VNI_NOT_FOUND      DropReason = 188
+	DropReason_SKB_DROP_REASON_MAC_INVALID_SOURCE       DropReason = 189
+	DropReason_SKB_DROP_REASON_VXLAN_ENTRY_EXISTS       DropReason = 190
+	DropReason_SKB_DROP_REASON_VXLAN_NO_REMOTE          DropReason = 191
+	DropReason_SKB_DROP_REASON_IP_TUNNEL_ECN            DropReason = 192
+	DropReason_SKB_DROP_REASON_TUNNEL_TXINFO            DropReason = 193
+	DropReason_SKB_DROP_REASON_LOCAL_MAC                DropReason = 194
+	DropReason_SKB_DROP_REASON_ARP_PVLAN_DISABLE        DropReason = 195
+	DropReason_SKB_DROP_REASON_MAC_IS_MULTICAST         DropReason = 196
+	DropReason_SKB_DROP_REASON_TCP_FILTER               DropReason = 197
 )
 
 // Enum value maps for DropReason.
 var (
 	DropReason_name = map[int32]string{
-		0: "IPTABLE_RULE_DROP",
-		1: "IPTABLE_NAT_DROP",
-		2: "TCP_CONNECT_BASIC",
-		3: "TCP_ACCEPT_BASIC",
-		4: "TCP_CLOSE_BASIC",
-		5: "CONNTRACK_ADD_DROP",
-		6: "UNKNOWN_DROP",
+		0:   "IPTABLE_RULE_DROP",
+		1:   "IPTABLE_NAT_DROP",
+		2:   "TCP_CONNECT_BASIC",
+		3:   "TCP_ACCEPT_BASIC",
+		4:   "TCP_CLOSE_BASIC",
+		5:   "CONNTRACK_ADD_DROP",
+		6:   "UNKNOWN_DROP",
+		100: "SKB_DROP_REASON_NOT_SPECIFIED",
+		101: "SKB_DROP_REASON_NO_SOCKET",
+		102: "SKB_DROP_REASON_PKT_TOO_SMALL",
+		103: "SKB_DROP_REASON_TCP_CSUM",
+		104: "SKB_DROP_REASON_SOCKET_FILTER",
+		105: "SKB_DROP_REASON_UDP_CSUM",
+		106: "SKB_DROP_REASON_NETFILTER_DROP",
+		107: "SKB_DROP_REASON_OTHERHOST",
+		108: "SKB_DROP_REASON_IP_CSUM",
+		109: "SKB_DROP_REASON_IP_INHDR",
+		110: "SKB_DROP_REASON_IP_RPFILTER",
+		111: "SKB_DROP_REASON_UNICAST_IN_L2_MULTICAST",
+		112: "SKB_DROP_REASON_XFRM_POLICY",
+		113: "SKB_DROP_REASON_IP_NOPROTO",
+		114: "SKB_DROP_REASON_SOCKET_RCVBUFF",
+		115: "SKB_DROP_REASON_PROTO_MEM",
+		116: "SKB_DROP_REASON_TCP_AUTH_HDR",
+		117: "SKB_DROP_REASON_TCP_MD5NOTFOUND",
+		118: "SKB_DROP_REASON_TCP_MD5UNEXPECTED",
+		119: "SKB_DROP_REASON_TCP_MD5FAILURE",
+		120: "SKB_DROP_REASON_TCP_AONOTFOUND",
+		121: "SKB_DROP_REASON_TCP_AOUNEXPECTED",
+		122: "SKB_DROP_REASON_TCP_AOKEYNOTFOUND",
+		123: "SKB_DROP_REASON_TCP_AOFAILURE",
+		124: "SKB_DROP_REASON_SOCKET_BACKLOG",
+		125: "SKB_DROP_REASON_TCP_FLAGS",
+		126: "SKB_DROP_REASON_TCP_ZEROWINDOW",
+		127: "SKB_DROP_REASON_TCP_OLD_DATA",
+		128: "SKB_DROP_REASON_TCP_OVERWINDOW",
+		129: "SKB_DROP_REASON_TCP_OFOMERGE",
+		130: "SKB_DROP_REASON_TCP_RFC7323_PAWS",
+		131: "SKB_DROP_REASON_TCP_OLD_SEQUENCE",
+		132: "SKB_DROP_REASON_TCP_INVALID_SEQUENCE",
+		133: "SKB_DROP_REASON_TCP_INVALID_ACK_SEQUENCE",
+		134: "SKB_DROP_REASON_TCP_RESET",
+		135: "SKB_DROP_REASON_TCP_INVALID_SYN",
+		136: "SKB_DROP_REASON_TCP_CLOSE",
+		137: "SKB_DROP_REASON_TCP_FASTOPEN",
+		138: "SKB_DROP_REASON_TCP_OLD_ACK",
+		139: "SKB_DROP_REASON_TCP_TOO_OLD_ACK",
+		140: "SKB_DROP_REASON_TCP_ACK_UNSENT_DATA",
+		141: "SKB_DROP_REASON_TCP_OFO_QUEUE_PRUNE",
+		142: "SKB_DROP_REASON_TCP_OFO_DROP",
+		143: "SKB_DROP_REASON_IP_OUTNOROUTES",
+		144: "SKB_DROP_REASON_BPF_CGROUP_EGRESS",
+		145: "SKB_DROP_REASON_IPV6DISABLED",
+		146: "SKB_DROP_REASON_NEIGH_CREATEFAIL",
+		147: "SKB_DROP_REASON_NEIGH_FAILED",
+		148: "SKB_DROP_REASON_NEIGH_QUEUEFULL",
+		149: "SKB_DROP_REASON_NEIGH_DEAD",
+		150: "SKB_DROP_REASON_TC_EGRESS",
+		151: "SKB_DROP_REASON_SECURITY_HOOK",
+		152: "SKB_DROP_REASON_QDISC_DROP",
+		153: "SKB_DROP_REASON_CPU_BACKLOG",
+		154: "SKB_DROP_REASON_XDP",
+		155: "SKB_DROP_REASON_TC_INGRESS",
+		156: "SKB_DROP_REASON_UNHANDLED_PROTO",
+		157: "SKB_DROP_REASON_SKB_CSUM",
+		158: "SKB_DROP_REASON_SKB_GSO_SEG",
+		159: "SKB_DROP_REASON_SKB_UCOPY_FAULT",
+		160: "SKB_DROP_REASON_DEV_HDR",
+		161: "SKB_DROP_REASON_DEV_READY",
+		162: "SKB_DROP_REASON_FULL_RING",
+		163: "SKB_DROP_REASON_NOMEM",
+		164: "SKB_DROP_REASON_HDR_TRUNC",
+		165: "SKB_DROP_REASON_TAP_FILTER",
+		166: "SKB_DROP_REASON_TAP_TXFILTER",
+		167: "SKB_DROP_REASON_ICMP_CSUM",
+		168: "SKB_DROP_REASON_INVALID_PROTO",
+		169: "SKB_DROP_REASON_IP_INADDRERRORS",
+		170: "SKB_DROP_REASON_IP_INNOROUTES",
+		171: "SKB_DROP_REASON_PKT_TOO_BIG",
+		172: "SKB_DROP_REASON_DUP_FRAG",
+		173: "SKB_DROP_REASON_FRAG_REASM_TIMEOUT",
+		174: "SKB_DROP_REASON_FRAG_TOO_FAR",
+		175: "SKB_DROP_REASON_TCP_MINTTL",
+		176: "SKB_DROP_REASON_IPV6_BAD_EXTHDR",
+		177: "SKB_DROP_REASON_IPV6_NDISC_FRAG",
+		178: "SKB_DROP_REASON_IPV6_NDISC_HOP_LIMIT",
+		179: "SKB_DROP_REASON_IPV6_NDISC_BAD_CODE",
+		180: "SKB_DROP_REASON_IPV6_NDISC_BAD_OPTIONS",
+		181: "SKB_DROP_REASON_IPV6_NDISC_NS_OTHERHOST",
+		182: "SKB_DROP_REASON_QUEUE_PURGE",
+		183: "SKB_DROP_REASON_TC_COOKIE_ERROR",
+		184: "SKB_DROP_REASON_PACKET_SOCK_ERROR",
+		185: "SKB_DROP_REASON_TC_CHAIN_NOTFOUND",
+		186: "SKB_DROP_REASON_TC_RECLASSIFY_LOOP",
+		187: "SKB_DROP_REASON_VXLAN_INVALID_HDR",
+		188: "SKB_DROP_REASON_VXLAN_VNI_NOT_FOUND",
+		189: "SKB_DROP_REASON_MAC_INVALID_SOURCE",
+		190: "SKB_DROP_REASON_VXLAN_ENTRY_EXISTS",
+		191: "SKB_DROP_REASON_VXLAN_NO_REMOTE",
+		192: "SKB_DROP_REASON_IP_TUNNEL_ECN",
+		193: "SKB_DROP_REASON_TUNNEL_TXINFO",
+		194: "SKB_DROP_REASON_LOCAL_MAC",
+		195: "SKB_DROP_REASON_ARP_PVLAN_DISABLE",
+		196: "SKB_DROP_REASON_MAC_IS_MULTICAST",
+		197: "SKB_DROP_REASON_TCP_FILTER",
 	}
 	DropReason_value = map[string]int32{
-		"IPTABLE_RULE_DROP":  0,
-		"IPTABLE_NAT_DROP":   1,
-		"TCP_CONNECT_BASIC":  2,
-		"TCP_ACCEPT_BASIC":   3,
-		"TCP_CLOSE_BASIC":    4,
-		"CONNTRACK_ADD_DROP": 5,
-		"UNKNOWN_DROP":       6,
+		"IPTABLE_RULE_DROP":                        0,
+		"IPTABLE_NAT_DROP":                         1,
+		"TCP_CONNECT_BASIC":                        2,
+		"TCP_ACCEPT_BASIC":                         3,
+		"TCP_CLOSE_BASIC":                          4,
+		"CONNTRACK_ADD_DROP":                       5,
+		"UNKNOWN_DROP":                             6,
+		"SKB_DROP_REASON_NOT_SPECIFIED":            100,
+		"SKB_DROP_REASON_NO_SOCKET":                101,
+		"SKB_DROP_REASON_PKT_TOO_SMALL":            102,
+		"SKB_DROP_REASON_TCP_CSUM":                 103,
+		"SKB_DROP_REASON_SOCKET_FILTER":            104,
+		"SKB_DROP_REASON_UDP_CSUM":                 105,
+		"SKB_DROP_REASON_NETFILTER_DROP":           106,
+		"SKB_DROP_REASON_OTHERHOST":                107,
+		"SKB_DROP_REASON_IP_CSUM":                  108,
+		"SKB_DROP_REASON_IP_INHDR":                 109,
+		"SKB_DROP_REASON_IP_RPFILTER":              110,
+		"SKB_DROP_REASON_UNICAST_IN_L2_MULTICAST":  111,
+		"SKB_DROP_REASON_XFRM_POLICY":              112,
+		"SKB_DROP_REASON_IP_NOPROTO":               113,
+		"SKB_DROP_REASON_SOCKET_RCVBUFF":           114,
+		"SKB_DROP_REASON_PROTO_MEM":                115,
+		"SKB_DROP_REASON_TCP_AUTH_HDR":             116,
+		"SKB_DROP_REASON_TCP_MD5NOTFOUND":          117,
+		"SKB_DROP_REASON_TCP_MD5UNEXPECTED":        118,
+		"SKB_DROP_REASON_TCP_MD5FAILURE":           119,
+		"SKB_DROP_REASON_TCP_AONOTFOUND":           120,
+		"SKB_DROP_REASON_TCP_AOUNEXPECTED":         121,
+		"SKB_DROP_REASON_TCP_AOKEYNOTFOUND":        122,
+		"SKB_DROP_REASON_TCP_AOFAILURE":            123,
+		"SKB_DROP_REASON_SOCKET_BACKLOG":           124,
+		"SKB_DROP_REASON_TCP_FLAGS":                125,
+		"SKB_DROP_REASON_TCP_ZEROWINDOW":           126,
+		"SKB_DROP_REASON_TCP_OLD_DATA":             127,
+		"SKB_DROP_REASON_TCP_OVERWINDOW":           128,
+		"SKB_DROP_REASON_TCP_OFOMERGE":             129,
+		"SKB_DROP_REASON_TCP_RFC7323_PAWS":         130,
+		"SKB_DROP_REASON_TCP_OLD_SEQUENCE":         131,
+		"SKB_DROP_REASON_TCP_INVALID_SEQUENCE":     132,
+		"SKB_DROP_REASON_TCP_INVALID_ACK_SEQUENCE": 133,
+		"SKB_DROP_REASON_TCP_RESET":                134,
+		"SKB_DROP_REASON_TCP_INVALID_SYN":          135,
+		"SKB_DROP_REASON_TCP_CLOSE":                136,
+		"SKB_DROP_REASON_TCP_FASTOPEN":             137,
+		"SKB_DROP_REASON_TCP_OLD_ACK":              138,
+		"SKB_DROP_REASON_TCP_TOO_OLD_ACK":          139,
+		"SKB_DROP_REASON_TCP_ACK_UNSENT_DATA":      140,
+		"SKB_DROP_REASON_TCP_OFO_QUEUE_PRUNE":      141,
+		"SKB_DROP_REASON_TCP_OFO_DROP":             142,
+		"SKB_DROP_REASON_IP_OUTNOROUTES":           143,
+		"SKB_DROP_REASON_BPF_CGROUP_EGRESS":        144,
+		"SKB_DROP_REASON_IPV6DISABLED":             145,
+		"SKB_DROP_REASON_NEIGH_CREATEFAIL":         146,
+		"SKB_DROP_REASON_NEIGH_FAILED":             147,
+		"SKB_DROP_REASON_NEIGH_QUEUEFULL":          148,
+		"SKB_DROP_REASON_NEIGH_DEAD":               149,
+		"SKB_DROP_REASON_TC_EGRESS":                150,
+		"SKB_DROP_REASON_SECURITY_HOOK":            151,
+		"SKB_DROP_REASON_QDISC_DROP":               152,
+		"SKB_DROP_REASON_CPU_BACKLOG":              153,
+		"SKB_DROP_REASON_XDP":                      154,
+		"SKB_DROP_REASON_TC_INGRESS":               155,
+		"SKB_DROP_REASON_UNHANDLED_PROTO":          156,
+		"SKB_DROP_REASON_SKB_CSUM":                 157,
+		"SKB_DROP_REASON_SKB_GSO_SEG":              158,
+		"SKB_DROP_REASON_SKB_UCOPY_FAULT":          159,
+		"SKB_DROP_REASON_DEV_HDR":                  160,
+		"SKB_DROP_REASON_DEV_READY":                161,
+		"SKB_DROP_REASON_FULL_RING":                162,
+		"SKB_DROP_REASON_NOMEM":                    163,
+		"SKB_DROP_REASON_HDR_TRUNC":                164,
+		"SKB_DROP_REASON_TAP_FILTER":               165,
+		"SKB_DROP_REASON_TAP_TXFILTER":             166,
+		"SKB_DROP_REASON_ICMP_CSUM":                167,
+		"SKB_DROP_REASON_INVALID_PROTO":            168,
+		"SKB_DROP_REASON_IP_INADDRERRORS":          169,
+		"SKB_DROP_REASON_IP_INNOROUTES":            170,
+		"SKB_DROP_REASON_PKT_TOO_BIG":              171,
+		"SKB_DROP_REASON_DUP_FRAG":                 172,
+		"SKB_DROP_REASON_FRAG_REASM_TIMEOUT":       173,
+		"SKB_DROP_REASON_FRAG_TOO_FAR":             174,
+		"SKB_DROP_REASON_TCP_MINTTL":               175,
+		"SKB_DROP_REASON_IPV6_BAD_EXTHDR":          176,
+		"SKB_DROP_REASON_IPV6_NDISC_FRAG":          177,
+		"SKB_DROP_REASON_IPV6_NDISC_HOP_LIMIT":     178,
+		"SKB_DROP_REASON_IPV6_NDISC_BAD_CODE":      179,
+		"SKB_DROP_REASON_IPV6_NDISC_BAD_OPTIONS":   180,
+		"SKB_DROP_REASON_IPV6_NDISC_NS_OTHERHOST":  181,
+		"SKB_DROP_REASON_QUEUE_PURGE":              182,
+		"SKB_DROP_REASON_TC_COOKIE_ERROR":          183,
+		"SKB_DROP_REASON_PACKET_SOCK_ERROR":        184,
+		"SKB_DROP_REASON_TC_CHAIN_NOTFOUND":        185,
+		"SKB_DROP_REASON_TC_RECLASSIFY_LOOP":       186,
+		"SKB_DROP_REASON_VXLAN_INVALID_HDR":        187,
+		"SKB_DROP_REASON_VXLAN_VNI_NOT_FOUND":      188,
+		"SKB_DROP_REASON_MAC_INVALID_SOURCE":       189,
+		"SKB_DROP_REASON_VXLAN_ENTRY_EXISTS":       190,
+		"SKB_DROP_REASON_VXLAN_NO_REMOTE":          191,
+		"SKB_DROP_REASON_IP_TUNNEL_ECN":            192,
+		"SKB_DROP_REASON_TUNNEL_TXINFO":            193,
+		"SKB_DROP_REASON_LOCAL_MAC":                194,
+		"SKB_DROP_REASON_ARP_PVLAN_DISABLE":        195,
+		"SKB_DROP_REASON_MAC_IS_MULTICAST":         196,
+		"SKB_DROP_REASON_TCP_FILTER":               197,
 	}
 )
 
@@ -131,6 +428,59 @@ func (DropReason) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_utils_metadata_linux_proto_rawDescGZIP(), []int{1}
 }
 
+// Ref: pkg/plugin/packetparser/_cprog/packetparser.h.
+type TunnelType int32
+
+const (
+	TunnelType_TUNNEL_NONE   TunnelType = 0
+	TunnelType_TUNNEL_VXLAN  TunnelType = 1
+	TunnelType_TUNNEL_GENEVE TunnelType = 2
+	TunnelType_TUNNEL_IPIP   TunnelType = 3
+)
+
+// Enum value maps for TunnelType.
+var (
+	TunnelType_name = map[int32]string{
+		0: "TUNNEL_NONE",
+		1: "TUNNEL_VXLAN",
+		2: "TUNNEL_GENEVE",
+		3: "TUNNEL_IPIP",
+	}
+	TunnelType_value = map[string]int32{
+		"TUNNEL_NONE":   0,
+		"TUNNEL_VXLAN":  1,
+		"TUNNEL_GENEVE": 2,
+		"TUNNEL_IPIP":   3,
+	}
+)
+
+func (x TunnelType) Enum() *TunnelType {
+	p := new(TunnelType)
+	*p = x
+	return p
+}
+
+func (x TunnelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TunnelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_utils_metadata_linux_proto_enumTypes[2].Descriptor()
+}
+
+func (TunnelType) Type() protoreflect.EnumType {
+	return &file_pkg_utils_metadata_linux_proto_enumTypes[2]
+}
+
+func (x TunnelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TunnelType.Descriptor instead.
+func (TunnelType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_utils_metadata_linux_proto_rawDescGZIP(), []int{2}
+}
+
 type RetinaMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -144,6 +494,15 @@ type RetinaMetadata struct {
 	TcpId uint64 `protobuf:"varint,4,opt,name=tcp_id,json=tcpId,proto3" json:"tcp_id,omitempty"`
 	// Drop reason in Retina.
 	DropReason DropReason `protobuf:"varint,5,opt,name=drop_reason,json=dropReason,proto3,enum=utils.DropReason" json:"drop_reason,omitempty"`
+	// DNS transaction ID, used to pair a response with its query.
+	DnsId uint32 `protobuf:"varint,6,opt,name=dns_id,json=dnsId,proto3" json:"dns_id,omitempty"`
+	// Overlay tunnel encapsulating the packet. The flow reports the inner packet.
+	TunnelType TunnelType `protobuf:"varint,7,opt,name=tunnel_type,json=tunnelType,proto3,enum=utils.TunnelType" json:"tunnel_type,omitempty"`
+	// Outer source and destination IPs of the encapsulated packet, i.e. the tunnel endpoints.
+	TunnelSourceIp      string `protobuf:"bytes,8,opt,name=tunnel_source_ip,json=tunnelSourceIp,proto3" json:"tunnel_source_ip,omitempty"`
+	TunnelDestinationIp string `protobuf:"bytes,9,opt,name=tunnel_destination_ip,json=tunnelDestinationIp,proto3" json:"tunnel_destination_ip,omitempty"`
+	// VXLAN or Geneve network identifier.
+	TunnelVni uint32 `protobuf:"varint,10,opt,name=tunnel_vni,json=tunnelVni,proto3" json:"tunnel_vni,omitempty"`
 }
 
 func (x *RetinaMetadata) Reset() {
@@ -213,12 +572,47 @@ func (x *RetinaMetadata) GetDropReason() DropReason {
 	return DropReason_IPTABLE_RULE_DROP
 }
 
+func (x *RetinaMetadata) GetDnsId() uint32 {
+	if x != nil {
+		return x.DnsId
+	}
+	return 0
+}
+
+func (x *RetinaMetadata) GetTunnelType() TunnelType {
+	if x != nil {
+		return x.TunnelType
+	}
+	return TunnelType_TUNNEL_NONE
+}
+
+func (x *RetinaMetadata) GetTunnelSourceIp() string {
+	if x != nil {
+		return x.TunnelSourceIp
+	}
+	return ""
+}
+
+func (x *RetinaMetadata) GetTunnelDestinationIp() string {
+	if x != nil {
+		return x.TunnelDestinationIp
+	}
+	return ""
+}
+
+func (x *RetinaMetadata) GetTunnelVni() uint32 {
+	if x != nil {
+		return x.TunnelVni
+	}
+	return 0
+}
+
 var File_pkg_utils_metadata_linux_proto protoreflect.FileDescriptor
 
 var file_pkg_utils_metadata_linux_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61,
 	0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x69,
+	0x12, 0x05, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x22, 0x89, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x69,
 	0x6e, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79,
 	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
 	0x12, 0x29, 0x0a, 0x08, 0x64, 0x6e, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -230,24 +624,262 @@ var file_pkg_utils_metadata_linux_proto_rawDesc = []byte{
 	0x52, 0x05, 0x74, 0x63, 0x70, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x5f,
 	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x75,
 	0x74, 0x69, 0x6c, 0x73, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
-	0x0a, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0x2f, 0x0a, 0x07, 0x44,
-	0x4e, 0x53, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0c,
-	0x0a, 0x08, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x02, 0x2a, 0xa5, 0x01, 0x0a,
-	0x0a, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x49,
-	0x50, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x50, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4e, 0x41,
-	0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x43, 0x50, 0x5f,
-	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x02, 0x12,
-	0x14, 0x0a, 0x10, 0x54, 0x43, 0x50, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x5f, 0x42, 0x41,
-	0x53, 0x49, 0x43, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f,
-	0x53, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f,
-	0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x52,
-	0x4f, 0x50, 0x10, 0x06, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x2f, 0x72, 0x65, 0x74,
-	0x69, 0x6e, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x64,
+	0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x6e, 0x73,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70,
+	0x12, 0x32, 0x0a, 0x15, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x76,
+	0x6e, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x56, 0x6e, 0x69, 0x2a, 0x2f, 0x0a, 0x07, 0x44, 0x4e, 0x53, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x51,
+	0x55, 0x45, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x53, 0x45, 0x10, 0x02, 0x2a, 0xe9, 0x1c, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x50, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52,
+	0x55, 0x4c, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x50,
+	0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4e, 0x41, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f,
+	0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x43, 0x50, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43,
+	0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f,
+	0x41, 0x44, 0x44, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d,
+	0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x64, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x65, 0x12, 0x21,
+	0x0a, 0x1d, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x10,
+	0x66, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x53, 0x55, 0x4d, 0x10, 0x67, 0x12,
+	0x21, 0x0a, 0x1d, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
+	0x10, 0x68, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x44, 0x50, 0x5f, 0x43, 0x53, 0x55, 0x4d, 0x10, 0x69,
+	0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x54, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x10, 0x6a, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x48, 0x4f, 0x53,
+	0x54, 0x10, 0x6b, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x5f, 0x43, 0x53, 0x55, 0x4d, 0x10, 0x6c,
+	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x5f, 0x49, 0x4e, 0x48, 0x44, 0x52, 0x10, 0x6d, 0x12, 0x1f,
+	0x0a, 0x1b, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x49, 0x50, 0x5f, 0x52, 0x50, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x6e, 0x12,
+	0x2b, 0x0a, 0x27, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4c, 0x32,
+	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x6f, 0x12, 0x1f, 0x0a, 0x1b,
+	0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x58, 0x46, 0x52, 0x4d, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10, 0x70, 0x12, 0x1e, 0x0a,
+	0x1a, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x50, 0x5f, 0x4e, 0x4f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x71, 0x12, 0x22, 0x0a,
+	0x1e, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x52, 0x43, 0x56, 0x42, 0x55, 0x46, 0x46, 0x10,
+	0x72, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x5f, 0x4d, 0x45, 0x4d, 0x10, 0x73,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x48, 0x44, 0x52,
+	0x10, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4d, 0x44, 0x35, 0x4e, 0x4f, 0x54,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x75, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x4b, 0x42, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4d,
+	0x44, 0x35, 0x55, 0x4e, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x76, 0x12, 0x22,
+	0x0a, 0x1e, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4d, 0x44, 0x35, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x10, 0x77, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x41, 0x4f, 0x4e, 0x4f, 0x54, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x78, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x41, 0x4f,
+	0x55, 0x4e, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x79, 0x12, 0x25, 0x0a, 0x21,
+	0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x54, 0x43, 0x50, 0x5f, 0x41, 0x4f, 0x4b, 0x45, 0x59, 0x4e, 0x4f, 0x54, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x7a, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x41, 0x4f, 0x46, 0x41, 0x49,
+	0x4c, 0x55, 0x52, 0x45, 0x10, 0x7b, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54,
+	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x7c, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4b,
+	0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43,
+	0x50, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x53, 0x10, 0x7d, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4b, 0x42,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50,
+	0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x7e, 0x12, 0x20, 0x0a,
+	0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x7f, 0x12,
+	0x23, 0x0a, 0x1e, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x10, 0x80, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4f, 0x46, 0x4f, 0x4d,
+	0x45, 0x52, 0x47, 0x45, 0x10, 0x81, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x4b, 0x42, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x52,
+	0x46, 0x43, 0x37, 0x33, 0x32, 0x33, 0x5f, 0x50, 0x41, 0x57, 0x53, 0x10, 0x82, 0x01, 0x12, 0x25,
+	0x0a, 0x20, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e,
+	0x43, 0x45, 0x10, 0x83, 0x01, 0x12, 0x29, 0x0a, 0x24, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x84, 0x01,
+	0x12, 0x2d, 0x0a, 0x28, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x41, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x85, 0x01, 0x12,
+	0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x86, 0x01, 0x12,
+	0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53,
+	0x59, 0x4e, 0x10, 0x87, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x10, 0x88, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x46, 0x41, 0x53,
+	0x54, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x89, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x53, 0x4b, 0x42, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f,
+	0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x8a, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b,
+	0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43,
+	0x50, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x8b, 0x01,
+	0x12, 0x28, 0x0a, 0x23, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x45,
+	0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x8c, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x53, 0x4b,
+	0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43,
+	0x50, 0x5f, 0x4f, 0x46, 0x4f, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x50, 0x52, 0x55, 0x4e,
+	0x45, 0x10, 0x8d, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4f, 0x46, 0x4f, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x10, 0x8e, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x53, 0x4b, 0x42, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x5f, 0x4f, 0x55,
+	0x54, 0x4e, 0x4f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x53, 0x10, 0x8f, 0x01, 0x12, 0x26, 0x0a, 0x21,
+	0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x42, 0x50, 0x46, 0x5f, 0x43, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x45, 0x47, 0x52, 0x45, 0x53,
+	0x53, 0x10, 0x90, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x44, 0x49, 0x53, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x10, 0x91, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x4b, 0x42, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x49, 0x47, 0x48,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x92, 0x01, 0x12, 0x21,
+	0x0a, 0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x93,
+	0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x94, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x4b, 0x42, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x49, 0x47, 0x48,
+	0x5f, 0x44, 0x45, 0x41, 0x44, 0x10, 0x95, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x5f, 0x45,
+	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x96, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x4b, 0x42, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x43, 0x55,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x97, 0x01, 0x12, 0x1f, 0x0a, 0x1a,
+	0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x51, 0x44, 0x49, 0x53, 0x43, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x98, 0x01, 0x12, 0x20, 0x0a,
+	0x1b, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x43, 0x50, 0x55, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x99, 0x01, 0x12,
+	0x18, 0x0a, 0x13, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x58, 0x44, 0x50, 0x10, 0x9a, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x4b, 0x42,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x5f,
+	0x49, 0x4e, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x9b, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b,
+	0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x9c, 0x01,
+	0x12, 0x1d, 0x0a, 0x18, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x42, 0x5f, 0x43, 0x53, 0x55, 0x4d, 0x10, 0x9d, 0x01, 0x12,
+	0x20, 0x0a, 0x1b, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x42, 0x5f, 0x47, 0x53, 0x4f, 0x5f, 0x53, 0x45, 0x47, 0x10, 0x9e,
+	0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x42, 0x5f, 0x55, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x10, 0x9f, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x53, 0x4b, 0x42, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x56, 0x5f, 0x48,
+	0x44, 0x52, 0x10, 0xa0, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x56, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0xa1, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x49,
+	0x4e, 0x47, 0x10, 0xa2, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4d, 0x45, 0x4d, 0x10, 0xa3,
+	0x01, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x48, 0x44, 0x52, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x10, 0xa4,
+	0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x50, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10,
+	0xa5, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x50, 0x5f, 0x54, 0x58, 0x46, 0x49, 0x4c, 0x54,
+	0x45, 0x52, 0x10, 0xa6, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x4d, 0x50, 0x5f, 0x43, 0x53,
+	0x55, 0x4d, 0x10, 0xa7, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0xa8, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x5f,
+	0x49, 0x4e, 0x41, 0x44, 0x44, 0x52, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0xa9, 0x01, 0x12,
+	0x22, 0x0a, 0x1d, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x5f, 0x49, 0x4e, 0x4e, 0x4f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x53,
+	0x10, 0xaa, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x42,
+	0x49, 0x47, 0x10, 0xab, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x5f, 0x46, 0x52, 0x41,
+	0x47, 0x10, 0xac, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xad, 0x01, 0x12, 0x21, 0x0a,
+	0x1c, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x46, 0x52, 0x41, 0x47, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x46, 0x41, 0x52, 0x10, 0xae, 0x01,
+	0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x54, 0x4c, 0x10, 0xaf,
+	0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x45, 0x58,
+	0x54, 0x48, 0x44, 0x52, 0x10, 0xb0, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x5f,
+	0x4e, 0x44, 0x49, 0x53, 0x43, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x10, 0xb1, 0x01, 0x12, 0x29, 0x0a,
+	0x24, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x4e, 0x44, 0x49, 0x53, 0x43, 0x5f, 0x48, 0x4f, 0x50, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0xb2, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x53, 0x4b, 0x42, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x56, 0x36,
+	0x5f, 0x4e, 0x44, 0x49, 0x53, 0x43, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10,
+	0xb3, 0x01, 0x12, 0x2b, 0x0a, 0x26, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x4e, 0x44, 0x49, 0x53, 0x43,
+	0x5f, 0x42, 0x41, 0x44, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0xb4, 0x01, 0x12,
+	0x2c, 0x0a, 0x27, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x5f, 0x4e, 0x44, 0x49, 0x53, 0x43, 0x5f, 0x4e, 0x53,
+	0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x48, 0x4f, 0x53, 0x54, 0x10, 0xb5, 0x01, 0x12, 0x20, 0x0a,
+	0x1b, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x50, 0x55, 0x52, 0x47, 0x45, 0x10, 0xb6, 0x01, 0x12,
+	0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x5f, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0xb7, 0x01, 0x12, 0x26, 0x0a, 0x21, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f,
+	0x53, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xb8, 0x01, 0x12, 0x26, 0x0a,
+	0x21, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x54, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0xb9, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x5f, 0x52, 0x45, 0x43, 0x4c,
+	0x41, 0x53, 0x53, 0x49, 0x46, 0x59, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x10, 0xba, 0x01, 0x12, 0x26,
+	0x0a, 0x21, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x48, 0x44, 0x52, 0x10, 0xbb, 0x01, 0x12, 0x28, 0x0a, 0x23, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f,
+	0x56, 0x4e, 0x49, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xbc, 0x01,
+	0x12, 0x27, 0x0a, 0x22, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0xbd, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x53, 0x4b, 0x42,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x58, 0x4c,
+	0x41, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10,
+	0xbe, 0x01, 0x12, 0x24, 0x0a, 0x1f, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0xbf, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x4b, 0x42, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x50, 0x5f, 0x54,
+	0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x43, 0x4e, 0x10, 0xc0, 0x01, 0x12, 0x22, 0x0a, 0x1d,
+	0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x58, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0xc1, 0x01,
+	0x12, 0x1e, 0x0a, 0x19, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x10, 0xc2, 0x01,
+	0x12, 0x26, 0x0a, 0x21, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x52, 0x50, 0x5f, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x44, 0x49,
+	0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xc3, 0x01, 0x12, 0x25, 0x0a, 0x20, 0x53, 0x4b, 0x42, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x43, 0x5f,
+	0x49, 0x53, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xc4, 0x01, 0x12,
+	0x1f, 0x0a, 0x1a, 0x53, 0x4b, 0x42, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0xc5, 0x01,
+	0x2a, 0x53, 0x0a, 0x0a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x47, 0x45, 0x4e, 0x45,
+	0x56, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x49,
+	0x50, 0x49, 0x50, 0x10, 0x03, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x2f, 0x72, 0x65,
+	0x74, 0x69, 0x6e, 0x61, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -262,21 +894,23 @@ func file_pkg_utils_metadata_linux_proto_rawDescGZIP() []byte {
 	return file_pkg_utils_metadata_linux_proto_rawDescData
 }
 
-var file_pkg_utils_metadata_linux_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_utils_metadata_linux_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_pkg_utils_metadata_linux_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_pkg_utils_metadata_linux_proto_goTypes = []any{
 	(DNSType)(0),           // 0: utils.DNSType
 	(DropReason)(0),        // 1: utils.DropReason
-	(*RetinaMetadata)(nil), // 2: utils.RetinaMetadata
+	(TunnelType)(0),        // 2: utils.TunnelType
+	(*RetinaMetadata)(nil), // 3: utils.RetinaMetadata
 }
 var file_pkg_utils_metadata_linux_proto_depIdxs = []int32{
 	0, // 0: utils.RetinaMetadata.dns_type:type_name -> utils.DNSType
 	1, // 1: utils.RetinaMetadata.drop_reason:type_name -> utils.DropReason
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 2: utils.RetinaMetadata.tunnel_type:type_name -> utils.TunnelType
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_pkg_utils_metadata_linux_proto_init() }
@@ -303,7 +937,7 @@ func file_pkg_utils_metadata_linux_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_utils_metadata_linux_proto_rawDesc,
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -20,11 +20,17 @@ const (
 	TCPConnectionStatsName               = "tcp_connection_stats"
 	TCPFlagGauge                         = "tcp_flag_gauges"
 	TCPRetransCount                      = "tcp_retransmission_count"
+	TCPRTTName                           = "tcp_rtt"
 	IPConnectionStatsName                = "ip_connection_stats"
 	UDPConnectionStatsName               = "udp_connection_stats"
 	InterfaceStatsName                   = "interface_stats"
 	DNSRequestCounterName                = "dns_request_count"
 	DNSResponseCounterName               = "dns_response_count"
+	DNSLatencyName                       = "dns_latency"
+	DNSUnansweredQueryCounterName        = "dns_unanswered_query_count"
+	HTTPRequestCounterName               = "http_requests"
+	HTTPLatencyName                      = "http_latency"
+	ICMPErrorCounterName                 = "icmp_error_count"
 	NodeAPIServerLatencyName             = "node_apiserver_latency"
 	NodeAPIServerTCPHandshakeLatencyName = "node_apiserver_handshake_latency"
 	NoResponseFromAPIServerName          = "node_apiserver_no_response"
@@ -51,10 +57,16 @@ func IsAdvancedMetric(name string) bool {
 		TCPConnectionStatsName,
 		TCPFlagGauge,
 		TCPRetransCount,
+		TCPRTTName,
 		IPConnectionStatsName,
 		UDPConnectionStatsName,
 		DNSRequestCounterName,
 		DNSResponseCounterName,
+		DNSLatencyName,
+		DNSUnansweredQueryCounterName,
+		HTTPRequestCounterName,
+		HTTPLatencyName,
+		ICMPErrorCounterName,
 		NodeAPIServerLatencyName,
 		NodeAPIServerTCPHandshakeLatencyName,
 		NoResponseFromAPIServerName:
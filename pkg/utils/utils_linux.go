@@ -127,7 +127,59 @@ func GetDropReasonDesc(dr DropReason) flow.DropReason {
 		return flow.DropReason_SNAT_NO_MAP_FOUND
 	case DropReason_CONNTRACK_ADD_DROP:
 		return flow.DropReason_UNKNOWN_CONNECTION_TRACKING_STATE
+	case DropReason_SKB_DROP_REASON_NETFILTER_DROP:
+		return flow.DropReason_POLICY_DENIED
+	case DropReason_SKB_DROP_REASON_NO_SOCKET:
+		return flow.DropReason_SOCKET_LOOKUP_FAILED
+	case DropReason_SKB_DROP_REASON_PKT_TOO_SMALL,
+		DropReason_SKB_DROP_REASON_TCP_CSUM,
+		DropReason_SKB_DROP_REASON_UDP_CSUM,
+		DropReason_SKB_DROP_REASON_IP_CSUM,
+		DropReason_SKB_DROP_REASON_IP_INHDR,
+		DropReason_SKB_DROP_REASON_SKB_CSUM,
+		DropReason_SKB_DROP_REASON_ICMP_CSUM,
+		DropReason_SKB_DROP_REASON_HDR_TRUNC:
+		return flow.DropReason_INVALID_PACKET_DROPPED
+	case DropReason_SKB_DROP_REASON_IP_NOPROTO:
+		return flow.DropReason_UNKNOWN_L4_PROTOCOL
+	case DropReason_SKB_DROP_REASON_UNHANDLED_PROTO:
+		return flow.DropReason_UNSUPPORTED_L3_PROTOCOL
+	case DropReason_SKB_DROP_REASON_IP_OUTNOROUTES, DropReason_SKB_DROP_REASON_IP_INNOROUTES:
+		return flow.DropReason_FIB_LOOKUP_FAILED
+	case DropReason_SKB_DROP_REASON_IP_RPFILTER:
+		return flow.DropReason_INVALID_SOURCE_IP
+	case DropReason_SKB_DROP_REASON_OTHERHOST:
+		return flow.DropReason_INVALID_DESTINATION_MAC
+	case DropReason_SKB_DROP_REASON_MAC_INVALID_SOURCE:
+		return flow.DropReason_INVALID_SOURCE_MAC
+	case DropReason_SKB_DROP_REASON_IPV6_BAD_EXTHDR:
+		return flow.DropReason_INVALID_IPV6_EXTENSION_HEADER
+	case DropReason_SKB_DROP_REASON_VXLAN_VNI_NOT_FOUND:
+		return flow.DropReason_INVALID_VNI
 	default:
 		return flow.DropReason_DROP_REASON_UNKNOWN
 	}
 }
+
+// AddTunnel adds the overlay tunnel encapsulating the packet to the flow's metadata.
+// sourceIP and destinationIP are the outer IPs of the packet, i.e. the tunnel endpoints.
+func AddTunnel(meta *RetinaMetadata, tunnelType TunnelType, sourceIP, destinationIP net.IP, vni uint32) {
+	if meta == nil || tunnelType == TunnelType_TUNNEL_NONE {
+		return
+	}
+	meta.TunnelType = tunnelType
+	meta.TunnelSourceIp = sourceIP.String()
+	meta.TunnelDestinationIp = destinationIP.String()
+	meta.TunnelVni = vni
+}
+
+// Tunnel returns the overlay tunnel encapsulating the packet of the flow, and its outer source and destination IPs and
+// VNI. The tunnel type is TUNNEL_NONE if the packet was not encapsulated.
+func Tunnel(f *flow.Flow) (tunnelType TunnelType, sourceIP, destinationIP string, vni uint32) {
+	if f.GetExtensions() == nil {
+		return TunnelType_TUNNEL_NONE, "", "", 0
+	}
+	k := &RetinaMetadata{}           //nolint:typecheck // Not required to check type as we are setting it.
+	f.GetExtensions().UnmarshalTo(k) //nolint:errcheck // Not required to check error as we are setting it.
+	return k.GetTunnelType(), k.GetTunnelSourceIp(), k.GetTunnelDestinationIp(), k.GetTunnelVni()
+}
@@ -72,6 +72,68 @@ func TestToFlow(t *testing.T) {
 	}
 }
 
+func TestToFlowIPVersion(t *testing.T) {
+	l, _ := log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	tests := []struct {
+		name    string
+		srcIP   net.IP
+		dstIP   net.IP
+		wantSrc string
+		wantDst string
+		wantVer flow.IPVersion
+	}{
+		{
+			name:    "IPv4",
+			srcIP:   net.ParseIP("1.1.1.1").To4(),
+			dstIP:   net.ParseIP("2.2.2.2").To4(),
+			wantSrc: "1.1.1.1",
+			wantDst: "2.2.2.2",
+			wantVer: flow.IPVersion_IPv4,
+		},
+		{
+			name:    "IPv4 in 16-byte form",
+			srcIP:   net.ParseIP("1.1.1.1"),
+			dstIP:   net.ParseIP("2.2.2.2"),
+			wantSrc: "1.1.1.1",
+			wantDst: "2.2.2.2",
+			wantVer: flow.IPVersion_IPv4,
+		},
+		{
+			name:    "IPv6",
+			srcIP:   net.ParseIP("fd00::1"),
+			dstIP:   net.ParseIP("fd00::2"),
+			wantSrc: "fd00::1",
+			wantDst: "fd00::2",
+			wantVer: flow.IPVersion_IPv6,
+		},
+		{
+			name:    "mixed families",
+			srcIP:   net.ParseIP("1.1.1.1").To4(),
+			dstIP:   net.ParseIP("fd00::2"),
+			wantSrc: "1.1.1.1",
+			wantDst: "fd00::2",
+			wantVer: flow.IPVersion_IP_NOT_USED,
+		},
+		{
+			name:    "nil destination",
+			srcIP:   net.ParseIP("fd00::1"),
+			dstIP:   nil,
+			wantSrc: "fd00::1",
+			wantDst: "<nil>",
+			wantVer: flow.IPVersion_IP_NOT_USED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := ToFlow(l, int64(1649748687588860), tt.srcIP, tt.dstIP, 443, 80, 6, uint8(1), flow.Verdict_FORWARDED)
+			assert.Equal(t, tt.wantSrc, f.IP.Source)
+			assert.Equal(t, tt.wantDst, f.IP.Destination)
+			assert.Equal(t, tt.wantVer, f.IP.IpVersion)
+		})
+	}
+}
+
 func TestAddPacketSize(t *testing.T) {
 	l, _ := log.SetupZapLogger(log.GetDefaultLogOpts())
 
@@ -117,6 +179,66 @@ func TestTcpID(t *testing.T) {
 	assert.EqualValues(t, GetTCPID(fl), uint64(1234))
 }
 
+func TestAddICMP(t *testing.T) {
+	l, _ := log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	fl := ToFlow(
+		l,
+		int64(1649748687588864),
+		net.ParseIP("2.2.2.2").To4(),
+		net.ParseIP("1.1.1.1").To4(),
+		0,
+		0,
+		1,
+		uint8(2),
+		flow.Verdict_FORWARDED,
+	)
+	AddICMP(fl, 3, 4)
+	assert.EqualValues(t, 3, fl.GetL4().GetICMPv4().GetType())
+	assert.EqualValues(t, 4, fl.GetL4().GetICMPv4().GetCode())
+
+	// The flows of other protocols are not modified.
+	fl = ToFlow(
+		l,
+		int64(1649748687588864),
+		net.ParseIP("2.2.2.2").To4(),
+		net.ParseIP("1.1.1.1").To4(),
+		80,
+		443,
+		6,
+		uint8(2),
+		flow.Verdict_FORWARDED,
+	)
+	AddICMP(fl, 3, 4)
+	assert.NotNil(t, fl.GetL4().GetTCP())
+	assert.Nil(t, fl.GetL4().GetICMPv4())
+}
+
+func TestAddHTTPInfo(t *testing.T) {
+	l, _ := log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	fl := ToFlow(
+		l,
+		int64(1649748687588864),
+		net.ParseIP("2.2.2.2").To4(),
+		net.ParseIP("1.1.1.1").To4(),
+		80,
+		443,
+		6,
+		uint8(1),
+		flow.Verdict_FORWARDED,
+	)
+
+	AddHTTPInfo(fl, flow.L7FlowType_RESPONSE, "GET", "/users/{id}", "HTTP/1.1", 200, 1500000)
+	assert.Equal(t, flow.FlowType_L7, fl.GetType())
+	assert.Equal(t, flow.L7FlowType_RESPONSE, fl.GetL7().GetType())
+	assert.EqualValues(t, 1500000, fl.GetL7().GetLatencyNs())
+	assert.EqualValues(t, 200, fl.GetL7().GetHttp().GetCode())
+	assert.Equal(t, "GET", fl.GetL7().GetHttp().GetMethod())
+	assert.Equal(t, "/users/{id}", fl.GetL7().GetHttp().GetUrl())
+	assert.True(t, fl.GetIsReply().GetValue())
+}
+
 func TestAddDropReason(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -149,6 +271,24 @@ func TestAddDropReason(t *testing.T) {
 			expectedDesc:   flow.DropReason_DROP_REASON_UNKNOWN,
 			expectedReason: 0,
 		},
+		{
+			name:                 "Kernel Netfilter Drop",
+			dropReason:           uint16(DropReason_SKB_DROP_REASON_NETFILTER_DROP),
+			expectedDesc:         flow.DropReason_POLICY_DENIED,
+			expectedRetinaReason: "SKB_DROP_REASON_NETFILTER_DROP",
+		},
+		{
+			name:                 "Kernel Checksum Drop",
+			dropReason:           uint16(DropReason_SKB_DROP_REASON_TCP_CSUM),
+			expectedDesc:         flow.DropReason_INVALID_PACKET_DROPPED,
+			expectedRetinaReason: "SKB_DROP_REASON_TCP_CSUM",
+		},
+		{
+			name:                 "Kernel Qdisc Drop",
+			dropReason:           uint16(DropReason_SKB_DROP_REASON_QDISC_DROP),
+			expectedDesc:         flow.DropReason_DROP_REASON_UNKNOWN,
+			expectedRetinaReason: "SKB_DROP_REASON_QDISC_DROP",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -162,10 +302,46 @@ func TestAddDropReason(t *testing.T) {
 			assert.NotNil(t, f.EventType.Type, 1)
 			assert.EqualValues(t, f.EventType.GetSubType(), int32(tc.expectedDesc))
 			assert.NotNil(t, DropReasonDescription(f), DropReason_name[int32(tc.dropReason)])
+			if tc.expectedRetinaReason != "" {
+				assert.Equal(t, tc.expectedRetinaReason, DropReasonDescription(f))
+			}
 		})
 	}
 }
 
+func TestAddTunnel(t *testing.T) {
+	l, _ := log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	fl := ToFlow(
+		l,
+		int64(1649748687588864),
+		net.ParseIP("10.244.1.5").To4(),
+		net.ParseIP("10.244.2.7").To4(),
+		443,
+		80,
+		6,
+		uint8(1),
+		flow.Verdict_FORWARDED,
+	)
+	meta := &RetinaMetadata{}
+	AddTunnel(meta, TunnelType_TUNNEL_VXLAN, net.ParseIP("192.168.0.4").To4(), net.ParseIP("192.168.0.5").To4(), 1)
+	AddRetinaMetadata(fl, meta)
+
+	tunnelType, src, dst, vni := Tunnel(fl)
+	assert.Equal(t, TunnelType_TUNNEL_VXLAN, tunnelType)
+	assert.Equal(t, "192.168.0.4", src)
+	assert.Equal(t, "192.168.0.5", dst)
+	assert.EqualValues(t, 1, vni)
+
+	// Packets not encapsulated have no tunnel.
+	meta = &RetinaMetadata{}
+	AddTunnel(meta, TunnelType_TUNNEL_NONE, nil, nil, 0)
+	AddRetinaMetadata(fl, meta)
+	tunnelType, src, _, _ = Tunnel(fl)
+	assert.Equal(t, TunnelType_TUNNEL_NONE, tunnelType)
+	assert.Empty(t, src)
+}
+
 func TestIsDefaultRoute(t *testing.T) {
 	tests := []struct {
 		Route           netlink.Route
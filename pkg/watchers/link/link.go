@@ -0,0 +1,107 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+// Package link contains the watcher of the network links of the node. It publishes the links created, updated and
+// deleted in the host network namespace as soon as netlink reports them, and resyncs with the links of the node on
+// every refresh in case netlink dropped an update.
+package link
+
+import (
+	"context"
+	"sync"
+
+	"github.com/microsoft/retina/pkg/common"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/pubsub"
+	"go.uber.org/zap"
+)
+
+type LinkWatcher struct {
+	isRunning bool
+	l         *log.ZapLogger
+	p         pubsub.PubSubInterface
+	// mu protects current, which is updated by both the netlink subscription and Refresh.
+	mu      sync.Mutex
+	current cache
+	// done stops the netlink subscription.
+	done chan struct{}
+}
+
+var w *LinkWatcher
+
+// Watcher creates a new link watcher.
+func Watcher() *LinkWatcher {
+	if w == nil {
+		w = &LinkWatcher{
+			isRunning: false,
+			l:         log.Logger().Named("link-watcher"),
+			p:         pubsub.New(),
+			current:   make(cache),
+		}
+	}
+
+	return w
+}
+
+func (w *LinkWatcher) Init(ctx context.Context) error {
+	if w.isRunning {
+		w.l.Info("link watcher is already running")
+		return nil
+	}
+
+	// The links existing before the watcher starts are not published, their subscribers list them on start.
+	links, err := listLinks()
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	w.current = links
+	w.mu.Unlock()
+
+	// subscribe is OS specific.
+	if err := w.subscribe(); err != nil {
+		return err
+	}
+	w.isRunning = true
+	return nil
+}
+
+func (w *LinkWatcher) Stop(ctx context.Context) error {
+	if !w.isRunning {
+		w.l.Info("link watcher is not running")
+		return nil
+	}
+	if w.done != nil {
+		close(w.done)
+		w.done = nil
+	}
+	w.isRunning = false
+	return nil
+}
+
+func (w *LinkWatcher) Refresh(ctx context.Context) error {
+	links, err := listLinks()
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Publish the links created and deleted since the last update of netlink.
+	for index, link := range links {
+		if _, ok := w.current[index]; !ok {
+			w.l.Debug("Link created", zap.Int("index", index))
+			w.p.Publish(common.PubSubLinks, NewLinkEvent(LinkCreated, link))
+		}
+	}
+	for index, link := range w.current {
+		if _, ok := links[index]; !ok {
+			w.l.Debug("Link deleted", zap.Int("index", index))
+			w.p.Publish(common.PubSubLinks, NewLinkEvent(LinkDeleted, link))
+		}
+	}
+
+	w.current = links
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package link
+
+import (
+	"github.com/microsoft/retina/pkg/common"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	showLink      = netlink.LinkList
+	linkSubscribe = netlink.LinkSubscribeWithOptions
+)
+
+func listLinks() (cache, error) {
+	links, err := showLink()
+	if err != nil {
+		return nil, err
+	}
+
+	c := make(cache, len(links))
+	for _, link := range links {
+		c[link.Attrs().Index] = link
+	}
+	return c, nil
+}
+
+// subscribe subscribes to the link updates of netlink in the host network namespace.
+func (w *LinkWatcher) subscribe() error {
+	updates := make(chan netlink.LinkUpdate)
+	done := make(chan struct{})
+	err := linkSubscribe(updates, done, netlink.LinkSubscribeOptions{
+		ErrorCallback: func(err error) {
+			w.l.Warn("Error receiving link updates, links will be resynced on the next refresh", zap.Error(err))
+		},
+	})
+	if err != nil {
+		close(done)
+		return err
+	}
+	w.done = done
+
+	// netlink closes updates when done is closed.
+	go func() {
+		for update := range updates {
+			w.processUpdate(update)
+		}
+	}()
+	return nil
+}
+
+func (w *LinkWatcher) processUpdate(update netlink.LinkUpdate) {
+	if update.Link == nil {
+		return
+	}
+	attrs := update.Link.Attrs()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch update.Header.Type {
+	case unix.RTM_NEWLINK:
+		previous, ok := w.current[attrs.Index]
+		w.current[attrs.Index] = update.Link
+		if !ok {
+			w.l.Debug("Link created", zap.String("name", attrs.Name), zap.Int("index", attrs.Index))
+			w.p.Publish(common.PubSubLinks, NewLinkEvent(LinkCreated, update.Link))
+			return
+		}
+		// netlink reports many changes of a link, e.g. of its statistics. Only publish the ones changing whether the
+		// link is usable, or how it is selected: its name and its master.
+		previousAttrs := previous.(netlink.Link).Attrs()
+		if previousAttrs.Flags != attrs.Flags || previousAttrs.OperState != attrs.OperState ||
+			previousAttrs.Name != attrs.Name || previousAttrs.MasterIndex != attrs.MasterIndex {
+			w.l.Debug("Link updated", zap.String("name", attrs.Name), zap.Int("index", attrs.Index))
+			w.p.Publish(common.PubSubLinks, NewLinkEvent(LinkUpdated, update.Link))
+		}
+	case unix.RTM_DELLINK:
+		if _, ok := w.current[attrs.Index]; !ok {
+			return
+		}
+		delete(w.current, attrs.Index)
+		w.l.Debug("Link deleted", zap.String("name", attrs.Name), zap.Int("index", attrs.Index))
+		w.p.Publish(common.PubSubLinks, NewLinkEvent(LinkDeleted, update.Link))
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package link
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/microsoft/retina/pkg/common"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/sys/unix"
+)
+
+func TestGetWatcher(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	w := Watcher()
+	assert.NotNil(t, w)
+
+	wAgain := Watcher()
+	assert.Equal(t, w, wAgain, "Expected the same link watcher instance")
+}
+
+func TestInitAndStop(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	c := context.Background()
+
+	eth0 := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 2, Name: "eth0"}}
+	showLink = func() ([]netlink.Link, error) {
+		return []netlink.Link{eth0}, nil
+	}
+	var done <-chan struct{}
+	linkSubscribe = func(ch chan<- netlink.LinkUpdate, d <-chan struct{}, _ netlink.LinkSubscribeOptions) error {
+		done = d
+		go func() {
+			<-d
+			close(ch)
+		}()
+		return nil
+	}
+	defer func() {
+		showLink = netlink.LinkList
+		linkSubscribe = netlink.LinkSubscribeWithOptions
+	}()
+
+	w := &LinkWatcher{
+		l:       log.Logger().Named("link-watcher"),
+		p:       pubsub.New(),
+		current: make(cache),
+	}
+	require.NoError(t, w.Init(c))
+	assert.True(t, w.isRunning)
+	assert.Equal(t, cache{2: eth0}, w.current, "Expected the existing links to be cached")
+
+	require.NoError(t, w.Stop(c))
+	assert.False(t, w.isRunning)
+	_, open := <-done
+	assert.False(t, open, "Expected the netlink subscription to be stopped")
+
+	// Stopping a stopped watcher is a no-op.
+	require.NoError(t, w.Stop(c))
+}
+
+func TestInitError(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+
+	showLink = func() ([]netlink.Link, error) {
+		return nil, nil
+	}
+	linkSubscribe = func(chan<- netlink.LinkUpdate, <-chan struct{}, netlink.LinkSubscribeOptions) error {
+		return errors.New("error")
+	}
+	defer func() {
+		showLink = netlink.LinkList
+		linkSubscribe = netlink.LinkSubscribeWithOptions
+	}()
+
+	w := &LinkWatcher{
+		l:       log.Logger().Named("link-watcher"),
+		p:       pubsub.New(),
+		current: make(cache),
+	}
+	require.Error(t, w.Init(context.Background()))
+	assert.False(t, w.isRunning)
+}
+
+func TestProcessUpdate(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPubSub := pubsub.NewMockPubSubInterface(ctrl)
+	w := &LinkWatcher{
+		l:       log.Logger().Named("link-watcher"),
+		p:       mockPubSub,
+		current: make(cache),
+	}
+
+	update := func(msgType uint16, l netlink.Link) netlink.LinkUpdate {
+		return netlink.LinkUpdate{Header: unix.NlMsghdr{Type: msgType}, Link: l}
+	}
+	expectEvent := func(eventType EventType, name string) {
+		mockPubSub.EXPECT().Publish(common.PubSubLinks, gomock.Any()).Do(func(_ pubsub.PubSubTopic, msg interface{}) {
+			event := msg.(*LinkEvent)
+			assert.Equal(t, eventType, event.Type)
+			assert.Equal(t, name, event.Obj.(netlink.Link).Attrs().Name)
+		}).Times(1)
+	}
+
+	// A new link is created.
+	expectEvent(LinkCreated, "eth1")
+	w.processUpdate(update(unix.RTM_NEWLINK, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth1"}}))
+
+	// Changes not affecting whether the link is usable are not published.
+	w.processUpdate(update(unix.RTM_NEWLINK, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth1", MTU: 9000}}))
+
+	// The link goes up.
+	expectEvent(LinkUpdated, "eth1")
+	w.processUpdate(update(unix.RTM_NEWLINK, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth1", OperState: netlink.OperUp}}))
+
+	// The link is renamed.
+	expectEvent(LinkUpdated, "eth2")
+	w.processUpdate(update(unix.RTM_NEWLINK, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth2", OperState: netlink.OperUp}}))
+
+	// The link is enslaved to a bond.
+	expectEvent(LinkUpdated, "eth2")
+	w.processUpdate(update(unix.RTM_NEWLINK, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth2", OperState: netlink.OperUp, MasterIndex: 2}}))
+
+	// The link is deleted, once.
+	expectEvent(LinkDeleted, "eth2")
+	w.processUpdate(update(unix.RTM_DELLINK, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth2"}}))
+	w.processUpdate(update(unix.RTM_DELLINK, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth2"}}))
+	assert.Empty(t, w.current)
+
+	// Other messages are ignored.
+	w.processUpdate(update(unix.RTM_NEWADDR, &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 4, Name: "eth2"}}))
+}
+
+func TestRefresh(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eth0 := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 2, Name: "eth0"}}
+	eth1 := &netlink.Device{LinkAttrs: netlink.LinkAttrs{Index: 3, Name: "eth1"}}
+	bond0 := &netlink.Bond{LinkAttrs: netlink.LinkAttrs{Index: 4, Name: "bond0"}}
+	showLink = func() ([]netlink.Link, error) {
+		return []netlink.Link{eth0, bond0}, nil
+	}
+	defer func() { showLink = netlink.LinkList }()
+
+	mockPubSub := pubsub.NewMockPubSubInterface(ctrl)
+	mockPubSub.EXPECT().Publish(common.PubSubLinks, NewLinkEvent(LinkCreated, bond0)).Times(1)
+	mockPubSub.EXPECT().Publish(common.PubSubLinks, NewLinkEvent(LinkDeleted, eth1)).Times(1)
+
+	w := &LinkWatcher{
+		isRunning: true,
+		l:         log.Logger().Named("link-watcher"),
+		p:         mockPubSub,
+		current:   cache{2: eth0, 3: eth1},
+	}
+	require.NoError(t, w.Refresh(context.Background()))
+	assert.Equal(t, cache{2: eth0, 4: bond0}, w.current)
+
+	// Errors listing the links keep the cache.
+	showLink = func() ([]netlink.Link, error) {
+		return nil, errors.New("error")
+	}
+	require.Error(t, w.Refresh(context.Background()))
+	assert.Equal(t, cache{2: eth0, 4: bond0}, w.current)
+}
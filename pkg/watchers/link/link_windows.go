@@ -0,0 +1,14 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package link
+
+// The links of windows nodes are not watched yet.
+
+func listLinks() (cache, error) {
+	return make(cache), nil
+}
+
+func (w *LinkWatcher) subscribe() error {
+	return nil
+}
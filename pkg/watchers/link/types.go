@@ -0,0 +1,50 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package link
+
+const (
+	linkCreated string = "link_created"
+	linkUpdated string = "link_updated"
+	linkDeleted string = "link_deleted"
+)
+
+// cache is a map of link index to link.
+type cache map[int]interface{}
+
+type LinkEvent struct {
+	// Type is the type of the event.
+	Type EventType
+	// Obj is the link that the event is about, a netlink.Link on linux.
+	Obj interface{}
+}
+
+func NewLinkEvent(t EventType, obj interface{}) *LinkEvent {
+	return &LinkEvent{
+		Type: t,
+		Obj:  obj,
+	}
+}
+
+type EventType int
+
+const (
+	LinkCreated EventType = iota
+	// LinkUpdated is published when the flags, the operational state, the name or the master of a link change, e.g.
+	// when it goes up.
+	LinkUpdated
+	LinkDeleted
+)
+
+func (e EventType) String() string {
+	switch e {
+	case LinkCreated:
+		return linkCreated
+	case LinkUpdated:
+		return linkUpdated
+	case LinkDeleted:
+		return linkDeleted
+	default:
+		return "unknown"
+	}
+}
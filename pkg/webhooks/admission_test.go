@@ -0,0 +1,95 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package webhooks
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+)
+
+var _ = Describe("Admission webhooks", func() {
+	Context("Capture", func() {
+		It("should default the duration and the max capture size", func() {
+			capture := validCapture()
+			capture.Name = "defaulted"
+			Expect(k8sClient.Create(ctx, capture)).To(Succeed())
+			DeferCleanup(func() { Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, capture))).To(Succeed()) })
+
+			created := &retinav1alpha1.Capture{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(capture), created)).To(Succeed())
+			Expect(created.Spec.CaptureConfiguration.CaptureOption.Duration).To(Equal(&metav1.Duration{Duration: DefaultCaptureDuration}))
+			Expect(*created.Spec.CaptureConfiguration.CaptureOption.MaxCaptureSize).To(Equal(DefaultMaxCaptureSize))
+
+			// Updating the metadata of the Capture is allowed.
+			created.Finalizers = []string{"retina.sh/test"}
+			Expect(k8sClient.Update(ctx, created)).To(Succeed())
+			created.Finalizers = nil
+			Expect(k8sClient.Update(ctx, created)).To(Succeed())
+		})
+
+		It("should reject an invalid Capture", func() {
+			capture := validCapture()
+			capture.Name = "invalid"
+			capture.Spec.CaptureConfiguration.CaptureTarget.PodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+			err := k8sClient.Create(ctx, capture)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid Capture"))
+
+			capture = validCapture()
+			capture.Name = "invalid-schedule"
+			capture.Spec.Schedule = "every day"
+			Expect(k8sClient.Create(ctx, capture)).NotTo(Succeed())
+		})
+
+		It("should reject an invalid update of the Capture", func() {
+			capture := validCapture()
+			capture.Name = "updated"
+			Expect(k8sClient.Create(ctx, capture)).To(Succeed())
+			DeferCleanup(func() { Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, capture))).To(Succeed()) })
+
+			capture.Spec.CaptureConfiguration.Filters = &retinav1alpha1.CaptureConfigurationFilters{Include: []string{"10.0.0.256"}}
+			err := k8sClient.Update(ctx, capture)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid filter"))
+		})
+	})
+
+	Context("MetricsConfiguration", func() {
+		It("should accept a valid MetricsConfiguration", func() {
+			mc := validMetricsConfiguration()
+			Expect(k8sClient.Create(ctx, mc)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, mc)).To(Succeed())
+		})
+
+		It("should reject an invalid MetricsConfiguration", func() {
+			mc := validMetricsConfiguration()
+			mc.Name = "invalid"
+			mc.Spec.Namespaces = retinav1alpha1.MetricsNamespaces{Include: []string{"default"}, Exclude: []string{"kube-system"}}
+			err := k8sClient.Create(ctx, mc)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid MetricsConfiguration"))
+		})
+	})
+
+	Context("TracesConfiguration", func() {
+		It("should accept a valid TracesConfiguration", func() {
+			tc := validTracesConfiguration()
+			Expect(k8sClient.Create(ctx, tc)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, tc)).To(Succeed())
+		})
+
+		It("should reject an invalid TracesConfiguration", func() {
+			tc := validTracesConfiguration()
+			tc.Name = "invalid"
+			tc.Spec.TraceConfiguration[0].TraceTargets[0].Source = &retinav1alpha1.TraceTarget{}
+			err := k8sClient.Create(ctx, tc)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid TracesConfiguration"))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/capture"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+// Defaults of the Capture, the same as the defaults of the CLI.
+const (
+	DefaultCaptureDuration = 1 * time.Minute
+	DefaultMaxCaptureSize  = 100
+)
+
+//+kubebuilder:webhook:path=/mutate-retina-sh-v1alpha1-capture,mutating=true,failurePolicy=fail,sideEffects=None,groups=retina.sh,resources=captures,verbs=create;update,versions=v1alpha1,name=mcapture.retina.sh,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-retina-sh-v1alpha1-capture,mutating=false,failurePolicy=fail,sideEffects=None,groups=retina.sh,resources=captures,verbs=create;update,versions=v1alpha1,name=vcapture.retina.sh,admissionReviewVersions=v1
+
+type captureWebhook struct {
+	l *log.ZapLogger
+}
+
+var (
+	_ admission.CustomDefaulter = &captureWebhook{}
+	_ admission.CustomValidator = &captureWebhook{}
+)
+
+// Default sets the duration of the Capture when neither the duration nor the ring buffer is set, and the maximum
+// capture size when it is not set.
+func (w *captureWebhook) Default(_ context.Context, obj runtime.Object) error {
+	c, ok := obj.(*retinav1alpha1.Capture)
+	if !ok {
+		return fmt.Errorf("expected a Capture but got %T", obj)
+	}
+	defaultCapture(c)
+	return nil
+}
+
+func defaultCapture(c *retinav1alpha1.Capture) {
+	option := &c.Spec.CaptureConfiguration.CaptureOption
+	// In ring buffer mode, the duration limits how long to wait for a trigger and is unlimited when not set.
+	if option.Duration == nil && option.RingBuffer == nil {
+		option.Duration = &metav1.Duration{Duration: DefaultCaptureDuration}
+	}
+	if option.MaxCaptureSize == nil {
+		maxCaptureSize := DefaultMaxCaptureSize
+		option.MaxCaptureSize = &maxCaptureSize
+	}
+}
+
+// ValidateCreate validates the Capture with the validation of the Capture controller.
+func (w *captureWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	c, ok := obj.(*retinav1alpha1.Capture)
+	if !ok {
+		return nil, fmt.Errorf("expected a Capture but got %T", obj)
+	}
+	return nil, w.validate(c)
+}
+
+// ValidateUpdate validates the Capture when its spec changes, so that the finalizers of an existing invalid Capture
+// can still be removed.
+func (w *captureWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCapture, ok := oldObj.(*retinav1alpha1.Capture)
+	if !ok {
+		return nil, fmt.Errorf("expected a Capture but got %T", oldObj)
+	}
+	newCapture, ok := newObj.(*retinav1alpha1.Capture)
+	if !ok {
+		return nil, fmt.Errorf("expected a Capture but got %T", newObj)
+	}
+	if apiequality.Semantic.DeepEqual(oldCapture.Spec, newCapture.Spec) {
+		return nil, nil
+	}
+	return nil, w.validate(newCapture)
+}
+
+// ValidateDelete allows the deletion of any Capture.
+func (w *captureWebhook) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *captureWebhook) validate(c *retinav1alpha1.Capture) error {
+	err := capture.ValidateCapture(c)
+	if err == nil && c.Spec.Schedule != "" {
+		if _, scheduleErr := cron.ParseStandard(c.Spec.Schedule); scheduleErr != nil {
+			err = fmt.Errorf("Invalid schedule %q: %w", c.Spec.Schedule, scheduleErr)
+		}
+	}
+	if err != nil {
+		w.l.Info("Rejected invalid Capture", zap.String("namespace", c.Namespace), zap.String("name", c.Name), zap.Error(err))
+		return fmt.Errorf("invalid Capture: %w", err)
+	}
+	return nil
+}
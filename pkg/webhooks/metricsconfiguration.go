@@ -0,0 +1,64 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	validate "github.com/microsoft/retina/crd/api/v1alpha1/validations"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+//+kubebuilder:webhook:path=/validate-retina-sh-v1alpha1-metricsconfiguration,mutating=false,failurePolicy=fail,sideEffects=None,groups=retina.sh,resources=metricsconfigurations,verbs=create;update,versions=v1alpha1,name=vmetricsconfiguration.retina.sh,admissionReviewVersions=v1
+
+type metricsConfigurationWebhook struct {
+	l *log.ZapLogger
+}
+
+var _ admission.CustomValidator = &metricsConfigurationWebhook{}
+
+// ValidateCreate validates the MetricsConfiguration with the validation of the MetricsConfiguration controller.
+func (w *metricsConfigurationWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	mc, ok := obj.(*retinav1alpha1.MetricsConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("expected a MetricsConfiguration but got %T", obj)
+	}
+	return nil, w.validate(mc)
+}
+
+// ValidateUpdate validates the MetricsConfiguration when its spec changes.
+func (w *metricsConfigurationWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldMC, ok := oldObj.(*retinav1alpha1.MetricsConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("expected a MetricsConfiguration but got %T", oldObj)
+	}
+	newMC, ok := newObj.(*retinav1alpha1.MetricsConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("expected a MetricsConfiguration but got %T", newObj)
+	}
+	if apiequality.Semantic.DeepEqual(oldMC.Spec, newMC.Spec) {
+		return nil, nil
+	}
+	return nil, w.validate(newMC)
+}
+
+// ValidateDelete allows the deletion of any MetricsConfiguration.
+func (w *metricsConfigurationWebhook) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *metricsConfigurationWebhook) validate(mc *retinav1alpha1.MetricsConfiguration) error {
+	if err := validate.MetricsCRD(mc); err != nil {
+		w.l.Info("Rejected invalid MetricsConfiguration", zap.String("name", mc.Name), zap.Error(err))
+		return fmt.Errorf("invalid MetricsConfiguration: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	validate "github.com/microsoft/retina/crd/api/v1alpha1/validations"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+//+kubebuilder:webhook:path=/validate-retina-sh-v1alpha1-tracesconfiguration,mutating=false,failurePolicy=fail,sideEffects=None,groups=retina.sh,resources=tracesconfigurations,verbs=create;update,versions=v1alpha1,name=vtracesconfiguration.retina.sh,admissionReviewVersions=v1
+
+type tracesConfigurationWebhook struct {
+	l *log.ZapLogger
+}
+
+var _ admission.CustomValidator = &tracesConfigurationWebhook{}
+
+// ValidateCreate validates the TracesConfiguration with the validation of the TracesConfiguration controller.
+func (w *tracesConfigurationWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tc, ok := obj.(*retinav1alpha1.TracesConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("expected a TracesConfiguration but got %T", obj)
+	}
+	return nil, w.validate(tc)
+}
+
+// ValidateUpdate validates the TracesConfiguration when its spec changes.
+func (w *tracesConfigurationWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldTC, ok := oldObj.(*retinav1alpha1.TracesConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("expected a TracesConfiguration but got %T", oldObj)
+	}
+	newTC, ok := newObj.(*retinav1alpha1.TracesConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("expected a TracesConfiguration but got %T", newObj)
+	}
+	if apiequality.Semantic.DeepEqual(oldTC.Spec, newTC.Spec) {
+		return nil, nil
+	}
+	return nil, w.validate(newTC)
+}
+
+// ValidateDelete allows the deletion of any TracesConfiguration.
+func (w *tracesConfigurationWebhook) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *tracesConfigurationWebhook) validate(tc *retinav1alpha1.TracesConfiguration) error {
+	// The status is written by the controller after the admission, and only the spec is validated.
+	withoutStatus := tc.DeepCopy()
+	withoutStatus.Status = nil
+	if err := validate.TracesCRD(withoutStatus); err != nil {
+		w.l.Info("Rejected invalid TracesConfiguration", zap.String("name", tc.Name), zap.Error(err))
+		return fmt.Errorf("invalid TracesConfiguration: %w", err)
+	}
+	return nil
+}
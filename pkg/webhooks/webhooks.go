@@ -0,0 +1,45 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+// Package webhooks features the defaulting and validating admission webhooks of the Retina CRDs, served by the
+// retina operator so that an invalid spec is rejected by the API server instead of being reported in the status.
+package webhooks
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/log"
+)
+
+// SetupWithManager registers the webhooks of Capture, MetricsConfiguration and TracesConfiguration to the webhook
+// server of the manager.
+func SetupWithManager(mgr ctrl.Manager) error {
+	l := log.Logger().Named("webhooks")
+
+	cw := &captureWebhook{l: l.Named("capture")}
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&retinav1alpha1.Capture{}).
+		WithDefaulter(cw).
+		WithValidator(cw).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to set up Capture webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&retinav1alpha1.MetricsConfiguration{}).
+		WithValidator(&metricsConfigurationWebhook{l: l.Named("metricsconfiguration")}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to set up MetricsConfiguration webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&retinav1alpha1.TracesConfiguration{}).
+		WithValidator(&tracesConfigurationWebhook{l: l.Named("tracesconfiguration")}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to set up TracesConfiguration webhook: %w", err)
+	}
+	return nil
+}
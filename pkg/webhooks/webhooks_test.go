@@ -0,0 +1,181 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	retinav1alpha1 "github.com/microsoft/retina/crd/api/v1alpha1"
+	"github.com/microsoft/retina/pkg/log"
+	"github.com/microsoft/retina/pkg/utils"
+)
+
+func validCapture() *retinav1alpha1.Capture {
+	hostPath := "/tmp/capture"
+	return &retinav1alpha1.Capture{
+		ObjectMeta: metav1.ObjectMeta{Name: "capture", Namespace: "default"},
+		Spec: retinav1alpha1.CaptureSpec{
+			CaptureConfiguration: retinav1alpha1.CaptureConfiguration{
+				CaptureTarget: retinav1alpha1.CaptureTarget{
+					NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/os": "linux"}},
+				},
+			},
+			OutputConfiguration: retinav1alpha1.OutputConfiguration{HostPath: &hostPath},
+		},
+	}
+}
+
+func validMetricsConfiguration() *retinav1alpha1.MetricsConfiguration {
+	return &retinav1alpha1.MetricsConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "metricsconfig"},
+		Spec: retinav1alpha1.MetricsSpec{
+			ContextOptions: []retinav1alpha1.MetricsContextOptions{{MetricName: utils.DroppedPacketsGaugeName}},
+			Namespaces:     retinav1alpha1.MetricsNamespaces{Include: []string{"default"}},
+		},
+	}
+}
+
+func validTracesConfiguration() *retinav1alpha1.TracesConfiguration {
+	return &retinav1alpha1.TracesConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "tracesconfig"},
+		Spec: &retinav1alpha1.TracesSpec{
+			TraceConfiguration: []*retinav1alpha1.TraceConfiguration{{
+				TraceCaptureLevel: retinav1alpha1.FirstPacketCapture,
+				TraceTargets: []*retinav1alpha1.TraceTargets{{
+					Source: &retinav1alpha1.TraceTarget{IPBlock: retinav1alpha1.IPBlock{CIDR: "10.0.0.0/8"}},
+				}},
+			}},
+			TraceOutputConfiguration: &retinav1alpha1.TraceOutputConfiguration{TraceOutputDestination: "stdout"},
+		},
+	}
+}
+
+func TestCaptureDefault(t *testing.T) {
+	w := &captureWebhook{}
+
+	c := validCapture()
+	require.NoError(t, w.Default(context.Background(), c))
+	assert.Equal(t, &metav1.Duration{Duration: DefaultCaptureDuration}, c.Spec.CaptureConfiguration.CaptureOption.Duration)
+	assert.Equal(t, DefaultMaxCaptureSize, *c.Spec.CaptureConfiguration.CaptureOption.MaxCaptureSize)
+
+	// The values set in the Capture are kept, and the duration of a ring buffer capture is unlimited by default.
+	c = validCapture()
+	maxCaptureSize := 10
+	c.Spec.CaptureConfiguration.CaptureOption.MaxCaptureSize = &maxCaptureSize
+	c.Spec.CaptureConfiguration.CaptureOption.RingBuffer = &retinav1alpha1.RingBuffer{}
+	require.NoError(t, w.Default(context.Background(), c))
+	assert.Nil(t, c.Spec.CaptureConfiguration.CaptureOption.Duration)
+	assert.Equal(t, 10, *c.Spec.CaptureConfiguration.CaptureOption.MaxCaptureSize)
+
+	c = validCapture()
+	c.Spec.CaptureConfiguration.CaptureOption.Duration = &metav1.Duration{Duration: time.Hour}
+	require.NoError(t, w.Default(context.Background(), c))
+	assert.Equal(t, time.Hour, c.Spec.CaptureConfiguration.CaptureOption.Duration.Duration)
+
+	assert.Error(t, w.Default(context.Background(), validMetricsConfiguration()))
+}
+
+func TestCaptureValidate(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	w := &captureWebhook{l: log.Logger().Named("test")}
+
+	valid := validCapture()
+	require.NoError(t, w.Default(context.Background(), valid))
+	_, err := w.ValidateCreate(context.Background(), valid)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		mutate func(c *retinav1alpha1.Capture)
+	}{
+		{
+			name:   "no target",
+			mutate: func(c *retinav1alpha1.Capture) { c.Spec.CaptureConfiguration.CaptureTarget.NodeSelector = nil },
+		},
+		{
+			name:   "no output",
+			mutate: func(c *retinav1alpha1.Capture) { c.Spec.OutputConfiguration.HostPath = nil },
+		},
+		{
+			name: "invalid filter",
+			mutate: func(c *retinav1alpha1.Capture) {
+				c.Spec.CaptureConfiguration.Filters = &retinav1alpha1.CaptureConfigurationFilters{Include: []string{"10.0.0.256"}}
+			},
+		},
+		{
+			name:   "invalid schedule",
+			mutate: func(c *retinav1alpha1.Capture) { c.Spec.Schedule = "every day" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invalid := valid.DeepCopy()
+			tt.mutate(invalid)
+			_, err := w.ValidateCreate(context.Background(), invalid)
+			assert.Error(t, err)
+
+			// An update of the spec is validated, while an update of the metadata only is allowed.
+			_, err = w.ValidateUpdate(context.Background(), valid, invalid)
+			assert.Error(t, err)
+			withoutFinalizer := invalid.DeepCopy()
+			invalid.Finalizers = []string{"retina.sh/capture"}
+			_, err = w.ValidateUpdate(context.Background(), invalid, withoutFinalizer)
+			assert.NoError(t, err)
+
+			_, err = w.ValidateDelete(context.Background(), invalid)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMetricsConfigurationValidate(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	w := &metricsConfigurationWebhook{l: log.Logger().Named("test")}
+
+	valid := validMetricsConfiguration()
+	_, err := w.ValidateCreate(context.Background(), valid)
+	require.NoError(t, err)
+
+	invalid := valid.DeepCopy()
+	invalid.Spec.ContextOptions[0].MetricName = "not_a_metric"
+	_, err = w.ValidateCreate(context.Background(), invalid)
+	assert.Error(t, err)
+	_, err = w.ValidateUpdate(context.Background(), valid, invalid)
+	assert.Error(t, err)
+	_, err = w.ValidateUpdate(context.Background(), invalid, invalid.DeepCopy())
+	assert.NoError(t, err)
+
+	_, err = w.ValidateCreate(context.Background(), validCapture())
+	assert.Error(t, err)
+}
+
+func TestTracesConfigurationValidate(t *testing.T) {
+	log.SetupZapLogger(log.GetDefaultLogOpts())
+	w := &tracesConfigurationWebhook{l: log.Logger().Named("test")}
+
+	valid := validTracesConfiguration()
+	_, err := w.ValidateCreate(context.Background(), valid)
+	require.NoError(t, err)
+
+	// The status written by the controller is not validated.
+	withStatus := valid.DeepCopy()
+	withStatus.Status = &retinav1alpha1.TracesStatus{LastKnownSpec: &retinav1alpha1.TracesSpec{
+		TraceConfiguration: []*retinav1alpha1.TraceConfiguration{{TraceCaptureLevel: "invalid"}},
+	}}
+	_, err = w.ValidateCreate(context.Background(), withStatus)
+	require.NoError(t, err)
+
+	invalid := valid.DeepCopy()
+	invalid.Spec.TraceConfiguration[0].TraceCaptureLevel = "invalid"
+	_, err = w.ValidateCreate(context.Background(), invalid)
+	assert.Error(t, err)
+	_, err = w.ValidateUpdate(context.Background(), valid, invalid)
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/microsoft/retina/pkg/managers/watchermanager"
 	"github.com/microsoft/retina/pkg/plugin/packetparser"
 	"github.com/microsoft/retina/pkg/watchers/endpoint"
+	"github.com/microsoft/retina/pkg/watchers/link"
 	"go.uber.org/zap"
 
 	"github.com/microsoft/retina/pkg/metrics"
@@ -33,7 +34,7 @@ func main() {
 
 	// watcher manager
 	wm := watchermanager.NewWatcherManager()
-	wm.Watchers = []watchermanager.IWatcher{endpoint.Watcher()}
+	wm.Watchers = []watchermanager.IWatcher{endpoint.Watcher(), link.Watcher()}
 
 	err := wm.Start(ctxTimeout)
 	if err != nil {